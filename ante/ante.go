@@ -16,6 +16,15 @@ type HandlerOptions struct {
 
 	IBCkeeper            *ibckeeper.Keeper
 	BypassMinFeeMsgTypes []string
+
+	// DeniedNestedMsgTypes are rejected when nested in an authz MsgExec
+	DeniedNestedMsgTypes []string
+	// MaxNestedMsgDepth is the maximum depth of nested msgs, DefaultMaxNestedMsgDepth if 0
+	MaxNestedMsgDepth int
+	// LSCosmosKeeper provides the governance set minimum fee per msg type
+	LSCosmosKeeper LSCosmosKeeper
+	// StakingKeeper provides the native denom the minimum fee per msg type is priced in
+	StakingKeeper StakingKeeper
 }
 
 func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
@@ -28,6 +37,17 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 	if opts.SignModeHandler == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
+	if opts.LSCosmosKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "lscosmos keeper is required for AnteHandler")
+	}
+	if opts.StakingKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "staking keeper is required for AnteHandler")
+	}
+
+	var maxNestedMsgDepth = opts.MaxNestedMsgDepth
+	if maxNestedMsgDepth == 0 {
+		maxNestedMsgDepth = DefaultMaxNestedMsgDepth
+	}
 
 	var sigGasConsumer = opts.SigGasConsumer
 	if sigGasConsumer == nil {
@@ -38,9 +58,11 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewSetUpContextDecorator(),
 		ante.NewExtensionOptionsDecorator(opts.ExtensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
+		NewDenyNestedMsgsDecorator(opts.DeniedNestedMsgTypes, maxNestedMsgDepth),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(opts.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(opts.AccountKeeper),
+		NewMsgFeeDecorator(opts.LSCosmosKeeper, opts.StakingKeeper),
 		ante.NewDeductFeeDecorator(opts.AccountKeeper, opts.BankKeeper, opts.FeegrantKeeper, opts.TxFeeChecker),
 		// SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewSetPubKeyDecorator(opts.AccountKeeper),
@@ -0,0 +1,134 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+
+	lscosmostypes "github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+	lselysiumtypes "github.com/merlin-network/estake-native/v2/x/lselysium/types"
+)
+
+// DefaultMaxNestedMsgDepth is the default maximum depth of msgs nested in authz MsgExec, a msg of the tx is at
+// depth 0.
+const DefaultMaxNestedMsgDepth = 2
+
+// DefaultDeniedNestedMsgTypes returns the admin and governance only msgs that must be signed directly by their
+// authority, they are rejected when nested in an authz MsgExec.
+func DefaultDeniedNestedMsgTypes() []string {
+	return []string{
+		sdk.MsgTypeURL(&lscosmostypes.MsgJumpStart{}),
+		sdk.MsgTypeURL(&lscosmostypes.MsgChangeModuleState{}),
+		sdk.MsgTypeURL(&lscosmostypes.MsgReportSlashing{}),
+		sdk.MsgTypeURL(&lselysiumtypes.MsgAddWhitelistedValidator{}),
+		sdk.MsgTypeURL(&lselysiumtypes.MsgRemoveWhitelistedValidator{}),
+		sdk.MsgTypeURL(&lselysiumtypes.MsgUpdateValidatorWeight{}),
+	}
+}
+
+// DefaultICAHostAllowMessages returns the msgs the interchain accounts host executes on behalf of the controller
+// chains, it is the AllowMessages param of the icahost module set in the default genesis and by the v3 upgrade.
+// The msgs of DefaultDeniedNestedMsgTypes are left out, and so is authz MsgExec, which would nest them.
+func DefaultICAHostAllowMessages() []string {
+	return []string{
+		sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+		sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{}),
+		sdk.MsgTypeURL(&distrtypes.MsgSetWithdrawAddress{}),
+		sdk.MsgTypeURL(&distrtypes.MsgFundCommunityPool{}),
+		sdk.MsgTypeURL(&govv1beta1.MsgVote{}),
+		sdk.MsgTypeURL(&govv1beta1.MsgVoteWeighted{}),
+		sdk.MsgTypeURL(&govv1beta1.MsgDeposit{}),
+		sdk.MsgTypeURL(&govv1.MsgVote{}),
+		sdk.MsgTypeURL(&govv1.MsgVoteWeighted{}),
+		sdk.MsgTypeURL(&govv1.MsgDeposit{}),
+		sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{}),
+		sdk.MsgTypeURL(&lscosmostypes.MsgLiquidStake{}),
+		sdk.MsgTypeURL(&lscosmostypes.MsgLiquidUnstake{}),
+		sdk.MsgTypeURL(&lscosmostypes.MsgRedeem{}),
+		sdk.MsgTypeURL(&lscosmostypes.MsgClaim{}),
+		sdk.MsgTypeURL(&lselysiumtypes.MsgLiquidStake{}),
+		sdk.MsgTypeURL(&lselysiumtypes.MsgLiquidUnstake{}),
+		sdk.MsgTypeURL(&lselysiumtypes.MsgStakeToLiquid{}),
+		sdk.MsgTypeURL(&lselysiumtypes.MsgPooledLiquidUnstake{}),
+		sdk.MsgTypeURL(&lselysiumtypes.MsgClaimUnbonded{}),
+		sdk.MsgTypeURL(&lselysiumtypes.MsgInstantLiquidUnstake{}),
+	}
+}
+
+// DenyNestedMsgsDecorator rejects txs executing a denied msg on behalf of another account through an authz
+// MsgExec, so that a leaked grant cannot be used to act as an admin. Nesting deeper than the max depth is
+// rejected as well. The msgs of the interchain account txs received by the host are executed by the host msg
+// router after the ante handler, they are restricted by the AllowMessages param of the icahost module, which the
+// app sets to DefaultICAHostAllowMessages.
+type DenyNestedMsgsDecorator struct {
+	deniedMsgTypes  map[string]struct{}
+	maxNestingDepth int
+}
+
+// NewDenyNestedMsgsDecorator returns a DenyNestedMsgsDecorator
+func NewDenyNestedMsgsDecorator(deniedMsgTypes []string, maxNestingDepth int) DenyNestedMsgsDecorator {
+	denied := make(map[string]struct{}, len(deniedMsgTypes))
+	for _, msgType := range deniedMsgTypes {
+		denied[msgType] = struct{}{}
+	}
+	return DenyNestedMsgsDecorator{
+		deniedMsgTypes:  denied,
+		maxNestingDepth: maxNestingDepth,
+	}
+}
+
+func (d DenyNestedMsgsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		if err := d.validateMsg(msg, 0); err != nil {
+			return ctx, err
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// validateMsg checks the msgs nested in msg, msg itself is denied only when nested
+func (d DenyNestedMsgsDecorator) validateMsg(msg sdk.Msg, depth int) error {
+	if depth > 0 {
+		if _, denied := d.deniedMsgTypes[sdk.MsgTypeURL(msg)]; denied {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s cannot be executed on behalf of another account", sdk.MsgTypeURL(msg))
+		}
+	}
+
+	nestedMsgs, err := d.nestedMsgs(msg)
+	if err != nil {
+		return err
+	}
+	if len(nestedMsgs) == 0 {
+		return nil
+	}
+	if depth+1 > d.maxNestingDepth {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "msgs nested deeper than %d in %s", d.maxNestingDepth, sdk.MsgTypeURL(msg))
+	}
+	for _, nestedMsg := range nestedMsgs {
+		if err := d.validateMsg(nestedMsg, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nestedMsgs returns the msgs executed by msg on behalf of other accounts
+func (d DenyNestedMsgsDecorator) nestedMsgs(msg sdk.Msg) ([]sdk.Msg, error) {
+	switch msg := msg.(type) {
+	case *authz.MsgExec:
+		return msg.GetMessages()
+	default:
+		return nil, nil
+	}
+}
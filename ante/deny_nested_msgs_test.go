@@ -0,0 +1,52 @@
+package ante_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/merlin-network/estake-native/v2/ante"
+	lscosmostypes "github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+// msgsTx is a tx made of msgs only
+type msgsTx []sdk.Msg
+
+func (tx msgsTx) GetMsgs() []sdk.Msg   { return tx }
+func (tx msgsTx) ValidateBasic() error { return nil }
+
+func (s *IntegrationTestSuite) TestDenyNestedMsgsDecorator() {
+	admin := sdk.AccAddress("admin_______________")
+	grantee := sdk.AccAddress("grantee_____________")
+	changeModuleState := lscosmostypes.NewMsgChangeModuleState(admin, false)
+	liquidStake := lscosmostypes.NewMsgLiquidStake(sdk.NewInt64Coin("ibc/uatom", 10), admin)
+
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(grantee, msgs)
+		return &msg
+	}
+
+	decorator := ante.NewDenyNestedMsgsDecorator(ante.DefaultDeniedNestedMsgTypes(), ante.DefaultMaxNestedMsgDepth)
+	anteHandler := sdk.ChainAnteDecorators(decorator)
+
+	for _, tc := range []struct {
+		desc  string
+		msgs  []sdk.Msg
+		valid bool
+	}{
+		{"admin msg signed directly", []sdk.Msg{changeModuleState}, true},
+		{"msg in authz exec", []sdk.Msg{exec(liquidStake)}, true},
+		{"admin msg in authz exec", []sdk.Msg{exec(liquidStake, changeModuleState)}, false},
+		{"admin msg in nested authz exec", []sdk.Msg{exec(exec(changeModuleState))}, false},
+		{"nesting at max depth", []sdk.Msg{exec(exec(liquidStake))}, true},
+		{"nesting deeper than max depth", []sdk.Msg{exec(exec(exec(liquidStake)))}, false},
+	} {
+		s.Run(tc.desc, func() {
+			_, err := anteHandler(s.ctx, msgsTx(tc.msgs), false)
+			if tc.valid {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
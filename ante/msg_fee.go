@@ -0,0 +1,90 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	lscosmostypes "github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+// LSCosmosKeeper defines the lscosmos keeper methods used by the ante handler
+type LSCosmosKeeper interface {
+	GetParams(ctx sdk.Context) lscosmostypes.Params
+}
+
+// StakingKeeper defines the staking keeper methods used by the ante handler
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+}
+
+// MsgFeeDecorator requires a minimum fee in the native denom for the msg types priced by governance. The minimum
+// fee of a tx is the lscosmos base msg fee times the sum of the fee multipliers of its msgs, msgs executed through
+// authz MsgExec included. Unlike the validators min gas prices, it is checked on deliver tx as well.
+type MsgFeeDecorator struct {
+	lscosmosKeeper LSCosmosKeeper
+	stakingKeeper  StakingKeeper
+}
+
+// NewMsgFeeDecorator returns a MsgFeeDecorator
+func NewMsgFeeDecorator(lscosmosKeeper LSCosmosKeeper, stakingKeeper StakingKeeper) MsgFeeDecorator {
+	return MsgFeeDecorator{
+		lscosmosKeeper: lscosmosKeeper,
+		stakingKeeper:  stakingKeeper,
+	}
+}
+
+func (d MsgFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// gentxs are not charged any fee
+	if simulate || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	params := d.lscosmosKeeper.GetParams(ctx)
+	if params.BaseMsgFee.IsNil() || params.BaseMsgFee.IsZero() || len(params.MsgFeeMultipliers) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	multiplier, err := msgsFeeMultiplier(params, tx.GetMsgs())
+	if err != nil {
+		return ctx, err
+	}
+	requiredFee := sdk.NewDecFromInt(params.BaseMsgFee).Mul(multiplier).Ceil().TruncateInt()
+	if !requiredFee.IsPositive() {
+		return next(ctx, tx, simulate)
+	}
+
+	requiredCoin := sdk.NewCoin(d.stakingKeeper.BondDenom(ctx), requiredFee)
+	if feeTx.GetFee().AmountOf(requiredCoin.Denom).LT(requiredCoin.Amount) {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees for the msg types; got: %s required: %s", feeTx.GetFee(), requiredCoin)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// msgsFeeMultiplier returns the sum of the fee multipliers of the msgs and of the msgs they execute through authz
+func msgsFeeMultiplier(params lscosmostypes.Params, msgs []sdk.Msg) (sdk.Dec, error) {
+	multiplier := sdk.ZeroDec()
+	for _, msg := range msgs {
+		multiplier = multiplier.Add(params.MsgFeeMultiplier(sdk.MsgTypeURL(msg)))
+
+		if exec, ok := msg.(*authz.MsgExec); ok {
+			execMsgs, err := exec.GetMessages()
+			if err != nil {
+				return sdk.Dec{}, err
+			}
+			execMultiplier, err := msgsFeeMultiplier(params, execMsgs)
+			if err != nil {
+				return sdk.Dec{}, err
+			}
+			multiplier = multiplier.Add(execMultiplier)
+		}
+	}
+	return multiplier, nil
+}
@@ -0,0 +1,55 @@
+package ante_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/merlin-network/estake-native/v2/ante"
+	lscosmostypes "github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+func (s *IntegrationTestSuite) TestMsgFeeDecorator() {
+	delegator := sdk.AccAddress("delegator___________")
+	liquidStake := lscosmostypes.NewMsgLiquidStake(sdk.NewInt64Coin("ibc/uatom", 10), delegator)
+	claim := lscosmostypes.NewMsgClaim(delegator, 0)
+	exec := authz.NewMsgExec(sdk.AccAddress("grantee_____________"), []sdk.Msg{liquidStake})
+	bondDenom := s.app.StakingKeeper.BondDenom(s.ctx)
+
+	params := lscosmostypes.DefaultParams()
+	params.BaseMsgFee = sdk.NewInt(1000)
+	params.MsgFeeMultipliers = []lscosmostypes.MsgFeeMultiplier{
+		{MsgTypeUrl: sdk.MsgTypeURL(liquidStake), Multiplier: sdk.NewDecWithPrec(15, 1)},
+	}
+	s.app.LSCosmosKeeper.SetParams(s.ctx, params)
+
+	anteHandler := sdk.ChainAnteDecorators(ante.NewMsgFeeDecorator(s.app.LSCosmosKeeper, s.app.StakingKeeper))
+
+	for _, tc := range []struct {
+		desc     string
+		msgs     []sdk.Msg
+		fee      sdk.Coins
+		simulate bool
+		valid    bool
+	}{
+		{"msg without multiplier", []sdk.Msg{claim}, nil, false, true},
+		{"priced msg without fee", []sdk.Msg{liquidStake}, nil, false, false},
+		{"priced msg with required fee", []sdk.Msg{liquidStake}, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1500)), false, true},
+		{"priced msg with fee in another denom", []sdk.Msg{liquidStake}, sdk.NewCoins(sdk.NewInt64Coin("ibc/uatom", 1500)), false, false},
+		{"priced msgs multipliers add up", []sdk.Msg{liquidStake, liquidStake}, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1500)), false, false},
+		{"priced msg in authz exec", []sdk.Msg{&exec}, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1499)), false, false},
+		{"simulation is not charged", []sdk.Msg{liquidStake}, nil, true, true},
+	} {
+		s.Run(tc.desc, func() {
+			s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+			s.Require().NoError(s.txBuilder.SetMsgs(tc.msgs...))
+			s.txBuilder.SetFeeAmount(tc.fee)
+
+			_, err := anteHandler(s.ctx, s.txBuilder.GetTx(), tc.simulate)
+			if tc.valid {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
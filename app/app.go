@@ -132,6 +132,7 @@ import (
 
 	estakeante "github.com/merlin-network/estake-native/v2/ante"
 	estakeappparams "github.com/merlin-network/estake-native/v2/app/params"
+	"github.com/merlin-network/estake-native/v2/server/stream"
 	"github.com/merlin-network/estake-native/v2/x/liquidstakeibc"
 	liquidstakeibckeeper "github.com/merlin-network/estake-native/v2/x/liquidstakeibc/keeper"
 	liquidstakeibctypes "github.com/merlin-network/estake-native/v2/x/liquidstakeibc/types"
@@ -181,7 +182,7 @@ var (
 		ibchooker.AppModuleBasic{},
 		ibcfee.AppModuleBasic{},
 		vesting.AppModuleBasic{},
-		ica.AppModuleBasic{},
+		icaModuleBasic{},
 		epochs.AppModuleBasic{},
 		lscosmos.AppModuleBasic{},
 		interchainquery.AppModuleBasic{},
@@ -433,10 +434,6 @@ func NeweStakeApp(
 		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
 	)
 
-	app.LSElysiumKeeper = lselysiumkeeper.NewKeeper(appCodec, keys[lselysiumtypes.StoreKey],
-		app.GetSubspace(lselysiumtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
-		app.StakingKeeper, app.DistrKeeper, app.SlashingKeeper)
-
 	app.IBCKeeper = ibckeeper.NewKeeper(
 		appCodec,
 		keys[ibchost.StoreKey],
@@ -491,7 +488,7 @@ func NeweStakeApp(
 	app.InterchainQueryKeeper = interchainquerykeeper.NewKeeper(appCodec, keys[interchainquerytypes.StoreKey], app.IBCKeeper)
 	interchainQueryModule := interchainquery.NewAppModule(appCodec, app.InterchainQueryKeeper)
 
-	app.LSCosmosKeeper = lscosmoskeeper.NewKeeper(
+	lsCosmosKeeper := lscosmoskeeper.NewKeeper(
 		appCodec,
 		keys[lscosmostypes.StoreKey],
 		memKeys[lscosmostypes.MemStoreKey],
@@ -508,6 +505,12 @@ func NeweStakeApp(
 		scopedLSCosmosKeeper,
 		app.MsgServiceRouter(),
 	)
+	// hooks have to be set before any copy of the keeper is handed out
+	app.LSCosmosKeeper = *lsCosmosKeeper.SetHooks(
+		lscosmostypes.NewMultiLSCosmosHooks(
+		// register the lscosmos hooks
+		),
+	)
 
 	_ = app.InterchainQueryKeeper.SetCallbackHandler(lscosmostypes.ModuleName, app.LSCosmosKeeper.CallbackHandler())
 
@@ -558,7 +561,7 @@ func NeweStakeApp(
 	// See: https://github.com/cosmos/cosmos-sdk/blob/release/v0.46.x/x/gov/spec/01_concepts.md#proposal-messages
 	govRouter := govv1beta1.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(paramproposal.RouterKey, lselysium.NewParamChangeProposalHandler(app.ParamsKeeper, app.GetSubspace(lselysiumtypes.ModuleName))).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(lscosmostypes.RouterKey, lscosmos.NewLSCosmosProposalHandler(app.LSCosmosKeeper))
@@ -578,6 +581,11 @@ func NeweStakeApp(
 		),
 	)
 
+	app.LSElysiumKeeper = lselysiumkeeper.NewKeeper(appCodec, keys[lselysiumtypes.StoreKey],
+		app.GetSubspace(lselysiumtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
+		app.StakingKeeper, app.DistrKeeper, app.GovKeeper, app.SlashingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String())
+
 	// create evidence keeper with router
 	evidenceKeeper := evidencekeeper.NewKeeper(
 		appCodec,
@@ -777,6 +785,10 @@ func NeweStakeApp(
 			},
 			IBCkeeper:            app.IBCKeeper,
 			BypassMinFeeMsgTypes: cast.ToStringSlice(appOpts.Get(estakeappparams.BypassMinFeeMsgTypesKey)),
+			DeniedNestedMsgTypes: estakeante.DefaultDeniedNestedMsgTypes(),
+			MaxNestedMsgDepth:    estakeante.DefaultMaxNestedMsgDepth,
+			LSCosmosKeeper:       app.LSCosmosKeeper,
+			StakingKeeper:        app.StakingKeeper,
 		},
 	)
 	if err != nil {
@@ -788,19 +800,8 @@ func NeweStakeApp(
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 
-	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
-	if err != nil {
-		panic(fmt.Sprintf("failed to read upgrade info from disk %s", err))
-	}
-
-	if upgradeInfo.Name == upgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
-		storeUpgrades := store.StoreUpgrades{
-			Added: []string{icahosttypes.StoreKey},
-		}
-
-		// configure store loader that checks if version == upgradeHeight and applies store upgrades
-		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
-	}
+	app.setupUpgradeHandlers()
+	app.setupUpgradeStoreLoaders()
 
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
@@ -956,8 +957,10 @@ func (app *EstakeApp) RegisterTendermintService(clientCtx client.Context) {
 	)
 }
 
+// RegisterNodeService registers the node gRPC services, the liquid staking event stream included.
 func (app *EstakeApp) RegisterNodeService(clientCtx client.Context) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter())
+	stream.RegisterStreamService(clientCtx, app.GRPCQueryRouter())
 }
 
 // RegisterSwaggerAPI registers swagger route with API Server
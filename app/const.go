@@ -2,8 +2,7 @@ package app
 
 //nolint:deadcode,unused,unused_vars
 const (
-	appName     = "eStake"
-	upgradeName = ""
+	appName = "eStake"
 	//nolint:nolintlint,unused_vars
 	authzMsgExec                        = "/cosmos.authz.v1beta1.MsgExec"
 	authzMsgGrant                       = "/cosmos.authz.v1beta1.MsgGrant"
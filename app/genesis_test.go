@@ -0,0 +1,32 @@
+package app_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	icagenesistypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/genesis/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/merlin-network/estake-native/v2/ante"
+	estake "github.com/merlin-network/estake-native/v2/app"
+)
+
+// TestDefaultGenesisICAHostAllowMessages checks that the interchain accounts host of the default genesis does not
+// execute the admin msgs denied to authz, nor an authz MsgExec nesting them.
+func TestDefaultGenesisICAHostAllowMessages(t *testing.T) {
+	encodingConfig := estake.MakeEncodingConfig()
+	genesis := estake.NewDefaultGenesisState()
+
+	var icaGenesis icagenesistypes.GenesisState
+	encodingConfig.Marshaler.MustUnmarshalJSON(genesis[icatypes.ModuleName], &icaGenesis)
+	hostParams := icaGenesis.HostGenesisState.Params
+	require.True(t, hostParams.HostEnabled)
+	require.Equal(t, ante.DefaultICAHostAllowMessages(), hostParams.AllowMessages)
+
+	for _, msgType := range append(ante.DefaultDeniedNestedMsgTypes(), sdk.MsgTypeURL(&authz.MsgExec{})) {
+		require.NotContains(t, hostParams.AllowMessages, msgType)
+	}
+	require.NotContains(t, hostParams.AllowMessages, "*")
+}
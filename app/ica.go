@@ -0,0 +1,25 @@
+package app
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	ica "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts"
+	icagenesistypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/genesis/types"
+	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+
+	estakeante "github.com/merlin-network/estake-native/v2/ante"
+)
+
+// icaModuleBasic overrides the default genesis of the interchain accounts module, so that the host only executes
+// the msgs of estakeante.DefaultICAHostAllowMessages.
+type icaModuleBasic struct {
+	ica.AppModuleBasic
+}
+
+// DefaultGenesis returns the default interchain accounts genesis with the app AllowMessages of the host.
+func (icaModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	genesis := icagenesistypes.DefaultGenesis()
+	genesis.HostGenesisState.Params = icahosttypes.NewParams(icahosttypes.DefaultHostEnabled, estakeante.DefaultICAHostAllowMessages())
+	return cdc.MustMarshalJSON(genesis)
+}
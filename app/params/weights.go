@@ -21,6 +21,9 @@ const (
 
 	DefaultWeightMsgLiquidStake   int = 80
 	DefaultWeightMsgLiquidUnstake int = 30
+	DefaultWeightMsgRedeem        int = 20
+	DefaultWeightMsgClaim         int = 30
+	DefaultWeightHostChain        int = 50
 
 	DefaultWeightAddWhitelistValidatorsProposal    int = 50
 	DefaultWeightUpdateWhitelistValidatorsProposal int = 5
@@ -28,6 +31,10 @@ const (
 	DefaultWeightCompleteRedelegationUnbonding     int = 30
 	DefaultWeightTallyWithLiquidStaking            int = 30
 
+	DefaultWeightMinDepositAndFeeChangeProposal        int = 5
+	DefaultWeightEstakeFeeAddressChangeProposal        int = 5
+	DefaultWeightAllowListedValidatorSetChangeProposal int = 5
+
 	DefaultWeightCommunitySpendProposal int = 5
 	DefaultWeightTextProposal           int = 5
 	DefaultWeightParamChangeProposal    int = 5
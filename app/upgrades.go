@@ -0,0 +1,56 @@
+package app
+
+import (
+	"fmt"
+
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/merlin-network/estake-native/v2/app/upgrades"
+	v3 "github.com/merlin-network/estake-native/v2/app/upgrades/v3"
+)
+
+// Upgrades lists every upgrade the app can execute, a new upgrade only has to be added here.
+var Upgrades = []upgrades.Upgrade{
+	v3.Upgrade,
+}
+
+// setupUpgradeHandlers registers the handler of every upgrade in Upgrades.
+func (app *EstakeApp) setupUpgradeHandlers() {
+	keepers := upgrades.Keepers{
+		AccountKeeper:        app.AccountKeeper,
+		BankKeeper:           app.BankKeeper,
+		ParamsKeeper:         app.ParamsKeeper,
+		ICAHostKeeper:        app.ICAHostKeeper,
+		LSCosmosKeeper:       app.LSCosmosKeeper,
+		LiquidStakeIBCKeeper: app.LiquidStakeIBCKeeper,
+		LSElysiumKeeper:      app.LSElysiumKeeper,
+	}
+
+	for _, upgrade := range Upgrades {
+		app.UpgradeKeeper.SetUpgradeHandler(
+			upgrade.UpgradeName,
+			upgrade.CreateUpgradeHandler(app.mm, app.configurator, keepers),
+		)
+	}
+}
+
+// setupUpgradeStoreLoaders applies the store upgrades of the upgrade the node is
+// restarted for, once it reaches the upgrade height.
+func (app *EstakeApp) setupUpgradeStoreLoaders() {
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk %s", err))
+	}
+
+	if app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	for _, upgrade := range Upgrades {
+		if upgradeInfo.Name == upgrade.UpgradeName {
+			storeUpgrades := upgrade.StoreUpgrades
+			// configure store loader that checks if version == upgradeHeight and applies store upgrades
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package upgrades
+
+import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	icahostkeeper "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/keeper"
+
+	liquidstakeibckeeper "github.com/merlin-network/estake-native/v2/x/liquidstakeibc/keeper"
+	lscosmoskeeper "github.com/merlin-network/estake-native/v2/x/lscosmos/keeper"
+	lselysiumkeeper "github.com/merlin-network/estake-native/v2/x/lselysium/keeper"
+)
+
+// Upgrade defines an on-chain software upgrade: the name of the plan it executes, the
+// store changes it needs and the handler migrating the state.
+type Upgrade struct {
+	// UpgradeName is the name of the upgrade plan executed by the upgrade.
+	UpgradeName string
+
+	// CreateUpgradeHandler returns the handler run at the upgrade height.
+	CreateUpgradeHandler func(*module.Manager, module.Configurator, Keepers) upgradetypes.UpgradeHandler
+
+	// StoreUpgrades lists the stores added, renamed or deleted by the upgrade.
+	StoreUpgrades store.StoreUpgrades
+}
+
+// Keepers holds the app keepers available to upgrade handlers.
+type Keepers struct {
+	AccountKeeper        authkeeper.AccountKeeper
+	BankKeeper           bankkeeper.BaseKeeper
+	ParamsKeeper         paramskeeper.Keeper
+	ICAHostKeeper        icahostkeeper.Keeper
+	LSCosmosKeeper       lscosmoskeeper.Keeper
+	LiquidStakeIBCKeeper liquidstakeibckeeper.Keeper
+	LSElysiumKeeper      lselysiumkeeper.Keeper
+}
@@ -0,0 +1,14 @@
+package v3
+
+import (
+	"github.com/merlin-network/estake-native/v2/app/upgrades"
+)
+
+// UpgradeName is the name of the v3 upgrade plan.
+const UpgradeName = "v3"
+
+// Upgrade migrates the lselysium params and stores the lscosmos params added since v2.
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+}
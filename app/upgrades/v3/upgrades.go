@@ -0,0 +1,39 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/merlin-network/estake-native/v2/ante"
+	"github.com/merlin-network/estake-native/v2/app/upgrades"
+)
+
+// CreateUpgradeHandler runs the module migrations, which take lselysium to its second
+// consensus version, then stores the lscosmos params missing from the param subspace and
+// restricts the msgs executed by the interchain accounts host.
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers upgrades.Keepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info("running module migrations", "upgrade", UpgradeName)
+
+		vm, err := mm.RunMigrations(ctx, configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		// the delegation strategy param was added without a migration, GetParams falls back
+		// to the default for it, which is now written to the store.
+		keepers.LSCosmosKeeper.SetParams(ctx, keepers.LSCosmosKeeper.GetParams(ctx))
+
+		// the host executed every msg allowed by the genesis, the admin msgs denied to authz are left out.
+		icaHostParams := keepers.ICAHostKeeper.GetParams(ctx)
+		icaHostParams.AllowMessages = ante.DefaultICAHostAllowMessages()
+		keepers.ICAHostKeeper.SetParams(ctx, icaHostParams)
+
+		return vm, nil
+	}
+}
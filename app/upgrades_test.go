@@ -0,0 +1,117 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/merlin-network/estake-native/v2/ante"
+	estake "github.com/merlin-network/estake-native/v2/app"
+	"github.com/merlin-network/estake-native/v2/app/helpers"
+	v3 "github.com/merlin-network/estake-native/v2/app/upgrades/v3"
+	lscosmostypes "github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+	lselysiumtypes "github.com/merlin-network/estake-native/v2/x/lselysium/types"
+)
+
+// upgradeChecks rolls the state of the modules migrated by an upgrade back to the versions
+// the upgrade starts from, and checks the state they are migrated to.
+var upgradeChecks = map[string]struct {
+	preUpgrade  func(t *testing.T, app *estake.EstakeApp, ctx sdk.Context)
+	postUpgrade func(t *testing.T, app *estake.EstakeApp, ctx sdk.Context)
+}{
+	v3.UpgradeName: {
+		preUpgrade: func(t *testing.T, app *estake.EstakeApp, ctx sdk.Context) {
+			vm := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+			vm[lselysiumtypes.ModuleName] = 1
+			app.UpgradeKeeper.SetModuleVersionMap(ctx, vm)
+
+			params := app.LSElysiumKeeper.GetParams(ctx)
+			params.RebalancingFrequency = 100
+			params.UpdateFrequency = 10
+			params.MaxUnbondsPerBlock = 1
+			params.InstantReserveRate = sdk.NewDecWithPrec(5, 1)
+			app.LSElysiumKeeper.SetParams(ctx, params)
+
+			// the lscosmos delegation strategy param was never stored before v3
+			paramsStore := ctx.KVStore(app.GetKey(paramstypes.StoreKey))
+			paramsStore.Delete(append([]byte(lscosmostypes.ModuleName+"/"), lscosmostypes.KeyDelegationStrategy...))
+			require.False(t, app.GetSubspace(lscosmostypes.ModuleName).Has(ctx, lscosmostypes.KeyDelegationStrategy))
+
+			// the interchain accounts host allowed every msg before v3
+			app.ICAHostKeeper.SetParams(ctx, icahosttypes.NewParams(true, []string{"*"}))
+		},
+		postUpgrade: func(t *testing.T, app *estake.EstakeApp, ctx sdk.Context) {
+			require.Equal(t, uint64(2), app.UpgradeKeeper.GetModuleVersionMap(ctx)[lselysiumtypes.ModuleName])
+
+			params := app.LSElysiumKeeper.GetParams(ctx)
+			require.Equal(t, lselysiumtypes.DefaultRebalancingFrequency, params.RebalancingFrequency)
+			require.Equal(t, lselysiumtypes.DefaultUpdateFrequency, params.UpdateFrequency)
+			require.Equal(t, lselysiumtypes.DefaultMaxUnbondsPerBlock, params.MaxUnbondsPerBlock)
+			require.Equal(t, lselysiumtypes.DefaultInstantReserveRate, params.InstantReserveRate)
+
+			require.True(t, app.GetSubspace(lscosmostypes.ModuleName).Has(ctx, lscosmostypes.KeyDelegationStrategy))
+			require.Equal(t, lscosmostypes.DefaultParams().DelegationStrategy, app.LSCosmosKeeper.GetParams(ctx).DelegationStrategy)
+
+			icaHostParams := app.ICAHostKeeper.GetParams(ctx)
+			require.True(t, icaHostParams.HostEnabled)
+			require.Equal(t, ante.DefaultICAHostAllowMessages(), icaHostParams.AllowMessages)
+		},
+	},
+}
+
+// TestUpgrades replays every registered upgrade on a chain started from an exported genesis
+// and checks that the state exported after the upgrade is still a valid genesis.
+func TestUpgrades(t *testing.T) {
+	for _, upgrade := range estake.Upgrades {
+		upgrade := upgrade
+		t.Run(upgrade.UpgradeName, func(t *testing.T) {
+			source := helpers.Setup(t, false, 5)
+			exported, err := source.ExportAppStateAndValidators(false, nil)
+			require.NoError(t, err)
+
+			encodingConfig := estake.MakeEncodingConfig()
+			app := estake.NeweStakeApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, estake.DefaultNodeHome, 5, encodingConfig, helpers.EmptyAppOptions{})
+			app.InitChain(abci.RequestInitChain{
+				Validators:      []abci.ValidatorUpdate{},
+				ConsensusParams: helpers.DefaultConsensusParams,
+				AppStateBytes:   exported.AppState,
+			})
+			app.Commit()
+
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+			ctx := app.NewContext(false, header)
+
+			check, ok := upgradeChecks[upgrade.UpgradeName]
+			require.True(t, ok, "no upgrade check for %s", upgrade.UpgradeName)
+			check.preUpgrade(t, app, ctx)
+
+			require.True(t, app.UpgradeKeeper.HasHandler(upgrade.UpgradeName))
+			plan := upgradetypes.Plan{Name: upgrade.UpgradeName, Height: ctx.BlockHeight()}
+			require.NotPanics(t, func() {
+				app.UpgradeKeeper.ApplyUpgrade(ctx, plan)
+			})
+			require.Equal(t, ctx.BlockHeight(), app.UpgradeKeeper.GetDoneHeight(ctx, upgrade.UpgradeName))
+			check.postUpgrade(t, app, ctx)
+
+			app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+			app.Commit()
+
+			upgraded, err := app.ExportAppStateAndValidators(false, nil)
+			require.NoError(t, err)
+
+			var genesis map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(upgraded.AppState, &genesis))
+			require.NoError(t, estake.ModuleBasics.ValidateGenesis(app.AppCodec(), encodingConfig.TxConfig, genesis))
+		})
+	}
+}
@@ -0,0 +1,348 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	estakeApp "github.com/merlin-network/estake-native/v2/app"
+	"github.com/merlin-network/estake-native/v2/app/params"
+	lscosmoskeeper "github.com/merlin-network/estake-native/v2/x/lscosmos/keeper"
+	lselysiumkeeper "github.com/merlin-network/estake-native/v2/x/lselysium/keeper"
+)
+
+const (
+	flagDiffHeight = "diff-height"
+	flagInvariants = "invariants"
+)
+
+// debugCmd returns the sdk debug command extended with the offline state inspectors of the app.
+func debugCmd(encodingConfig params.EncodingConfig) *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(lscosmosStateCmd(encodingConfig))
+	return cmd
+}
+
+// lscosmosStateCmd returns the command dumping the lscosmos and lselysium state of a stopped node.
+func lscosmosStateCmd(encodingConfig params.EncodingConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lscosmos-state [height]",
+		Short: "Dump the lscosmos and lselysium state of a stopped node as JSON",
+		Long: `Open the application db of the node in --home read-only and print every lscosmos and lselysium
+store entry at the given height, the latest one if omitted, as decoded JSON.
+With --invariants the module invariants are run against the state, with --diff-height only the
+entries that changed between the two heights are printed. The node has to be stopped.`,
+		Example: `$ estaked debug lscosmos-state 1200 --invariants
+$ estaked debug lscosmos-state 1200 --diff-height 1300`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			var height int64
+			if len(args) == 1 {
+				var err error
+				height, err = strconv.ParseInt(args[0], 10, 64)
+				if err != nil || height <= 0 {
+					return fmt.Errorf("invalid height %s", args[0])
+				}
+			}
+			diffHeight, err := cmd.Flags().GetInt64(flagDiffHeight)
+			if err != nil {
+				return err
+			}
+			withInvariants, err := cmd.Flags().GetBool(flagInvariants)
+			if err != nil {
+				return err
+			}
+
+			db, err := openApplicationDBReadOnly(serverCtx.Config.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app := estakeApp.NeweStakeApp(
+				serverCtx.Logger, db, nil, false, map[int64]bool{},
+				serverCtx.Config.RootDir, 0, encodingConfig, serverCtx.Viper,
+			)
+			if err := app.LoadLatestVersion(); err != nil {
+				return err
+			}
+			if height == 0 {
+				height = app.LastBlockHeight()
+			}
+
+			report, err := newStateReport(app, height, withInvariants)
+			if err != nil {
+				return err
+			}
+
+			var out interface{} = report
+			if diffHeight != 0 {
+				diffReport, err := newStateReport(app, diffHeight, withInvariants)
+				if err != nil {
+					return err
+				}
+				out, err = diffStateReports(report, diffReport)
+				if err != nil {
+					return err
+				}
+			}
+
+			bz, err := json.MarshalIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagDiffHeight, 0, "Print the entries that changed between height and this height instead of the state")
+	cmd.Flags().Bool(flagInvariants, false, "Run the lscosmos and lselysium invariants against the state")
+
+	return cmd
+}
+
+// openApplicationDBReadOnly opens the application db in rootDir read-only. Only goleveldb supports
+// opening a db read-only, the other backends are refused so that the db of a node is never modified.
+func openApplicationDBReadOnly(rootDir string, backend dbm.BackendType) (dbm.DB, error) {
+	if backend != dbm.GoLevelDBBackend {
+		return nil, fmt.Errorf("the %s db backend can not be opened read-only, only %s is supported", backend, dbm.GoLevelDBBackend)
+	}
+	return dbm.NewGoLevelDBWithOpts("application", filepath.Join(rootDir, "data"), &opt.Options{ReadOnly: true})
+}
+
+// stateReport is the decoded lscosmos and lselysium state at a height, keyed by store entry.
+type stateReport struct {
+	Height     int64                      `json:"height"`
+	LSCosmos   map[string]json.RawMessage `json:"lscosmos"`
+	LSElysium  map[string]json.RawMessage `json:"lselysium"`
+	Invariants map[string]invariantResult `json:"invariants,omitempty"`
+}
+
+// invariantResult is the outcome of an invariant run offline.
+type invariantResult struct {
+	Broken  bool   `json:"broken"`
+	Message string `json:"message"`
+}
+
+// stateDiff holds the store entries that changed between two heights, keyed by their JSON path.
+type stateDiff struct {
+	Height     int64             `json:"height"`
+	DiffHeight int64             `json:"diff_height"`
+	Changes    map[string]change `json:"changes"`
+}
+
+// change is the value of a store entry at the two heights of a stateDiff, nil if it is missing.
+type change struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+// newStateReport decodes the lscosmos and lselysium stores of app at height. The state is read from
+// an immutable version of the stores, nothing is written back.
+func newStateReport(app *estakeApp.EstakeApp, height int64, withInvariants bool) (stateReport, error) {
+	cms, err := app.CommitMultiStore().CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return stateReport{}, fmt.Errorf("failed to load the state at height %d: %w", height, err)
+	}
+	ctx := sdk.NewContext(cms, tmproto.Header{Height: height}, false, app.Logger())
+	enc := &jsonEncoder{cdc: app.AppCodec()}
+
+	lscosmosKeeper := app.LSCosmosKeeper
+	lscosmosParams := lscosmosKeeper.GetParams(ctx)
+	hostChainParams := lscosmosKeeper.GetHostChainParams(ctx)
+	allowListedValidators := lscosmosKeeper.GetAllowListedValidators(ctx)
+	delegationState := lscosmosKeeper.GetDelegationState(ctx)
+	hostChainRewardAddress := lscosmosKeeper.GetHostChainRewardAddress(ctx)
+	ibcTransientStore := lscosmosKeeper.GetIBCTransientStore(ctx)
+	hostAccounts := lscosmosKeeper.GetHostAccounts(ctx)
+	var unbondingEpochCValues []json.RawMessage
+	for _, cValue := range lscosmosKeeper.IterateAllUnbondingEpochCValues(ctx) {
+		cValue := cValue
+		unbondingEpochCValues = append(unbondingEpochCValues, enc.msg(&cValue))
+	}
+	var delegatorUnbondingEpochEntries []json.RawMessage
+	for _, entry := range lscosmosKeeper.IterateAllDelegatorUnbondingEpochEntry(ctx) {
+		entry := entry
+		delegatorUnbondingEpochEntries = append(delegatorUnbondingEpochEntries, enc.msg(&entry))
+	}
+	inFlightICATxs, oldestICATx := lscosmosKeeper.GetInFlightICATxs(ctx)
+
+	report := stateReport{
+		Height: height,
+		LSCosmos: map[string]json.RawMessage{
+			"params":                            enc.msg(&lscosmosParams),
+			"module_enabled":                    enc.value(lscosmosKeeper.GetModuleState(ctx)),
+			"host_chain_params":                 enc.msg(&hostChainParams),
+			"allow_listed_validators":           enc.msg(&allowListedValidators),
+			"delegation_state":                  enc.msg(&delegationState),
+			"host_chain_reward_address":         enc.msg(&hostChainRewardAddress),
+			"ibc_transient_store":               enc.msg(&ibcTransientStore),
+			"unbonding_epoch_c_values":          enc.value(unbondingEpochCValues),
+			"delegator_unbonding_epoch_entries": enc.value(delegatorUnbondingEpochEntries),
+			"host_accounts":                     enc.msg(&hostAccounts),
+			"host_max_entries":                  enc.value(lscosmosKeeper.GetHostMaxEntries(ctx)),
+			"in_flight_ica_txs": enc.value(map[string]interface{}{
+				"count":  inFlightICATxs,
+				"oldest": oldestICATx,
+			}),
+			"c_value": enc.value(lscosmosKeeper.GetCValue(ctx)),
+		},
+	}
+
+	lselysiumKeeper := app.LSElysiumKeeper
+	lselysiumParams := lselysiumKeeper.GetParams(ctx)
+	netAmountState := lselysiumKeeper.GetNetAmountState(ctx)
+	var liquidValidators []json.RawMessage
+	for _, lv := range lselysiumKeeper.GetAllLiquidValidators(ctx) {
+		lv := lv
+		liquidValidators = append(liquidValidators, enc.msg(&lv))
+	}
+	var unbondingRequests []json.RawMessage
+	for _, req := range lselysiumKeeper.GetAllUnbondingRequests(ctx) {
+		req := req
+		unbondingRequests = append(unbondingRequests, enc.msg(&req))
+	}
+	var unbondCursor interface{}
+	if valAddr, found := lselysiumKeeper.GetUnbondCursor(ctx); found {
+		unbondCursor = valAddr.String()
+	}
+
+	report.LSElysium = map[string]json.RawMessage{
+		"params":                    enc.msg(&lselysiumParams),
+		"liquid_validators":         enc.value(liquidValidators),
+		"unbond_cursor":             enc.value(unbondCursor),
+		"unbonding_requests":        enc.value(unbondingRequests),
+		"last_unbonding_request_id": enc.value(lselysiumKeeper.GetLastUnbondingRequestID(ctx)),
+		"net_amount_state":          enc.msg(&netAmountState),
+	}
+	if enc.err != nil {
+		return stateReport{}, enc.err
+	}
+
+	if withInvariants {
+		registry := invariantRegistry{}
+		lscosmoskeeper.RegisterInvariants(registry, lscosmosKeeper)
+		lselysiumkeeper.RegisterInvariants(registry, lselysiumKeeper)
+
+		report.Invariants = make(map[string]invariantResult, len(registry))
+		for route, invariant := range registry {
+			msg, broken := invariant(ctx)
+			report.Invariants[route] = invariantResult{Broken: broken, Message: msg}
+		}
+	}
+
+	return report, nil
+}
+
+// diffStateReports returns the store entries that differ between from and to.
+func diffStateReports(from, to stateReport) (stateDiff, error) {
+	fromState, err := decodeReport(from)
+	if err != nil {
+		return stateDiff{}, err
+	}
+	toState, err := decodeReport(to)
+	if err != nil {
+		return stateDiff{}, err
+	}
+
+	diff := stateDiff{Height: from.Height, DiffHeight: to.Height, Changes: map[string]change{}}
+	diffValues("", fromState, toState, diff.Changes)
+	return diff, nil
+}
+
+// decodeReport decodes the state of report, without its height, into generic JSON values.
+func decodeReport(report stateReport) (map[string]interface{}, error) {
+	report.Height = 0
+	bz, err := json.Marshal(report)
+	if err != nil {
+		return nil, err
+	}
+	var state map[string]interface{}
+	if err := json.Unmarshal(bz, &state); err != nil {
+		return nil, err
+	}
+	delete(state, "height")
+	return state, nil
+}
+
+// diffValues records in changes every path below path at which from and to differ. Objects are
+// compared key by key, arrays element by element if they have the same length.
+func diffValues(path string, from, to interface{}, changes map[string]change) {
+	switch fromValue := from.(type) {
+	case map[string]interface{}:
+		if toValue, ok := to.(map[string]interface{}); ok {
+			for key, value := range fromValue {
+				diffValues(joinPath(path, key), value, toValue[key], changes)
+			}
+			for key, value := range toValue {
+				if _, found := fromValue[key]; !found {
+					changes[joinPath(path, key)] = change{To: value}
+				}
+			}
+			return
+		}
+	case []interface{}:
+		if toValue, ok := to.([]interface{}); ok && len(fromValue) == len(toValue) {
+			for i := range fromValue {
+				diffValues(fmt.Sprintf("%s[%d]", path, i), fromValue[i], toValue[i], changes)
+			}
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(from, to) {
+		changes[path] = change{From: from, To: to}
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// jsonEncoder encodes the store entries as JSON, keeping the first error.
+type jsonEncoder struct {
+	cdc codec.JSONCodec
+	err error
+}
+
+func (e *jsonEncoder) msg(msg proto.Message) json.RawMessage {
+	bz, err := e.cdc.MarshalJSON(msg)
+	if err != nil && e.err == nil {
+		e.err = err
+	}
+	return bz
+}
+
+func (e *jsonEncoder) value(v interface{}) json.RawMessage {
+	bz, err := json.Marshal(v)
+	if err != nil && e.err == nil {
+		e.err = err
+	}
+	return bz
+}
+
+// invariantRegistry collects the invariants registered by the modules to run them offline.
+type invariantRegistry map[string]sdk.Invariant
+
+// RegisterRoute implements sdk.InvariantRegistry.
+func (r invariantRegistry) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	r[moduleName+"/"+route] = invar
+}
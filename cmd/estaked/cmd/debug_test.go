@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/merlin-network/estake-native/v2/app/helpers"
+	lscosmostypes "github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+	lselysiumtypes "github.com/merlin-network/estake-native/v2/x/lselysium/types"
+)
+
+func TestDiffValues(t *testing.T) {
+	from := map[string]interface{}{
+		"unchanged": "a",
+		"changed":   "b",
+		"removed":   "c",
+		"nested":    map[string]interface{}{"list": []interface{}{"1", "2"}},
+		"resized":   []interface{}{"1"},
+	}
+	to := map[string]interface{}{
+		"unchanged": "a",
+		"changed":   "B",
+		"added":     "d",
+		"nested":    map[string]interface{}{"list": []interface{}{"1", "3"}},
+		"resized":   []interface{}{"1", "2"},
+	}
+
+	changes := map[string]change{}
+	diffValues("", from, to, changes)
+	require.Equal(t, map[string]change{
+		"changed":        {From: "b", To: "B"},
+		"removed":        {From: "c"},
+		"added":          {To: "d"},
+		"nested.list[1]": {From: "2", To: "3"},
+		"resized":        {From: []interface{}{"1"}, To: []interface{}{"1", "2"}},
+	}, changes)
+}
+
+func TestStateReport(t *testing.T) {
+	app := helpers.Setup(t, false, 5)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	ctx := app.BaseApp.NewContext(false, header)
+	app.LSCosmosKeeper.SetModuleState(ctx, true)
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	before, err := newStateReport(app, 1, true)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), before.Height)
+	require.JSONEq(t, "false", string(before.LSCosmos["module_enabled"]))
+	require.Contains(t, before.LSElysium, "liquid_validators")
+	require.Contains(t, before.Invariants, lscosmostypes.ModuleName+"/c-value-range")
+	require.Contains(t, before.Invariants, lselysiumtypes.ModuleName+"/net-amount")
+
+	after, err := newStateReport(app, 2, false)
+	require.NoError(t, err)
+	require.JSONEq(t, "true", string(after.LSCosmos["module_enabled"]))
+	require.Nil(t, after.Invariants)
+
+	diff, err := diffStateReports(before, after)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), diff.Height)
+	require.Equal(t, int64(2), diff.DiffHeight)
+	require.Equal(t, change{From: false, To: true}, diff.Changes["lscosmos.module_enabled"])
+
+	_, err = newStateReport(app, 3, false)
+	require.Error(t, err)
+}
+
+func TestOpenApplicationDBReadOnly(t *testing.T) {
+	rootDir := t.TempDir()
+	db, err := dbm.NewGoLevelDB("application", filepath.Join(rootDir, "data"))
+	require.NoError(t, err)
+	require.NoError(t, db.Set([]byte("key"), []byte("value")))
+	require.NoError(t, db.Close())
+
+	db, err = openApplicationDBReadOnly(rootDir, dbm.GoLevelDBBackend)
+	require.NoError(t, err)
+	value, err := db.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+	require.Error(t, db.Set([]byte("key"), []byte("changed")))
+	require.NoError(t, db.Close())
+
+	_, err = openApplicationDBReadOnly(rootDir, dbm.MemDBBackend)
+	require.EqualError(t, err, "the memdb db backend can not be opened read-only, only goleveldb is supported")
+}
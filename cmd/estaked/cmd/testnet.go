@@ -59,6 +59,14 @@ Note, strict routability for addresses is turned off in the config file.
 
 Example:
 	estaked testnet --v 4 --output-dir ./output --starting-ip-address 192.168.10.2
+
+With --with-host-chain, a host chain is initialized next to the testnet in the "host" directory. The
+testnet genesis is seeded with the lscosmos host chain params and the host validators allow listed,
+relayer configs are written in the "relayer" directory and jump-start.sh jump starts lscosmos once
+the transfer channel is open.
+
+Example:
+	estaked testnet --v 1 --with-host-chain --host-v 2 --keyring-backend test --output-dir ./output
 	`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -77,11 +85,43 @@ Example:
 			startingIPAddress, _ := cmd.Flags().GetString(flagStartingIPAddress)
 			numValidators, _ := cmd.Flags().GetInt(flagNumValidators)
 			algo, _ := cmd.Flags().GetString(flags.FlagKeyAlgorithm)
+			withHostChain, _ := cmd.Flags().GetBool(flagWithHostChain)
+
+			if chainID == "" {
+				chainID = "chain-" + tmrand.NewRand().Str(6)
+			}
 
-			return InitTestnet(
+			err = InitTestnet(
 				clientCtx, cmd, config, mbm, genBalIterator, outputDir, chainID, minGasPrices,
 				nodeDirPrefix, nodeDaemonHome, startingIPAddress, keyringBackend, algo, numValidators,
 			)
+			if err != nil || !withHostChain {
+				return err
+			}
+
+			hostChainID, _ := cmd.Flags().GetString(flagHostChainID)
+			hostBaseDenom, _ := cmd.Flags().GetString(flagHostBaseDenom)
+			hostNodeDaemonHome, _ := cmd.Flags().GetString(flagHostNodeDaemonHome)
+			hostStartingIPAddress, _ := cmd.Flags().GetString(flagHostStartingIPAddress)
+			hostNumValidators, _ := cmd.Flags().GetInt(flagHostNumValidators)
+			epochDuration, _ := cmd.Flags().GetDuration(flagEpochDuration)
+
+			return InitHostChainTestnet(clientCtx, cmd, mbm, hostChainTestnetConfig{
+				OutputDir:             outputDir,
+				ChainID:               chainID,
+				NodeDirPrefix:         nodeDirPrefix,
+				NodeDaemonHome:        nodeDaemonHome,
+				StartingIPAddress:     startingIPAddress,
+				NumValidators:         numValidators,
+				KeyringBackend:        keyringBackend,
+				Algo:                  algo,
+				HostChainID:           hostChainID,
+				HostBaseDenom:         hostBaseDenom,
+				HostNodeDaemonHome:    hostNodeDaemonHome,
+				HostStartingIPAddress: hostStartingIPAddress,
+				HostNumValidators:     hostNumValidators,
+				EpochDuration:         epochDuration,
+			})
 		},
 	}
 
@@ -94,6 +134,7 @@ Example:
 	cmd.Flags().String(server.FlagMinGasPrices, fmt.Sprintf("0.000006%s", sdk.DefaultBondDenom), "Minimum gas prices to accept for transactions; All fees in a tx must meet this minimum (e.g. 0.01photino,0.001stake)")
 	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
 	cmd.Flags().String(flags.FlagKeyAlgorithm, string(hd.Secp256k1Type), "Key signing algorithm to generate keys for")
+	addHostChainFlags(cmd)
 
 	return cmd
 }
@@ -0,0 +1,880 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/spf13/cobra"
+	tmconfig "github.com/tendermint/tendermint/config"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	srvconfig "github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icagenesistypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/genesis/types"
+	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	ibchost "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/client/utils"
+	lscosmostypes "github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+var (
+	flagWithHostChain         = "with-host-chain"
+	flagHostChainID           = "host-chain-id"
+	flagHostBaseDenom         = "host-base-denom"
+	flagHostNumValidators     = "host-v"
+	flagHostNodeDaemonHome    = "host-node-daemon-home"
+	flagHostStartingIPAddress = "host-starting-ip-address"
+	flagEpochDuration         = "epoch-duration"
+)
+
+const (
+	hostChainDir             = "host"
+	relayerDir               = "relayer"
+	hostChainAccountPrefix   = "cosmos"
+	hostChainConnectionID    = "connection-0"
+	hostChainTransferChannel = "channel-0"
+	hostChainHDPath          = "m/44'/118'/0'/0/0"
+
+	// epochsModuleName is the genesis key of the epochs module driving the lscosmos delegation, reward and
+	// undelegation epochs
+	epochsModuleName = "epochs"
+)
+
+// hostChainGenesisModules are the modules of the controller chain also run by the host chain, the host chain
+// genesis is made of their default genesis.
+var hostChainGenesisModules = []string{
+	authtypes.ModuleName,
+	banktypes.ModuleName,
+	stakingtypes.ModuleName,
+	distributiontypes.ModuleName,
+	slashingtypes.ModuleName,
+	govtypes.ModuleName,
+	minttypes.ModuleName,
+	crisistypes.ModuleName,
+	capabilitytypes.ModuleName,
+	ibchost.ModuleName,
+	ibctransfertypes.ModuleName,
+	icatypes.ModuleName,
+	genutiltypes.ModuleName,
+}
+
+// hostChainAllowMessages are the msgs lscosmos executes through its interchain accounts on the host chain
+var hostChainAllowMessages = []string{
+	sdk.MsgTypeURL(&banktypes.MsgSend{}),
+	sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+	sdk.MsgTypeURL(&distributiontypes.MsgWithdrawDelegatorReward{}),
+	sdk.MsgTypeURL(&distributiontypes.MsgSetWithdrawAddress{}),
+	sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{}),
+}
+
+// hostChainTestnetConfig defines the layout of the controller testnet and the host chain paired to it
+type hostChainTestnetConfig struct {
+	OutputDir         string
+	ChainID           string
+	NodeDirPrefix     string
+	NodeDaemonHome    string
+	StartingIPAddress string
+	NumValidators     int
+	KeyringBackend    string
+	Algo              string
+
+	HostChainID           string
+	HostBaseDenom         string
+	HostNodeDaemonHome    string
+	HostStartingIPAddress string
+	HostNumValidators     int
+	EpochDuration         time.Duration
+}
+
+// hostChainValidator is a validator of the host chain, allow listed by the controller chain
+type hostChainValidator struct {
+	AccAddress       sdk.AccAddress
+	ValidatorAddress string
+	Tokens           sdk.Int
+	PubKey           codectypes.Any
+	Moniker          string
+}
+
+// relayerKey is a relayer account, saved in the format expected by the relayers keys files
+type relayerKey struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Address  string `json:"address"`
+	PubKey   string `json:"pubkey"`
+	Mnemonic string `json:"mnemonic"`
+
+	accAddress sdk.AccAddress
+}
+
+// relayerChain is a chain entry of the relayers keys files
+type relayerChain struct {
+	Name   string       `json:"name"`
+	ID     string       `json:"id"`
+	HDPath string       `json:"hdpath"`
+	Port   string       `json:"port"`
+	Keys   []relayerKey `json:"keys"`
+}
+
+// addHostChainFlags adds the flags of the host chain paired to the testnet
+func addHostChainFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(flagWithHostChain, false, "Pair the testnet with a host chain, relayer configs and a lscosmos jump start script")
+	cmd.Flags().String(flagHostChainID, "gaia-1", "Host chain genesis file chain-id")
+	cmd.Flags().String(flagHostBaseDenom, "uatom", "Host chain staking denom, liquid staked by lscosmos")
+	cmd.Flags().Int(flagHostNumValidators, 2, "Number of validators to initialize the host chain with, all of them are allow listed")
+	cmd.Flags().String(flagHostNodeDaemonHome, "gaiad", "Home directory of the host chain node's daemon configuration")
+	cmd.Flags().String(flagHostStartingIPAddress, "192.168.1.1", "Starting IP address of the host chain nodes")
+	cmd.Flags().Duration(flagEpochDuration, 90*time.Second, "Duration of the lscosmos delegation, reward and undelegation epochs")
+}
+
+// InitHostChainTestnet initializes a host chain paired to the testnet created by InitTestnet. The controller genesis
+// is seeded with the lscosmos host chain params, the host chain validators allow listed and short epochs, the host
+// genesis allows the lscosmos interchain accounts msgs. Relayer configs and a jump start script are written in the
+// output directory.
+func InitHostChainTestnet(clientCtx client.Context, cmd *cobra.Command, mbm module.BasicManager, cfg hostChainTestnetConfig) error {
+	keyringAlgos, _ := keyring.New(sdk.KeyringServiceName(), keyring.BackendMemory, "", nil, clientCtx.Codec)
+	supportedAlgos, _ := keyringAlgos.SupportedAlgorithms()
+	algo, err := keyring.NewSigningAlgoFromString(cfg.Algo, supportedAlgos)
+	if err != nil {
+		return err
+	}
+
+	relayerKeyring := keyring.NewInMemory(clientCtx.Codec)
+	controllerRelayer, err := newRelayerKey(clientCtx.Codec, relayerKeyring, "estake", algo, sdk.AccAddress.String)
+	if err != nil {
+		return err
+	}
+	icqRelayer, err := newRelayerKey(clientCtx.Codec, relayerKeyring, "estake-icq", algo, sdk.AccAddress.String)
+	if err != nil {
+		return err
+	}
+	hostRelayer, err := newRelayerKey(clientCtx.Codec, relayerKeyring, "host", algo, hostChainAddress)
+	if err != nil {
+		return err
+	}
+
+	hostValidators, err := initHostChain(clientCtx, cmd, mbm, cfg, hostRelayer, algo)
+	if err != nil {
+		return err
+	}
+
+	estakeFeeAddress, err := seedControllerGenesis(clientCtx, cmd, cfg, hostValidators, controllerRelayer, icqRelayer)
+	if err != nil {
+		return err
+	}
+
+	if err := writeRelayerConfigs(clientCtx, cfg, controllerRelayer, icqRelayer, hostRelayer, algo); err != nil {
+		return err
+	}
+
+	if err := writeJumpStart(clientCtx, cfg, hostValidators, estakeFeeAddress); err != nil {
+		return err
+	}
+
+	cmd.PrintErrf("Successfully initialized host chain %s with %d node directories\n", cfg.HostChainID, cfg.HostNumValidators)
+	return nil
+}
+
+// initHostChain creates the host chain node directories and genesis, validators are set in the staking genesis as
+// the host chain binary cannot sign gentxs with the controller chain bech32 prefixes
+func initHostChain(
+	clientCtx client.Context, cmd *cobra.Command, mbm module.BasicManager, cfg hostChainTestnetConfig,
+	hostRelayer relayerKey, algo keyring.SignatureAlgo,
+) ([]hostChainValidator, error) {
+	var (
+		validators  []hostChainValidator
+		genAccounts []authtypes.GenesisAccount
+		genBalances []banktypes.Balance
+		nodeConfigs []*tmconfig.Config
+		peers       []string
+	)
+
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	for i := 0; i < cfg.HostNumValidators; i++ {
+		nodeDirName := fmt.Sprintf("%s%d", cfg.NodeDirPrefix, i)
+		nodeDir := filepath.Join(cfg.OutputDir, hostChainDir, nodeDirName, cfg.HostNodeDaemonHome)
+
+		nodeConfig := tmconfig.DefaultConfig()
+		nodeConfig.SetRoot(nodeDir)
+		nodeConfig.Moniker = nodeDirName
+		nodeConfig.RPC.ListenAddress = "tcp://0.0.0.0:26657"
+		nodeConfig.P2P.AddrBookStrict = false
+		nodeConfig.P2P.AllowDuplicateIP = true
+
+		if err := os.MkdirAll(filepath.Join(nodeDir, "config"), nodeDirPerm); err != nil {
+			return nil, err
+		}
+
+		ip, err := getIP(i, cfg.HostStartingIPAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		nodeID, valPubKey, err := genutil.InitializeNodeValidatorFiles(nodeConfig)
+		if err != nil {
+			return nil, err
+		}
+		peers = append(peers, fmt.Sprintf("%s@%s:26656", nodeID, ip))
+		nodeConfigs = append(nodeConfigs, nodeConfig)
+
+		kb, err := keyring.New(sdk.KeyringServiceName(), cfg.KeyringBackend, nodeDir, inBuf, clientCtx.Codec)
+		if err != nil {
+			return nil, err
+		}
+		addr, secret, err := testutil.GenerateSaveCoinKey(kb, nodeDirName, "", true, algo)
+		if err != nil {
+			return nil, err
+		}
+		cliPrint, err := json.Marshal(map[string]string{"secret": secret})
+		if err != nil {
+			return nil, err
+		}
+		// save private key seed words
+		if err := writeFile(fmt.Sprintf("%v.json", "key_seed"), nodeDir, cliPrint); err != nil {
+			return nil, err
+		}
+
+		valAddress, err := lscosmostypes.Bech32FromValAddress(sdk.ValAddress(addr), lscosmostypes.CosmosValOperPrefix)
+		if err != nil {
+			return nil, err
+		}
+		pubKey, err := codectypes.NewAnyWithValue(valPubKey)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, hostChainValidator{
+			AccAddress:       addr,
+			ValidatorAddress: valAddress,
+			Tokens:           sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction),
+			PubKey:           *pubKey,
+			Moniker:          nodeDirName,
+		})
+
+		genAccounts = append(genAccounts, authtypes.NewBaseAccount(addr, nil, 0, 0))
+		genBalances = append(genBalances, banktypes.Balance{
+			Address: hostChainAddress(addr),
+			Coins:   sdk.NewCoins(sdk.NewCoin(cfg.HostBaseDenom, sdk.TokensFromConsensusPower(1000, sdk.DefaultPowerReduction))),
+		})
+	}
+
+	genAccounts = append(genAccounts, authtypes.NewBaseAccount(hostRelayer.accAddress, nil, 0, 0))
+	genBalances = append(genBalances, banktypes.Balance{
+		Address: hostRelayer.Address,
+		Coins:   sdk.NewCoins(sdk.NewCoin(cfg.HostBaseDenom, sdk.TokensFromConsensusPower(1000, sdk.DefaultPowerReduction))),
+	})
+
+	appGenState, err := hostChainGenesis(clientCtx.Codec, mbm, cfg.HostBaseDenom, validators, genAccounts, genBalances)
+	if err != nil {
+		return nil, err
+	}
+	appGenStateJSON, err := json.MarshalIndent(appGenState, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	genDoc := tmtypes.GenesisDoc{
+		ChainID:     cfg.HostChainID,
+		GenesisTime: tmtime.Now(),
+		AppState:    appGenStateJSON,
+	}
+
+	appConfig := srvconfig.DefaultConfig()
+	appConfig.MinGasPrices = fmt.Sprintf("0%s", cfg.HostBaseDenom)
+	appConfig.API.Enable = true
+	for i, nodeConfig := range nodeConfigs {
+		var persistentPeers []string
+		for j, peer := range peers {
+			if i != j {
+				persistentPeers = append(persistentPeers, peer)
+			}
+		}
+		nodeConfig.P2P.PersistentPeers = strings.Join(persistentPeers, ",")
+
+		tmconfig.WriteConfigFile(filepath.Join(nodeConfig.RootDir, "config", "config.toml"), nodeConfig)
+		srvconfig.WriteConfigFile(filepath.Join(nodeConfig.RootDir, "config", "app.toml"), appConfig)
+		if err := genDoc.SaveAs(nodeConfig.GenesisFile()); err != nil {
+			return nil, err
+		}
+	}
+
+	return validators, nil
+}
+
+// hostChainGenesis returns the host chain app genesis state, accounts and validators are encoded with the host
+// chain bech32 prefixes
+func hostChainGenesis(
+	cdc codec.JSONCodec, mbm module.BasicManager, denom string, validators []hostChainValidator,
+	genAccounts []authtypes.GenesisAccount, genBalances []banktypes.Balance,
+) (map[string]json.RawMessage, error) {
+	defaultGenState := mbm.DefaultGenesis(cdc)
+	appGenState := make(map[string]json.RawMessage, len(hostChainGenesisModules))
+	for _, moduleName := range hostChainGenesisModules {
+		appGenState[moduleName] = defaultGenState[moduleName]
+	}
+
+	for _, genAccount := range genAccounts {
+		baseAccount, ok := genAccount.(*authtypes.BaseAccount)
+		if !ok {
+			return nil, fmt.Errorf("unexpected genesis account type %T", genAccount)
+		}
+		baseAccount.Address = hostChainAddress(baseAccount.GetAddress())
+	}
+	var authGenState authtypes.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[authtypes.ModuleName], &authGenState)
+	accounts, err := authtypes.PackAccounts(genAccounts)
+	if err != nil {
+		return nil, err
+	}
+	authGenState.Accounts = accounts
+	appGenState[authtypes.ModuleName] = cdc.MustMarshalJSON(&authGenState)
+
+	// validators are created unbonded with their self delegation in the not bonded pool, they are bonded by the
+	// staking module at genesis
+	var stakingGenState stakingtypes.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[stakingtypes.ModuleName], &stakingGenState)
+	stakingGenState.Params.BondDenom = denom
+	notBondedTokens := sdk.ZeroInt()
+	for _, validator := range validators {
+		stakingGenState.Validators = append(stakingGenState.Validators, stakingtypes.Validator{
+			OperatorAddress:   validator.ValidatorAddress,
+			ConsensusPubkey:   &validator.PubKey,
+			Status:            stakingtypes.Unbonded,
+			Tokens:            validator.Tokens,
+			DelegatorShares:   sdk.NewDecFromInt(validator.Tokens),
+			Description:       stakingtypes.NewDescription(validator.Moniker, "", "", "", ""),
+			Commission:        stakingtypes.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2)),
+			MinSelfDelegation: sdk.OneInt(),
+		})
+		stakingGenState.Delegations = append(stakingGenState.Delegations, stakingtypes.Delegation{
+			DelegatorAddress: hostChainAddress(validator.AccAddress),
+			ValidatorAddress: validator.ValidatorAddress,
+			Shares:           sdk.NewDecFromInt(validator.Tokens),
+		})
+		notBondedTokens = notBondedTokens.Add(validator.Tokens)
+	}
+	appGenState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(&stakingGenState)
+
+	genBalances = append(genBalances, banktypes.Balance{
+		Address: hostChainAddress(authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName)),
+		Coins:   sdk.NewCoins(sdk.NewCoin(denom, notBondedTokens)),
+	})
+	var bankGenState banktypes.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[banktypes.ModuleName], &bankGenState)
+	bankGenState.Balances = genBalances
+	for _, bal := range bankGenState.Balances {
+		bankGenState.Supply = bankGenState.Supply.Add(bal.Coins...)
+	}
+	appGenState[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenState)
+
+	var mintGenState minttypes.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[minttypes.ModuleName], &mintGenState)
+	mintGenState.Params.MintDenom = denom
+	appGenState[minttypes.ModuleName] = cdc.MustMarshalJSON(&mintGenState)
+
+	var crisisGenState crisistypes.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[crisistypes.ModuleName], &crisisGenState)
+	crisisGenState.ConstantFee.Denom = denom
+	appGenState[crisistypes.ModuleName] = cdc.MustMarshalJSON(&crisisGenState)
+
+	var govGenState govv1.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[govtypes.ModuleName], &govGenState)
+	govGenState.DepositParams.MinDeposit = sdk.NewCoins(sdk.NewCoin(denom, govGenState.DepositParams.MinDeposit.AmountOf(sdk.DefaultBondDenom)))
+	appGenState[govtypes.ModuleName] = cdc.MustMarshalJSON(&govGenState)
+
+	var icaGenState icagenesistypes.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[icatypes.ModuleName], &icaGenState)
+	icaGenState.HostGenesisState.Params = icahosttypes.NewParams(true, hostChainAllowMessages)
+	appGenState[icatypes.ModuleName] = cdc.MustMarshalJSON(&icaGenState)
+
+	return appGenState, nil
+}
+
+// seedControllerGenesis sets the lscosmos host chain params and allow listed validators in the genesis of the
+// controller nodes, shortens the epochs and funds the relayers. It returns the estake fee address, allowed to
+// jump start the module.
+func seedControllerGenesis(
+	clientCtx client.Context, cmd *cobra.Command, cfg hostChainTestnetConfig, hostValidators []hostChainValidator,
+	relayers ...relayerKey,
+) (sdk.AccAddress, error) {
+	nodeDirName := fmt.Sprintf("%s0", cfg.NodeDirPrefix)
+	nodeDir := filepath.Join(cfg.OutputDir, nodeDirName, cfg.NodeDaemonHome)
+	kb, err := keyring.New(sdk.KeyringServiceName(), cfg.KeyringBackend, nodeDir, bufio.NewReader(cmd.InOrStdin()), clientCtx.Codec)
+	if err != nil {
+		return nil, err
+	}
+	record, err := kb.Key(nodeDirName)
+	if err != nil {
+		return nil, err
+	}
+	estakeFeeAddress, err := record.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	genFile := filepath.Join(nodeDir, "config", "genesis.json")
+	genDoc, err := tmtypes.GenesisDocFromFile(genFile)
+	if err != nil {
+		return nil, err
+	}
+	var appGenState map[string]json.RawMessage
+	if err := json.Unmarshal(genDoc.AppState, &appGenState); err != nil {
+		return nil, err
+	}
+
+	lscosmosGenState := lscosmostypes.DefaultGenesis()
+	clientCtx.Codec.MustUnmarshalJSON(appGenState[lscosmostypes.ModuleName], lscosmosGenState)
+	lscosmosGenState.HostChainParams = newHostChainParams(cfg, estakeFeeAddress)
+	lscosmosGenState.AllowListedValidators = newAllowListedValidators(hostValidators)
+	if err := lscosmosGenState.Validate(); err != nil {
+		return nil, err
+	}
+	appGenState[lscosmostypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(lscosmosGenState)
+
+	epochsGenState, err := setEpochsDuration(appGenState[epochsModuleName], cfg.EpochDuration)
+	if err != nil {
+		return nil, err
+	}
+	appGenState[epochsModuleName] = epochsGenState
+
+	var authGenState authtypes.GenesisState
+	clientCtx.Codec.MustUnmarshalJSON(appGenState[authtypes.ModuleName], &authGenState)
+	var bankGenState banktypes.GenesisState
+	clientCtx.Codec.MustUnmarshalJSON(appGenState[banktypes.ModuleName], &bankGenState)
+	for _, relayer := range relayers {
+		account, err := codectypes.NewAnyWithValue(authtypes.NewBaseAccount(relayer.accAddress, nil, 0, 0))
+		if err != nil {
+			return nil, err
+		}
+		authGenState.Accounts = append(authGenState.Accounts, account)
+
+		coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1000, sdk.DefaultPowerReduction)))
+		bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: relayer.Address, Coins: coins})
+		bankGenState.Supply = bankGenState.Supply.Add(coins...)
+	}
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+	appGenState[authtypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&authGenState)
+	appGenState[banktypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&bankGenState)
+
+	genDoc.AppState, err = json.MarshalIndent(appGenState, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < cfg.NumValidators; i++ {
+		genFile := filepath.Join(cfg.OutputDir, fmt.Sprintf("%s%d", cfg.NodeDirPrefix, i), cfg.NodeDaemonHome, "config", "genesis.json")
+		if err := genDoc.SaveAs(genFile); err != nil {
+			return nil, err
+		}
+	}
+
+	return estakeFeeAddress, nil
+}
+
+// newHostChainParams returns the lscosmos host chain params of the host chain, the connection and transfer channel
+// are the first ones opened by the relayer
+func newHostChainParams(cfg hostChainTestnetConfig, estakeFeeAddress sdk.AccAddress) lscosmostypes.HostChainParams {
+	return lscosmostypes.NewHostChainParams(
+		cfg.HostChainID, hostChainConnectionID, hostChainTransferChannel, ibctransfertypes.PortID,
+		cfg.HostBaseDenom, lscosmostypes.ConvertBaseDenomToMintDenom(cfg.HostBaseDenom), estakeFeeAddress.String(),
+		sdk.NewInt(5), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(),
+	)
+}
+
+// newAllowListedValidators returns the host chain validators with equal target weights, the last one taking the
+// remainder so that the weights sum to one
+func newAllowListedValidators(hostValidators []hostChainValidator) lscosmostypes.AllowListedValidators {
+	weight := sdk.OneDec().QuoInt64(int64(len(hostValidators)))
+	remainder := sdk.OneDec()
+	var allowListedValidators []lscosmostypes.AllowListedValidator
+	for i, validator := range hostValidators {
+		if i == len(hostValidators)-1 {
+			weight = remainder
+		}
+		allowListedValidators = append(allowListedValidators, lscosmostypes.AllowListedValidator{
+			ValidatorAddress: validator.ValidatorAddress,
+			TargetWeight:     weight,
+		})
+		remainder = remainder.Sub(weight)
+	}
+	return lscosmostypes.AllowListedValidators{AllowListedValidators: allowListedValidators}
+}
+
+// setEpochsDuration sets the duration of the lscosmos epochs in the epochs genesis state
+func setEpochsDuration(bz json.RawMessage, duration time.Duration) (json.RawMessage, error) {
+	var epochsGenState map[string]interface{}
+	if err := json.Unmarshal(bz, &epochsGenState); err != nil {
+		return nil, err
+	}
+	epochs, ok := epochsGenState["epochs"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid %s genesis state", epochsModuleName)
+	}
+	lscosmosEpochs := map[string]bool{
+		lscosmostypes.DelegationEpochIdentifier:   true,
+		lscosmostypes.RewardEpochIdentifier:       true,
+		lscosmostypes.UndelegationEpochIdentifier: true,
+	}
+	for _, epoch := range epochs {
+		epochInfo, ok := epoch.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid %s genesis state", epochsModuleName)
+		}
+		if identifier, _ := epochInfo["identifier"].(string); lscosmosEpochs[identifier] {
+			epochInfo["duration"] = fmt.Sprintf("%ds", int64(duration.Seconds()))
+		}
+	}
+	return json.Marshal(epochsGenState)
+}
+
+// newRelayerKey creates a relayer account in the keyring, address is encoded with the chain bech32 prefix
+func newRelayerKey(
+	cdc codec.Codec, kb keyring.Keyring, name string, algo keyring.SignatureAlgo, encodeAddress func(sdk.AccAddress) string,
+) (relayerKey, error) {
+	addr, mnemonic, err := testutil.GenerateSaveCoinKey(kb, name, "", true, algo)
+	if err != nil {
+		return relayerKey{}, err
+	}
+	record, err := kb.Key(name)
+	if err != nil {
+		return relayerKey{}, err
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return relayerKey{}, err
+	}
+	pubKeyJSON, err := cdc.MarshalInterfaceJSON(pubKey)
+	if err != nil {
+		return relayerKey{}, err
+	}
+	return relayerKey{
+		Name:       name,
+		Type:       "local",
+		Address:    encodeAddress(addr),
+		PubKey:     string(pubKeyJSON),
+		Mnemonic:   mnemonic,
+		accAddress: addr,
+	}, nil
+}
+
+// hostChainAddress encodes an address with the host chain bech32 prefix
+func hostChainAddress(addr sdk.AccAddress) string {
+	address, err := bech32.ConvertAndEncode(hostChainAccountPrefix, addr)
+	if err != nil {
+		panic(err)
+	}
+	return address
+}
+
+// writeRelayerConfigs writes the hermes config and keys, and the interchain queries relayer config with its keyring
+func writeRelayerConfigs(
+	clientCtx client.Context, cfg hostChainTestnetConfig, controllerRelayer, icqRelayer, hostRelayer relayerKey,
+	algo keyring.SignatureAlgo,
+) error {
+	dir := filepath.Join(cfg.OutputDir, relayerDir)
+
+	controllerIP, err := getIP(0, cfg.StartingIPAddress)
+	if err != nil {
+		return err
+	}
+	hostIP, err := getIP(0, cfg.HostStartingIPAddress)
+	if err != nil {
+		return err
+	}
+	chains := struct {
+		Controller relayerConfigChain
+		Host       relayerConfigChain
+	}{
+		Controller: relayerConfigChain{
+			ID: cfg.ChainID, IP: controllerIP, AccountPrefix: sdk.GetConfig().GetBech32AccountAddrPrefix(),
+			Denom: sdk.DefaultBondDenom, Key: controllerRelayer.Name, ICQKey: icqRelayer.Name,
+		},
+		Host: relayerConfigChain{
+			ID: cfg.HostChainID, IP: hostIP, AccountPrefix: hostChainAccountPrefix,
+			Denom: cfg.HostBaseDenom, Key: hostRelayer.Name,
+		},
+	}
+
+	for name, tmpl := range map[string]*template.Template{
+		"hermes.toml":     hermesConfigTemplate,
+		"icq-config.yaml": icqConfigTemplate,
+		"hermes.sh":       hermesScriptTemplate,
+	} {
+		var buffer bytes.Buffer
+		if err := tmpl.Execute(&buffer, chains); err != nil {
+			return err
+		}
+		if err := writeFile(name, dir, buffer.Bytes()); err != nil {
+			return err
+		}
+	}
+	if err := os.Chmod(filepath.Join(dir, "hermes.sh"), 0o755); err != nil {
+		return err
+	}
+
+	keys, err := json.MarshalIndent(map[string][]relayerChain{"chains": {
+		{Name: "estake", ID: cfg.ChainID, HDPath: hostChainHDPath, Port: ibctransfertypes.PortID, Keys: []relayerKey{controllerRelayer}},
+		{Name: "host", ID: cfg.HostChainID, HDPath: hostChainHDPath, Port: ibctransfertypes.PortID, Keys: []relayerKey{hostRelayer}},
+	}}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeFile("keys.json", dir, keys); err != nil {
+		return err
+	}
+
+	// the interchain queries relayer reads its keys from a test keyring
+	icqKeyring, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, filepath.Join(dir, "icq", "keys"), nil, clientCtx.Codec)
+	if err != nil {
+		return err
+	}
+	for _, key := range []relayerKey{icqRelayer, hostRelayer} {
+		if _, err := icqKeyring.NewAccount(key.Name, key.Mnemonic, "", hostChainHDPath, algo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeJumpStart writes the lscosmos jump start msg details and the script broadcasting it once the transfer
+// channel with the host chain is open
+func writeJumpStart(clientCtx client.Context, cfg hostChainTestnetConfig, hostValidators []hostChainValidator, estakeFeeAddress sdk.AccAddress) error {
+	hostChainParams := newHostChainParams(cfg, estakeFeeAddress)
+	jumpStart := utils.JumpstartTxnJSON{
+		ChainID:               hostChainParams.ChainID,
+		ConnectionID:          hostChainParams.ConnectionID,
+		TransferChannel:       hostChainParams.TransferChannel,
+		TransferPort:          hostChainParams.TransferPort,
+		BaseDenom:             hostChainParams.BaseDenom,
+		MintDenom:             hostChainParams.MintDenom,
+		MinDeposit:            hostChainParams.MinDeposit.String(),
+		AllowListedValidators: newAllowListedValidators(hostValidators),
+		EstakeParams: utils.EstakeParams{
+			EstakeDepositFee:    hostChainParams.EstakeParams.EstakeDepositFee.String(),
+			EstakeRestakeFee:    hostChainParams.EstakeParams.EstakeRestakeFee.String(),
+			EstakeUnstakeFee:    hostChainParams.EstakeParams.EstakeUnstakeFee.String(),
+			EstakeRedemptionFee: hostChainParams.EstakeParams.EstakeRedemptionFee.String(),
+			EstakeFeeAddress:    hostChainParams.EstakeParams.EstakeFeeAddress,
+		},
+		HostAccounts: lscosmostypes.DefaultGenesis().HostAccounts,
+	}
+	bz, err := clientCtx.LegacyAmino.MarshalJSONIndent(jumpStart, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeFile("jumpstart.json", cfg.OutputDir, bz); err != nil {
+		return err
+	}
+
+	controllerIP, err := getIP(0, cfg.StartingIPAddress)
+	if err != nil {
+		return err
+	}
+	var buffer bytes.Buffer
+	err = jumpStartScriptTemplate.Execute(&buffer, map[string]string{
+		"ChainID":         cfg.ChainID,
+		"IP":              controllerIP,
+		"Key":             fmt.Sprintf("%s0", cfg.NodeDirPrefix),
+		"Home":            filepath.Join(fmt.Sprintf("%s0", cfg.NodeDirPrefix), cfg.NodeDaemonHome),
+		"KeyringBackend":  cfg.KeyringBackend,
+		"TransferPort":    hostChainParams.TransferPort,
+		"TransferChannel": hostChainParams.TransferChannel,
+	})
+	if err != nil {
+		return err
+	}
+	if err := writeFile("jump-start.sh", cfg.OutputDir, buffer.Bytes()); err != nil {
+		return err
+	}
+	return os.Chmod(filepath.Join(cfg.OutputDir, "jump-start.sh"), 0o755)
+}
+
+// relayerConfigChain is a chain of the relayer config templates
+type relayerConfigChain struct {
+	ID            string
+	IP            string
+	AccountPrefix string
+	Denom         string
+	Key           string
+	ICQKey        string
+}
+
+var hermesConfigTemplate = template.Must(template.New("hermes").Parse(`[global]
+log_level = 'info'
+
+[mode.clients]
+enabled = true
+refresh = true
+misbehaviour = true
+
+[mode.connections]
+enabled = true
+
+[mode.channels]
+enabled = true
+
+[mode.packets]
+enabled = true
+clear_interval = 100
+clear_on_start = true
+tx_confirmation = true
+
+[rest]
+enabled = true
+host = '127.0.0.1'
+port = 3000
+
+[telemetry]
+enabled = true
+host = '127.0.0.1'
+port = 3001
+[[chains]]
+id = '{{ .Host.ID }}'
+rpc_addr = 'http://{{ .Host.IP }}:26657'
+grpc_addr = 'http://{{ .Host.IP }}:9090'
+websocket_addr = 'ws://{{ .Host.IP }}:26657/websocket'
+rpc_timeout = '10s'
+account_prefix = '{{ .Host.AccountPrefix }}'
+key_name = '{{ .Host.Key }}'
+store_prefix = 'ibc'
+default_gas = 500000
+max_gas = 1000000
+gas_price = { price = 0.001, denom = '{{ .Host.Denom }}' }
+gas_multiplier = 1.5
+max_msg_num = 30
+max_tx_size = 2097152
+clock_drift = '5s'
+max_block_time = '30s'
+trusting_period = '200s'
+trust_threshold = { numerator = '1', denominator = '3' }
+address_type = { derivation = 'cosmos' }
+
+[[chains]]
+id = '{{ .Controller.ID }}'
+rpc_addr = 'http://{{ .Controller.IP }}:26657'
+grpc_addr = 'http://{{ .Controller.IP }}:9090'
+websocket_addr = 'ws://{{ .Controller.IP }}:26657/websocket'
+rpc_timeout = '10s'
+account_prefix = '{{ .Controller.AccountPrefix }}'
+key_name = '{{ .Controller.Key }}'
+store_prefix = 'ibc'
+default_gas = 500000
+max_gas = 1000000
+gas_price = { price = 0.001, denom = '{{ .Controller.Denom }}' }
+gas_multiplier = 1.5
+max_msg_num = 30
+max_tx_size = 2097152
+clock_drift = '5s'
+max_block_time = '30s'
+trusting_period = '200s'
+trust_threshold = { numerator = '1', denominator = '3' }
+address_type = { derivation = 'cosmos' }
+`))
+
+var icqConfigTemplate = template.Must(template.New("icq").Parse(`default_chain: estake
+chains:
+  estake:
+    key: {{ .Controller.ICQKey }}
+    chain-id: {{ .Controller.ID }}
+    rpc-addr: http://{{ .Controller.IP }}:26657
+    grpc-addr: http://{{ .Controller.IP }}:9090
+    account-prefix: {{ .Controller.AccountPrefix }}
+    keyring-backend: test
+    gas-adjustment: 10
+    gas-prices: 0.001{{ .Controller.Denom }}
+    key-directory: ./icq/keys
+    debug: false
+    timeout: 20s
+    block-timeout: ""
+    output-format: json
+    sign-mode: direct
+  host:
+    key: {{ .Host.Key }}
+    chain-id: {{ .Host.ID }}
+    rpc-addr: http://{{ .Host.IP }}:26657
+    grpc-addr: http://{{ .Host.IP }}:9090
+    account-prefix: {{ .Host.AccountPrefix }}
+    keyring-backend: test
+    gas-adjustment: 10
+    gas-prices: 0.001{{ .Host.Denom }}
+    key-directory: ./icq/keys
+    debug: false
+    timeout: 20s
+    block-timeout: ""
+    output-format: json
+    sign-mode: direct
+cl: {}
+`))
+
+var hermesScriptTemplate = template.Must(template.New("hermes.sh").Parse(`#!/bin/sh
+# Adds the relayer keys, opens the transfer channel between the chains and starts relaying.
+set -e
+DIR=$(cd "$(dirname "$0")" && pwd)
+export HERMES_CONFIG="$DIR/hermes.toml"
+
+for i in 0 1; do
+  jq -r ".chains[$i].keys[0]" "$DIR/keys.json" > "$DIR/key$i.json"
+  hermes --config "$HERMES_CONFIG" keys add \
+    --chain "$(jq -r ".chains[$i].id" "$DIR/keys.json")" \
+    --key-file "$DIR/key$i.json" \
+    --hd-path "$(jq -r ".chains[$i].hdpath" "$DIR/keys.json")" \
+    --overwrite
+done
+
+hermes --config "$HERMES_CONFIG" create channel \
+  --a-chain {{ .Controller.ID }} \
+  --b-chain {{ .Host.ID }} \
+  --a-port transfer \
+  --b-port transfer \
+  --new-client-connection --yes
+hermes --config "$HERMES_CONFIG" start
+`))
+
+var jumpStartScriptTemplate = template.Must(template.New("jump-start.sh").Parse(`#!/bin/sh
+# Jump starts lscosmos once the relayer opened the transfer channel with the host chain.
+set -e
+DIR=$(cd "$(dirname "$0")" && pwd)
+NODE=tcp://{{ .IP }}:26657
+
+until estaked query ibc channel end {{ .TransferPort }} {{ .TransferChannel }} --node "$NODE" --output json 2>/dev/null | grep -q STATE_OPEN; do
+  echo "waiting for channel {{ .TransferPort }}/{{ .TransferChannel }} to open"
+  sleep 5
+done
+
+estaked tx lscosmos jump-start "$DIR/jumpstart.json" \
+  --from {{ .Key }} \
+  --home "$DIR/{{ .Home }}" \
+  --keyring-backend {{ .KeyringBackend }} \
+  --chain-id {{ .ChainID }} \
+  --node "$NODE" \
+  --gas 500000 \
+  --yes
+`))
@@ -0,0 +1,77 @@
+package cmd_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	icagenesistypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/genesis/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	app "github.com/merlin-network/estake-native/v2/app"
+	"github.com/merlin-network/estake-native/v2/cmd/estaked/cmd"
+	lscosmostypes "github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+func TestTestnetCmdWithHostChain(t *testing.T) {
+	outputDir := t.TempDir()
+
+	rootCmd, _ := cmd.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"testnet",
+		"--v", "1",
+		"--output-dir", outputDir,
+		"--chain-id", "estake-1",
+		"--keyring-backend", "test",
+		"--with-host-chain",
+		"--host-chain-id", "gaia-1",
+		"--host-v", "3",
+		"--epoch-duration", "60s",
+	})
+	require.NoError(t, svrcmd.Execute(rootCmd, "", app.DefaultNodeHome))
+
+	encodingConfig := app.MakeEncodingConfig()
+	appGenState := func(genFile string) map[string]json.RawMessage {
+		genDoc, err := tmtypes.GenesisDocFromFile(genFile)
+		require.NoError(t, err)
+		var appGenState map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(genDoc.AppState, &appGenState))
+		return appGenState
+	}
+
+	controllerGenState := appGenState(filepath.Join(outputDir, "node0", "estaked", "config", "genesis.json"))
+	var lscosmosGenState lscosmostypes.GenesisState
+	encodingConfig.Marshaler.MustUnmarshalJSON(controllerGenState[lscosmostypes.ModuleName], &lscosmosGenState)
+	require.NoError(t, lscosmosGenState.Validate())
+	require.False(t, lscosmosGenState.ModuleEnabled)
+	require.Equal(t, "gaia-1", lscosmosGenState.HostChainParams.ChainID)
+	require.Equal(t, "stk/uatom", lscosmosGenState.HostChainParams.MintDenom)
+	require.Len(t, lscosmosGenState.AllowListedValidators.AllowListedValidators, 3)
+	require.Contains(t, string(controllerGenState["epochs"]), `"60s"`)
+
+	for i := 0; i < 3; i++ {
+		hostGenState := appGenState(filepath.Join(outputDir, "host", fmt.Sprintf("node%d", i), "gaiad", "config", "genesis.json"))
+		var icaGenState icagenesistypes.GenesisState
+		encodingConfig.Marshaler.MustUnmarshalJSON(hostGenState[icatypes.ModuleName], &icaGenState)
+		require.True(t, icaGenState.HostGenesisState.Params.HostEnabled)
+		require.Contains(t, icaGenState.HostGenesisState.Params.AllowMessages, "/cosmos.staking.v1beta1.MsgDelegate")
+		require.Contains(t, string(hostGenState["staking"]), lscosmosGenState.AllowListedValidators.AllowListedValidators[i].ValidatorAddress)
+	}
+
+	for _, file := range []string{
+		filepath.Join("relayer", "hermes.toml"),
+		filepath.Join("relayer", "keys.json"),
+		filepath.Join("relayer", "icq-config.yaml"),
+		filepath.Join("relayer", "hermes.sh"),
+		"jumpstart.json",
+		"jump-start.sh",
+	} {
+		_, err := os.Stat(filepath.Join(outputDir, file))
+		require.NoError(t, err, file)
+	}
+}
@@ -0,0 +1,58 @@
+package stream
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	lscosmostypes "github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+	lselysiumtypes "github.com/merlin-network/estake-native/v2/x/lselysium/types"
+)
+
+// cValueTracker keeps the last c values seen by a stream to send their changes
+type cValueTracker struct {
+	cValues map[string]sdk.Dec
+}
+
+func newCValueTracker() *cValueTracker {
+	return &cValueTracker{cValues: make(map[string]sdk.Dec)}
+}
+
+// update queries the c values at the height of the client context and returns an EventCValueUpdated for every
+// module whose c value changed, nothing is returned for the first height seen.
+func (t *cValueTracker) update(ctx context.Context, clientCtx client.Context) ([]proto.Message, error) {
+	lscosmosRes, err := lscosmostypes.NewQueryClient(clientCtx).CValue(ctx, &lscosmostypes.QueryCValueRequest{})
+	if err != nil {
+		return nil, err
+	}
+	lselysiumRes, err := lselysiumtypes.NewQueryClient(clientCtx).States(ctx, &lselysiumtypes.QueryStatesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	var deltas []proto.Message
+	for _, current := range []struct {
+		module string
+		cValue sdk.Dec
+	}{
+		{lscosmostypes.ModuleName, lscosmosRes.CValue},
+		{lselysiumtypes.ModuleName, lselysiumRes.NetAmountState.MintRate},
+	} {
+		if current.cValue.IsNil() {
+			continue
+		}
+		previous, found := t.cValues[current.module]
+		t.cValues[current.module] = current.cValue
+		if !found || previous.Equal(current.cValue) {
+			continue
+		}
+		deltas = append(deltas, &EventCValueUpdated{
+			Module:         current.module,
+			PreviousCValue: previous,
+			CValue:         current.cValue,
+		})
+	}
+	return deltas, nil
+}
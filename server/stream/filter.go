@@ -0,0 +1,48 @@
+package stream
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// filter selects the events sent on a stream
+type filter struct {
+	delegators map[string]struct{}
+	eventTypes map[string]struct{}
+}
+
+// newFilter returns the filter of the request, an empty list matches everything
+func newFilter(req *SubscribeRequest) (filter, error) {
+	f := filter{
+		delegators: make(map[string]struct{}, len(req.DelegatorAddresses)),
+		eventTypes: make(map[string]struct{}, len(req.EventTypes)),
+	}
+	for _, address := range req.DelegatorAddresses {
+		delegator, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return filter{}, err
+		}
+		f.delegators[delegator.String()] = struct{}{}
+	}
+	for _, eventType := range req.EventTypes {
+		f.eventTypes[eventType] = struct{}{}
+	}
+	return f, nil
+}
+
+// matchesType returns true if events of the type are sent
+func (f filter) matchesType(eventType string) bool {
+	if len(f.eventTypes) == 0 {
+		return true
+	}
+	_, ok := f.eventTypes[eventType]
+	return ok
+}
+
+// matchesDelegator returns true if events of the delegator are sent, events not tied to a delegator always are
+func (f filter) matchesDelegator(delegator string) bool {
+	if len(f.delegators) == 0 || delegator == "" {
+		return true
+	}
+	_, ok := f.delegators[delegator]
+	return ok
+}
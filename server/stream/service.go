@@ -0,0 +1,223 @@
+// Package stream implements a node gRPC service streaming the lscosmos and lselysium events of the
+// committed blocks, along with the state deltas bots would otherwise poll the queries for.
+package stream
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/client/events"
+	lscosmostypes "github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+	lselysiumtypes "github.com/merlin-network/estake-native/v2/x/lselysium/types"
+)
+
+const (
+	// subscriberPrefix prefixes the names of the node event subscriptions of the streams
+	subscriberPrefix = "estake-stream"
+	// blockBufferSize is the number of committed blocks buffered for a stream before the node drops its
+	// subscription for being too slow
+	blockBufferSize = 100
+)
+
+// subscriberCount makes the node event subscriber names unique
+var subscriberCount uint64
+
+// lselysiumEventTypes are the types of the events emitted by lselysium, it has no typed events
+var lselysiumEventTypes = map[string]struct{}{
+	lselysiumtypes.EventTypeMsgLiquidStake:             {},
+	lselysiumtypes.EventTypeMsgLiquidUnstake:           {},
+	lselysiumtypes.EventTypeMsgStakeToLiquid:           {},
+	lselysiumtypes.EventTypeMsgPooledLiquidUnstake:     {},
+	lselysiumtypes.EventTypeMsgClaimUnbonded:           {},
+	lselysiumtypes.EventTypeMsgInstantLiquidUnstake:    {},
+	lselysiumtypes.EventTypeAddWhitelistedValidator:    {},
+	lselysiumtypes.EventTypeRemoveWhitelistedValidator: {},
+	lselysiumtypes.EventTypeUpdateValidatorWeight:      {},
+	lselysiumtypes.EventTypeAddLiquidValidator:         {},
+	lselysiumtypes.EventTypeRemoveLiquidValidator:      {},
+	lselysiumtypes.EventTypeBeginRebalancing:           {},
+	lselysiumtypes.EventTypeReStake:                    {},
+	lselysiumtypes.EventTypeRewardFee:                  {},
+	lselysiumtypes.EventTypeUnbondInactiveLiquidTokens: {},
+}
+
+var _ ServiceServer = service{}
+
+type service struct {
+	clientCtx client.Context
+}
+
+// NewService returns the stream service, the client context must hold the node client
+func NewService(clientCtx client.Context) ServiceServer {
+	return service{clientCtx: clientCtx}
+}
+
+// RegisterStreamService registers the stream service on the gRPC router of the app
+func RegisterStreamService(clientCtx client.Context, server gogogrpc.Server) {
+	RegisterServiceServer(server, NewService(clientCtx))
+}
+
+// Subscribe streams the events of the blocks committed after the subscription until the client goes away
+func (s service) Subscribe(req *SubscribeRequest, stream Service_SubscribeServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+	f, err := newFilter(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	ctx := stream.Context()
+	subscriber := fmt.Sprintf("%s-%d", subscriberPrefix, atomic.AddUint64(&subscriberCount, 1))
+	blocks, err := node.Subscribe(ctx, subscriber, tmtypes.EventQueryNewBlockHeader.String(), blockBufferSize)
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	defer node.UnsubscribeAll(context.Background(), subscriber) //nolint:errcheck
+
+	tracker := newCValueTracker()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case block, ok := <-blocks:
+			if !ok {
+				return status.Error(codes.Unavailable, "node event subscription closed")
+			}
+			data, ok := block.Data.(tmtypes.EventDataNewBlockHeader)
+			if !ok {
+				continue
+			}
+
+			res, err := s.blockResponse(ctx, node, data.Header, f, tracker)
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+			if len(res.Events) == 0 && len(res.LselysiumEvents) == 0 {
+				continue
+			}
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// blockResponse returns the events of the block matching the filter followed by its state deltas
+func (s service) blockResponse(ctx context.Context, node rpcclient.Client, header tmtypes.Header, f filter, tracker *cValueTracker) (*SubscribeResponse, error) {
+	results, err := node.BlockResults(ctx, &header.Height)
+	if err != nil {
+		return nil, err
+	}
+	res, err := newBlockResponse(header, results, f)
+	if err != nil {
+		return nil, err
+	}
+
+	if !f.matchesType(proto.MessageName(&EventCValueUpdated{})) {
+		return res, nil
+	}
+	deltas, err := tracker.update(ctx, s.clientCtx.WithHeight(header.Height))
+	if err != nil {
+		return nil, err
+	}
+	for _, delta := range deltas {
+		packed, err := codectypes.NewAnyWithValue(delta)
+		if err != nil {
+			return nil, err
+		}
+		res.Events = append(res.Events, packed)
+	}
+	return res, nil
+}
+
+// newBlockResponse returns the typed lscosmos events and the lselysium events of the block matching the filter,
+// the events of the failed txs are skipped.
+func newBlockResponse(header tmtypes.Header, results *coretypes.ResultBlockResults, f filter) (*SubscribeResponse, error) {
+	blockEvents := append([]abci.Event{}, results.BeginBlockEvents...)
+	for _, txResult := range results.TxsResults {
+		if txResult.IsOK() {
+			blockEvents = append(blockEvents, txResult.Events...)
+		}
+	}
+	blockEvents = append(blockEvents, results.EndBlockEvents...)
+
+	res := &SubscribeResponse{
+		Height: header.Height,
+		Time:   header.Time,
+	}
+	for _, event := range blockEvents {
+		if !f.matchesType(event.Type) {
+			continue
+		}
+
+		if events.IsTypedEvent(event.Type) {
+			msg, err := sdk.ParseTypedEvent(event)
+			if err != nil {
+				return nil, err
+			}
+			if !f.matchesDelegator(typedEventDelegator(msg)) {
+				continue
+			}
+			packed, err := codectypes.NewAnyWithValue(msg)
+			if err != nil {
+				return nil, err
+			}
+			res.Events = append(res.Events, packed)
+			continue
+		}
+
+		if _, ok := lselysiumEventTypes[event.Type]; ok {
+			stringEvent := sdk.StringifyEvent(event)
+			if !f.matchesDelegator(lselysiumEventDelegator(stringEvent)) {
+				continue
+			}
+			res.LselysiumEvents = append(res.LselysiumEvents, stringEvent)
+		}
+	}
+	return res, nil
+}
+
+// typedEventDelegator returns the delegator of the typed lscosmos event, empty if not tied to a delegator
+func typedEventDelegator(msg proto.Message) string {
+	switch event := msg.(type) {
+	case *lscosmostypes.EventLiquidStake:
+		return event.DelegatorAddress
+	case *lscosmostypes.EventLiquidUnstake:
+		return event.DelegatorAddress
+	case *lscosmostypes.EventRedeem:
+		return event.DelegatorAddress
+	case *lscosmostypes.EventClaim:
+		return event.DelegatorAddress
+	default:
+		return ""
+	}
+}
+
+// lselysiumEventDelegator returns the delegator of the lselysium event, empty if not tied to a delegator
+func lselysiumEventDelegator(event sdk.StringEvent) string {
+	for _, attr := range event.Attributes {
+		if attr.Key == lselysiumtypes.AttributeKeyDelegator {
+			return attr.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,130 @@
+package stream
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	lscosmostypes "github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+	lselysiumtypes "github.com/merlin-network/estake-native/v2/x/lselysium/types"
+)
+
+func TestNewBlockResponse(t *testing.T) {
+	delegator1 := sdk.AccAddress("delegator1__________").String()
+	delegator2 := sdk.AccAddress("delegator2__________").String()
+
+	typedEvent := func(msg proto.Message) abci.Event {
+		event, err := sdk.TypedEventToEvent(msg)
+		require.NoError(t, err)
+		return event
+	}
+	epochStarted := typedEvent(&lscosmostypes.EventEpochStarted{EpochIdentifier: "undelegate", EpochNumber: 4})
+	liquidStake := typedEvent(&lscosmostypes.EventLiquidStake{
+		DelegatorAddress: delegator1,
+		Amount:           sdk.NewInt64Coin("ibc/uatom", 100),
+		Minted:           sdk.NewInt64Coin("stk/uatom", 100),
+		AmountReceived:   sdk.NewInt64Coin("stk/uatom", 100),
+		DepositFee:       sdk.NewInt64Coin("stk/uatom", 0),
+		CValue:           sdk.OneDec(),
+	})
+	failedLiquidStake := typedEvent(&lscosmostypes.EventLiquidStake{
+		DelegatorAddress: delegator2,
+		Amount:           sdk.NewInt64Coin("ibc/uatom", 100),
+		Minted:           sdk.NewInt64Coin("stk/uatom", 100),
+		AmountReceived:   sdk.NewInt64Coin("stk/uatom", 100),
+		DepositFee:       sdk.NewInt64Coin("stk/uatom", 0),
+		CValue:           sdk.OneDec(),
+	})
+	lselysiumLiquidStake := sdk.NewEvent(lselysiumtypes.EventTypeMsgLiquidStake,
+		sdk.NewAttribute(lselysiumtypes.AttributeKeyDelegator, delegator2),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, "100ufury"),
+	).ToABCIEvent()
+	icaAck := typedEvent(&lscosmostypes.EventICATxAck{PortId: "icacontroller-delegator", ChannelId: "channel-0", Sequence: 1, Success: true})
+	transfer := sdk.NewEvent("transfer", sdk.NewAttribute(sdk.AttributeKeyAmount, "100ufury")).ToABCIEvent()
+
+	header := tmtypes.Header{Height: 10, Time: time.Unix(1000, 0).UTC()}
+	results := &coretypes.ResultBlockResults{
+		Height:           10,
+		BeginBlockEvents: []abci.Event{epochStarted},
+		TxsResults: []*abci.ResponseDeliverTx{
+			{Events: []abci.Event{transfer, liquidStake}},
+			{Code: 5, Events: []abci.Event{failedLiquidStake}},
+			{Events: []abci.Event{lselysiumLiquidStake}},
+		},
+		EndBlockEvents: []abci.Event{icaAck},
+	}
+
+	for _, tc := range []struct {
+		desc                  string
+		req                   SubscribeRequest
+		expectedEvents        []string
+		expectedLselysiumSize int
+	}{
+		{
+			desc: "no filter",
+			expectedEvents: []string{
+				proto.MessageName(&lscosmostypes.EventEpochStarted{}),
+				proto.MessageName(&lscosmostypes.EventLiquidStake{}),
+				proto.MessageName(&lscosmostypes.EventICATxAck{}),
+			},
+			expectedLselysiumSize: 1,
+		},
+		{
+			desc: "delegator filter keeps events not tied to a delegator",
+			req:  SubscribeRequest{DelegatorAddresses: []string{delegator2}},
+			expectedEvents: []string{
+				proto.MessageName(&lscosmostypes.EventEpochStarted{}),
+				proto.MessageName(&lscosmostypes.EventICATxAck{}),
+			},
+			expectedLselysiumSize: 1,
+		},
+		{
+			desc:                  "event type filter",
+			req:                   SubscribeRequest{EventTypes: []string{proto.MessageName(&lscosmostypes.EventLiquidStake{})}},
+			expectedEvents:        []string{proto.MessageName(&lscosmostypes.EventLiquidStake{})},
+			expectedLselysiumSize: 0,
+		},
+		{
+			desc: "delegator and event type filters",
+			req: SubscribeRequest{
+				DelegatorAddresses: []string{delegator1},
+				EventTypes:         []string{proto.MessageName(&lscosmostypes.EventLiquidStake{}), lselysiumtypes.EventTypeMsgLiquidStake},
+			},
+			expectedEvents:        []string{proto.MessageName(&lscosmostypes.EventLiquidStake{})},
+			expectedLselysiumSize: 0,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			f, err := newFilter(&tc.req)
+			require.NoError(t, err)
+
+			res, err := newBlockResponse(header, results, f)
+			require.NoError(t, err)
+			require.Equal(t, header.Height, res.Height)
+			require.Equal(t, header.Time, res.Time)
+
+			var eventTypes []string
+			for _, event := range res.Events {
+				eventTypes = append(eventTypes, event.TypeUrl[1:])
+			}
+			require.Equal(t, tc.expectedEvents, eventTypes)
+			require.Len(t, res.LselysiumEvents, tc.expectedLselysiumSize)
+		})
+	}
+}
+
+func TestNewFilter(t *testing.T) {
+	_, err := newFilter(&SubscribeRequest{DelegatorAddresses: []string{"invalid"}})
+	require.Error(t, err)
+
+	f, err := newFilter(&SubscribeRequest{})
+	require.NoError(t, err)
+	require.True(t, f.matchesType(proto.MessageName(&EventCValueUpdated{})))
+	require.True(t, f.matchesDelegator(sdk.AccAddress("delegator1__________").String()))
+}
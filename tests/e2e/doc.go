@@ -11,4 +11,7 @@
 //
 // The file e2e_test.go contains the actual end-to-end integration tests that
 // utilize the testing suite.
+//
+// The lscosmos liquid staking lifecycle is also covered in-process, without
+// Docker or a relayer, by the ICAEndToEndTestSuite in x/lscosmos/keeper.
 package e2e
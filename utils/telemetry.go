@@ -0,0 +1,27 @@
+// Package utils holds the helpers shared by the estake modules.
+package utils
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DecToFloat32 converts the dec to a telemetry gauge value, nil or out of range decs are 0
+func DecToFloat32(d sdk.Dec) float32 {
+	if d.IsNil() {
+		return 0
+	}
+	f, err := d.Float64()
+	if err != nil {
+		return 0
+	}
+	return float32(f)
+}
+
+// IntToFloat32 converts the int to a telemetry gauge value, nil ints are 0
+func IntToFloat32(i math.Int) float32 {
+	if i.IsNil() {
+		return 0
+	}
+	return DecToFloat32(sdk.NewDecFromInt(i))
+}
@@ -0,0 +1,18 @@
+package utils_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/merlin-network/estake-native/v2/utils"
+)
+
+func TestToFloat32(t *testing.T) {
+	require.Equal(t, float32(0), utils.DecToFloat32(sdk.Dec{}))
+	require.Equal(t, float32(1.5), utils.DecToFloat32(sdk.NewDecWithPrec(15, 1)))
+	require.Equal(t, float32(0), utils.IntToFloat32(math.Int{}))
+	require.Equal(t, float32(42), utils.IntToFloat32(sdk.NewInt(42)))
+}
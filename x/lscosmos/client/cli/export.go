@@ -0,0 +1,376 @@
+package cli
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/client/events"
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+const (
+	FlagFormat     = "format"
+	FlagFromHeight = "from-height"
+	FlagToHeight   = "to-height"
+	FlagMaxEntries = "max-entries"
+
+	ExportFormatCSV  = "csv"
+	ExportFormatJSON = "json"
+
+	// exportTxSearchLimit is the page size of the tx search
+	exportTxSearchLimit = 100
+)
+
+// Export record kinds
+const (
+	ExportKindLiquidStake    = "liquid-stake"
+	ExportKindLiquidUnstake  = "liquid-unstake"
+	ExportKindRedeem         = "redeem"
+	ExportKindUnbondingEpoch = "unbonding-epoch"
+	ExportKindUnbondingEntry = "unbonding-entry"
+)
+
+// Export unbonding statuses
+const (
+	ExportStatusPending   = "pending"
+	ExportStatusUnbonding = "unbonding"
+	ExportStatusMatured   = "matured"
+	ExportStatusFailed    = "failed"
+)
+
+// ExportRecord is a normalised row of the lscosmos export. Fee records come from the transactions of the height
+// range, unbonding records are a snapshot of the state at the end of the range. Amounts are coins, c value is the
+// c value the record was processed with.
+type ExportRecord struct {
+	Kind        string `json:"kind" yaml:"kind"`
+	Height      int64  `json:"height" yaml:"height"`
+	Time        string `json:"time" yaml:"time"`
+	TxHash      string `json:"tx_hash" yaml:"tx_hash"`
+	Address     string `json:"address" yaml:"address"`
+	EpochNumber int64  `json:"epoch_number" yaml:"epoch_number"`
+	AmountIn    string `json:"amount_in" yaml:"amount_in"`
+	AmountOut   string `json:"amount_out" yaml:"amount_out"`
+	Fee         string `json:"fee" yaml:"fee"`
+	CValue      string `json:"c_value" yaml:"c_value"`
+	Status      string `json:"status" yaml:"status"`
+}
+
+// exportCSVHeader is the header row of the csv export, in the order of csvRow
+var exportCSVHeader = []string{
+	"kind", "height", "time", "tx_hash", "address", "epoch_number", "amount_in", "amount_out", "fee", "c_value", "status",
+}
+
+func (r ExportRecord) csvRow() []string {
+	return []string{
+		r.Kind, strconv.FormatInt(r.Height, 10), r.Time, r.TxHash, r.Address, strconv.FormatInt(r.EpochNumber, 10),
+		r.AmountIn, r.AmountOut, r.Fee, r.CValue, r.Status,
+	}
+}
+
+// CmdExport implements the export command of the protocol fees and the unbondings
+func CmdExport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Args:  cobra.NoArgs,
+		Short: "Exports protocol fees and delegator unbondings as csv or json",
+		Long: `Exports the liquid stake, liquid unstake and redeem protocol fees of the transactions in the height range,
+followed by the unbonding epochs and delegator unbonding entries at the end of the range. Every record carries the
+c value it was processed with.
+
+Example:
+$ estaked query lscosmos export --from-height 100000 --to-height 200000 --format csv > export.csv
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			format, _ := cmd.Flags().GetString(FlagFormat)
+			if format != ExportFormatCSV && format != ExportFormatJSON {
+				return fmt.Errorf("invalid format %s, expected %s or %s", format, ExportFormatCSV, ExportFormatJSON)
+			}
+			fromHeight, _ := cmd.Flags().GetInt64(FlagFromHeight)
+			toHeight, _ := cmd.Flags().GetInt64(FlagToHeight)
+			if toHeight == 0 {
+				node, err := clientCtx.GetNode()
+				if err != nil {
+					return err
+				}
+				nodeStatus, err := node.Status(cmd.Context())
+				if err != nil {
+					return err
+				}
+				toHeight = nodeStatus.SyncInfo.LatestBlockHeight
+			}
+			if fromHeight < 0 || fromHeight > toHeight {
+				return fmt.Errorf("invalid height range [%d, %d]", fromHeight, toHeight)
+			}
+
+			records, err := queryFeeExportRecords(clientCtx, fromHeight, toHeight)
+			if err != nil {
+				return err
+			}
+			unbondingRecords, err := queryUnbondingExportRecords(cmd.Context(), clientCtx.WithHeight(toHeight), toHeight)
+			if err != nil {
+				return err
+			}
+			records = append(records, unbondingRecords...)
+
+			return WriteExportRecords(cmd.OutOrStdout(), format, records)
+		},
+	}
+
+	cmd.Flags().String(FlagFormat, ExportFormatCSV, "Output format (csv|json)")
+	cmd.Flags().Int64(FlagFromHeight, 0, "First height of the transactions to export")
+	cmd.Flags().Int64(FlagToHeight, 0, "Last height of the transactions to export and height of the unbondings snapshot, latest height if 0")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// queryFeeExportRecords pages through the lscosmos transactions of the height range
+func queryFeeExportRecords(clientCtx client.Context, fromHeight, toHeight int64) ([]ExportRecord, error) {
+	txEvents := []string{
+		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyModule, types.AttributeValueCategory),
+		fmt.Sprintf("tx.height>=%d", fromHeight),
+		fmt.Sprintf("tx.height<=%d", toHeight),
+	}
+
+	var records []ExportRecord
+	for page := 1; ; page++ {
+		res, err := authtx.QueryTxsByEvents(clientCtx, txEvents, page, exportTxSearchLimit, "asc")
+		if err != nil {
+			return nil, err
+		}
+		for _, txResponse := range res.Txs {
+			txRecords, err := NewFeeExportRecords(txResponse)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, txRecords...)
+		}
+		if page >= int(res.PageTotal) {
+			return records, nil
+		}
+	}
+}
+
+// queryUnbondingExportRecords pages through the unbonding epoch c values and the delegator unbonding epoch entries
+func queryUnbondingExportRecords(ctx context.Context, clientCtx client.Context, height int64) ([]ExportRecord, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+
+	var unbondingEpochCValues []types.UnbondingEpochCValue
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := queryClient.UnbondingEpochCValues(ctx, &types.QueryUnbondingEpochCValuesRequest{Pagination: pageReq})
+		if err != nil {
+			return nil, err
+		}
+		unbondingEpochCValues = append(unbondingEpochCValues, res.UnbondingEpochCValues...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+
+	var entries []types.DelegatorUnbondingEpochEntry
+	pageReq = &query.PageRequest{}
+	for {
+		res, err := queryClient.UnbondingEpochEntries(ctx, &types.QueryUnbondingEpochEntriesRequest{Pagination: pageReq})
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, res.DelegatorUnbondingEpochEntries...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+
+	return NewUnbondingExportRecords(height, unbondingEpochCValues, entries), nil
+}
+
+// NewFeeExportRecords returns the liquid stake, liquid unstake and redeem records of the tx response
+func NewFeeExportRecords(txResponse *sdk.TxResponse) ([]ExportRecord, error) {
+	msgs, err := events.ParseTxResponse(txResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []ExportRecord
+	for _, msg := range msgs {
+		record := ExportRecord{
+			Height: txResponse.Height,
+			Time:   txResponse.Timestamp,
+			TxHash: txResponse.TxHash,
+		}
+		switch event := msg.(type) {
+		case *types.EventLiquidStake:
+			record.Kind = ExportKindLiquidStake
+			record.Address = event.DelegatorAddress
+			record.AmountIn = event.Amount.String()
+			record.AmountOut = event.AmountReceived.String()
+			record.Fee = event.DepositFee.String()
+			record.CValue = event.CValue.String()
+		case *types.EventLiquidUnstake:
+			record.Kind = ExportKindLiquidUnstake
+			record.Address = event.DelegatorAddress
+			record.EpochNumber = event.UnbondingEpochNumber
+			record.AmountIn = event.Amount.String()
+			record.AmountOut = event.UnstakeAmount.String()
+			record.Fee = event.UnstakeFee.String()
+			record.CValue = event.CValue.String()
+		case *types.EventRedeem:
+			record.Kind = ExportKindRedeem
+			record.Address = event.DelegatorAddress
+			record.AmountIn = event.Amount.String()
+			record.AmountOut = event.AmountReceived.String()
+			record.Fee = event.RedeemFee.String()
+			record.CValue = event.CValue.String()
+		default:
+			continue
+		}
+		records = append(records, record)
+	}
+	if len(msgs) > 0 {
+		return records, nil
+	}
+
+	// transactions processed before the typed events only carry the string attributes, without c value
+	for _, log := range txResponse.Logs {
+		for _, event := range log.Events {
+			record := ExportRecord{
+				Height: txResponse.Height,
+				Time:   txResponse.Timestamp,
+				TxHash: txResponse.TxHash,
+			}
+			attributes := make(map[string]string, len(event.Attributes))
+			for _, attribute := range event.Attributes {
+				attributes[attribute.Key] = attribute.Value
+			}
+			record.Address = attributes[types.AttributeDelegatorAddress]
+			switch event.Type {
+			case types.EventTypeLiquidStake:
+				record.Kind = ExportKindLiquidStake
+				record.AmountIn = attributes[types.AttributeAmount]
+				record.AmountOut = attributes[types.AttributeAmountReceived]
+				record.Fee = attributes[types.AttributeEstakeDepositFee]
+			case types.EventTypeLiquidUnstake:
+				record.Kind = ExportKindLiquidUnstake
+				record.AmountIn = attributes[types.AttributeAmountReceived]
+				record.AmountOut = attributes[types.AttributeUnstakeAmount]
+				record.Fee = attributes[types.AttributeEstakeUnstakeFee]
+			case types.EventTypeRedeem:
+				record.Kind = ExportKindRedeem
+				record.AmountIn = attributes[types.AttributeAmount]
+				record.AmountOut = attributes[types.AttributeAmountReceived]
+				record.Fee = attributes[types.AttributeEstakeRedeemFee]
+			default:
+				continue
+			}
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+// NewUnbondingExportRecords returns the unbonding epoch records followed by the delegator unbonding entry records.
+// Entries of a matured epoch are exported with the amount claimable at the epoch c value.
+func NewUnbondingExportRecords(height int64, unbondingEpochCValues []types.UnbondingEpochCValue, entries []types.DelegatorUnbondingEpochEntry) []ExportRecord {
+	cValues := make(map[int64]types.UnbondingEpochCValue, len(unbondingEpochCValues))
+	records := make([]ExportRecord, 0, len(unbondingEpochCValues)+len(entries))
+	for _, unbondingEpochCValue := range unbondingEpochCValues {
+		cValues[unbondingEpochCValue.EpochNumber] = unbondingEpochCValue
+		records = append(records, ExportRecord{
+			Kind:        ExportKindUnbondingEpoch,
+			Height:      height,
+			EpochNumber: unbondingEpochCValue.EpochNumber,
+			AmountIn:    unbondingEpochCValue.STKBurn.String(),
+			AmountOut:   unbondingEpochCValue.AmountUnbonded.String(),
+			CValue:      unbondingEpochCValueString(unbondingEpochCValue),
+			Status:      unbondingEpochStatus(unbondingEpochCValue, true),
+		})
+	}
+
+	for _, entry := range entries {
+		unbondingEpochCValue, found := cValues[entry.EpochNumber]
+		record := ExportRecord{
+			Kind:        ExportKindUnbondingEntry,
+			Height:      height,
+			Address:     entry.DelegatorAddress,
+			EpochNumber: entry.EpochNumber,
+			AmountIn:    entry.Amount.String(),
+			Status:      unbondingEpochStatus(unbondingEpochCValue, found),
+		}
+		if found {
+			record.CValue = unbondingEpochCValueString(unbondingEpochCValue)
+		}
+		if record.Status == ExportStatusMatured && !unbondingEpochCValue.AmountUnbonded.IsZero() {
+			claimable := sdk.NewDecFromInt(entry.Amount.Amount).Quo(unbondingEpochCValue.GetUnbondingEpochCValue()).TruncateInt()
+			record.AmountOut = sdk.NewCoin(unbondingEpochCValue.AmountUnbonded.Denom, claimable).String()
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// unbondingEpochCValueString returns the c value of the unbonding epoch, empty if nothing was unbonded
+func unbondingEpochCValueString(unbondingEpochCValue types.UnbondingEpochCValue) string {
+	if unbondingEpochCValue.AmountUnbonded.Amount.IsNil() || unbondingEpochCValue.AmountUnbonded.IsZero() {
+		return ""
+	}
+	return unbondingEpochCValue.GetUnbondingEpochCValue().String()
+}
+
+// unbondingEpochStatus returns the status of an unbonding epoch, an epoch without c value is not undelegated yet
+func unbondingEpochStatus(unbondingEpochCValue types.UnbondingEpochCValue, found bool) string {
+	switch {
+	case !found:
+		return ExportStatusPending
+	case unbondingEpochCValue.IsFailed:
+		return ExportStatusFailed
+	case unbondingEpochCValue.IsMatured:
+		return ExportStatusMatured
+	default:
+		return ExportStatusUnbonding
+	}
+}
+
+// WriteExportRecords writes the records as csv with a header row, or as a json array
+func WriteExportRecords(w io.Writer, format string, records []ExportRecord) error {
+	switch format {
+	case ExportFormatJSON:
+		if records == nil {
+			records = []ExportRecord{}
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(records)
+	case ExportFormatCSV:
+		writer := csv.NewWriter(w)
+		if err := writer.Write(exportCSVHeader); err != nil {
+			return err
+		}
+		for _, record := range records {
+			if err := writer.Write(record.csvRow()); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	default:
+		return fmt.Errorf("invalid format %s, expected %s or %s", format, ExportFormatCSV, ExportFormatJSON)
+	}
+}
@@ -0,0 +1,121 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/client/cli"
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+func TestNewFeeExportRecords(t *testing.T) {
+	liquidUnstake := &types.EventLiquidUnstake{
+		DelegatorAddress:     "estake1delegator",
+		Amount:               sdk.NewInt64Coin("stk/uatom", 100),
+		UnstakeAmount:        sdk.NewInt64Coin("stk/uatom", 99),
+		UnstakeFee:           sdk.NewInt64Coin("stk/uatom", 1),
+		UnbondingEpochNumber: 8,
+		CValue:               sdk.MustNewDecFromStr("0.9"),
+	}
+	typedEvent, err := sdk.TypedEventToEvent(liquidUnstake)
+	require.NoError(t, err)
+
+	records, err := cli.NewFeeExportRecords(&sdk.TxResponse{Height: 10, TxHash: "AB", Events: []abci.Event{typedEvent}})
+	require.NoError(t, err)
+	require.Equal(t, []cli.ExportRecord{{
+		Kind:        cli.ExportKindLiquidUnstake,
+		Height:      10,
+		TxHash:      "AB",
+		Address:     "estake1delegator",
+		EpochNumber: 8,
+		AmountIn:    "100stk/uatom",
+		AmountOut:   "99stk/uatom",
+		Fee:         "1stk/uatom",
+		CValue:      sdk.MustNewDecFromStr("0.9").String(),
+	}}, records)
+
+	// transactions without typed events are exported out of the string attributes
+	legacyEvent := sdk.NewEvent(
+		types.EventTypeRedeem,
+		sdk.NewAttribute(types.AttributeDelegatorAddress, "estake1delegator"),
+		sdk.NewAttribute(types.AttributeAmount, "100stk/uatom"),
+		sdk.NewAttribute(types.AttributeAmountReceived, "95ibc/uatom"),
+		sdk.NewAttribute(types.AttributeEstakeRedeemFee, "5stk/uatom"),
+	).ToABCIEvent()
+	records, err = cli.NewFeeExportRecords(&sdk.TxResponse{
+		Height: 11,
+		TxHash: "CD",
+		Logs:   sdk.ABCIMessageLogs{sdk.NewABCIMessageLog(0, "", []abci.Event{legacyEvent})},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []cli.ExportRecord{{
+		Kind:      cli.ExportKindRedeem,
+		Height:    11,
+		TxHash:    "CD",
+		Address:   "estake1delegator",
+		AmountIn:  "100stk/uatom",
+		AmountOut: "95ibc/uatom",
+		Fee:       "5stk/uatom",
+	}}, records)
+}
+
+func TestNewUnbondingExportRecords(t *testing.T) {
+	unbondingEpochCValues := []types.UnbondingEpochCValue{
+		{EpochNumber: 4, STKBurn: sdk.NewInt64Coin("stk/uatom", 100), AmountUnbonded: sdk.NewInt64Coin("uatom", 125), IsMatured: true},
+		{EpochNumber: 8, STKBurn: sdk.NewInt64Coin("stk/uatom", 50), AmountUnbonded: sdk.NewInt64Coin("uatom", 55), IsFailed: true},
+	}
+	entries := []types.DelegatorUnbondingEpochEntry{
+		types.NewDelegatorUnbondingEpochEntry("estake1delegator", 4, sdk.NewInt64Coin("stk/uatom", 30)),
+		types.NewDelegatorUnbondingEpochEntry("estake1delegator", 8, sdk.NewInt64Coin("stk/uatom", 50)),
+		types.NewDelegatorUnbondingEpochEntry("estake1delegator", 12, sdk.NewInt64Coin("stk/uatom", 20)),
+	}
+
+	records := cli.NewUnbondingExportRecords(100, unbondingEpochCValues, entries)
+	require.Len(t, records, 5)
+
+	require.Equal(t, cli.ExportKindUnbondingEpoch, records[0].Kind)
+	require.Equal(t, cli.ExportStatusMatured, records[0].Status)
+	require.Equal(t, unbondingEpochCValues[0].GetUnbondingEpochCValue().String(), records[0].CValue)
+	require.Equal(t, cli.ExportStatusFailed, records[1].Status)
+
+	// 30 stk at a c value of 100/125 is 37.5 uatom, truncated
+	require.Equal(t, cli.ExportKindUnbondingEntry, records[2].Kind)
+	require.Equal(t, cli.ExportStatusMatured, records[2].Status)
+	require.Equal(t, "37uatom", records[2].AmountOut)
+	require.Equal(t, int64(100), records[2].Height)
+
+	require.Equal(t, cli.ExportStatusFailed, records[3].Status)
+	require.Empty(t, records[3].AmountOut)
+
+	require.Equal(t, cli.ExportStatusPending, records[4].Status)
+	require.Empty(t, records[4].CValue)
+}
+
+func TestWriteExportRecords(t *testing.T) {
+	records := []cli.ExportRecord{
+		{Kind: cli.ExportKindLiquidStake, Height: 10, TxHash: "AB", Address: "estake1delegator", AmountIn: "100ibc/uatom", Fee: "1stk/uatom", CValue: "1.000000000000000000"},
+		{Kind: cli.ExportKindUnbondingEpoch, Height: 20, EpochNumber: 4, Status: cli.ExportStatusMatured},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, cli.WriteExportRecords(&buf, cli.ExportFormatCSV, records))
+	rows, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	require.Equal(t, "kind", rows[0][0])
+	require.Equal(t, []string{"liquid-stake", "10", "", "AB", "estake1delegator", "0", "100ibc/uatom", "", "1stk/uatom", "1.000000000000000000", ""}, rows[1])
+
+	buf.Reset()
+	require.NoError(t, cli.WriteExportRecords(&buf, cli.ExportFormatJSON, records))
+	var decoded []cli.ExportRecord
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	require.Equal(t, records, decoded)
+
+	require.Error(t, cli.WriteExportRecords(&buf, "xml", records))
+}
@@ -41,6 +41,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		CmdQueryHostAccounts(),
 		CmdQueryDepositModuleAccount(),
 		CmdDelegatorUnbondingEpochEntries(),
+		CmdExport(),
 	)
 
 	return cmd
@@ -355,6 +355,7 @@ func NewClaimCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "claim",
 		Short: `Claim matured tokens`,
+		Long:  `Claim matured tokens and the tokens of failed unbondings, --max-entries bounds the gas of the claim, claim again while entries remain.`,
 		Args:  cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
 
@@ -363,13 +364,19 @@ func NewClaimCmd() *cobra.Command {
 				return err
 			}
 
+			maxEntries, err := cmd.Flags().GetUint32(FlagMaxEntries)
+			if err != nil {
+				return err
+			}
+
 			delegatorAddress := clientctx.GetFromAddress()
-			msg := types.NewMsgClaim(delegatorAddress)
+			msg := types.NewMsgClaim(delegatorAddress, maxEntries)
 
 			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Uint32(FlagMaxEntries, 0, "Maximum number of unbonding entries to claim, all of them if 0")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
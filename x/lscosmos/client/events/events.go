@@ -0,0 +1,147 @@
+// Package events decodes the typed lscosmos events out of transaction responses
+// so indexers and clients do not have to parse the legacy string attributes.
+package events
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+// typedEventPrefix is the event type prefix of every typed event of the lscosmos module,
+// EmitTypedEvent uses the proto message name as the event type.
+const typedEventPrefix = "estake.lscosmos.v1beta1."
+
+// IsTypedEvent returns true if the event type belongs to a typed lscosmos event
+func IsTypedEvent(eventType string) bool {
+	return strings.HasPrefix(eventType, typedEventPrefix)
+}
+
+// ParseTxResponse returns all the typed lscosmos events of the tx response in emission order.
+// The abci events are used when present, else the events are rebuilt from the message logs.
+func ParseTxResponse(res *sdk.TxResponse) ([]proto.Message, error) {
+	if res == nil {
+		return nil, nil
+	}
+	if len(res.Events) > 0 {
+		return ParseEvents(res.Events)
+	}
+
+	var msgs []proto.Message
+	for _, log := range res.Logs {
+		parsed, err := ParseStringEvents(log.Events)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, parsed...)
+	}
+	return msgs, nil
+}
+
+// ParseEvents returns the typed lscosmos events out of the abci events, other events are skipped.
+func ParseEvents(events []abci.Event) ([]proto.Message, error) {
+	var msgs []proto.Message
+	for _, event := range events {
+		if !IsTypedEvent(event.Type) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// ParseStringEvents returns the typed lscosmos events out of the events of an ABCIMessageLog.
+// Message logs merge all events of the same type into one, so a repeated attribute
+// key marks the start of the next event.
+func ParseStringEvents(events sdk.StringEvents) ([]proto.Message, error) {
+	var abciEvents []abci.Event
+	for _, event := range events {
+		if !IsTypedEvent(event.Type) {
+			continue
+		}
+		var current abci.Event
+		seen := make(map[string]bool)
+		for _, attr := range event.Attributes {
+			if seen[attr.Key] {
+				abciEvents = append(abciEvents, current)
+				current = abci.Event{}
+				seen = make(map[string]bool)
+			}
+			current.Type = event.Type
+			current.Attributes = append(current.Attributes, abci.EventAttribute{Key: []byte(attr.Key), Value: []byte(attr.Value)})
+			seen[attr.Key] = true
+		}
+		if len(current.Attributes) > 0 {
+			abciEvents = append(abciEvents, current)
+		}
+	}
+	return ParseEvents(abciEvents)
+}
+
+// LiquidStakeEvents returns the EventLiquidStake events of the tx response
+func LiquidStakeEvents(res *sdk.TxResponse) ([]*types.EventLiquidStake, error) {
+	msgs, err := ParseTxResponse(res)
+	if err != nil {
+		return nil, err
+	}
+	var events []*types.EventLiquidStake
+	for _, msg := range msgs {
+		if event, ok := msg.(*types.EventLiquidStake); ok {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+// LiquidUnstakeEvents returns the EventLiquidUnstake events of the tx response
+func LiquidUnstakeEvents(res *sdk.TxResponse) ([]*types.EventLiquidUnstake, error) {
+	msgs, err := ParseTxResponse(res)
+	if err != nil {
+		return nil, err
+	}
+	var events []*types.EventLiquidUnstake
+	for _, msg := range msgs {
+		if event, ok := msg.(*types.EventLiquidUnstake); ok {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+// RedeemEvents returns the EventRedeem events of the tx response
+func RedeemEvents(res *sdk.TxResponse) ([]*types.EventRedeem, error) {
+	msgs, err := ParseTxResponse(res)
+	if err != nil {
+		return nil, err
+	}
+	var events []*types.EventRedeem
+	for _, msg := range msgs {
+		if event, ok := msg.(*types.EventRedeem); ok {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+// ClaimEvents returns the EventClaim events of the tx response
+func ClaimEvents(res *sdk.TxResponse) ([]*types.EventClaim, error) {
+	msgs, err := ParseTxResponse(res)
+	if err != nil {
+		return nil, err
+	}
+	var events []*types.EventClaim
+	for _, msg := range msgs {
+		if event, ok := msg.(*types.EventClaim); ok {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
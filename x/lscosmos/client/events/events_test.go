@@ -0,0 +1,74 @@
+package events_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/client/events"
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+func TestParseTxResponse(t *testing.T) {
+	liquidStake := &types.EventLiquidStake{
+		DelegatorAddress: "estake1delegator",
+		Amount:           sdk.NewInt64Coin("ibc/uatom", 100),
+		Minted:           sdk.NewInt64Coin("stk/uatom", 100),
+		AmountReceived:   sdk.NewInt64Coin("stk/uatom", 99),
+		DepositFee:       sdk.NewInt64Coin("stk/uatom", 1),
+		CValue:           sdk.OneDec(),
+	}
+	claims := []*types.EventClaim{
+		{
+			DelegatorAddress:     "estake1delegator",
+			EpochNumber:          4,
+			Amount:               sdk.NewInt64Coin("stk/uatom", 10),
+			Claimed:              sdk.NewInt64Coin("ibc/uatom", 11),
+			UnbondingEpochCValue: sdk.MustNewDecFromStr("0.9"),
+		},
+		{
+			DelegatorAddress:     "estake1delegator",
+			EpochNumber:          8,
+			Amount:               sdk.NewInt64Coin("stk/uatom", 5),
+			Claimed:              sdk.NewInt64Coin("stk/uatom", 5),
+			UnbondingEpochCValue: sdk.ZeroDec(),
+			IsFailed:             true,
+		},
+	}
+
+	var abciEvents []abci.Event
+	for _, msg := range []proto.Message{liquidStake, claims[0], claims[1]} {
+		event, err := sdk.TypedEventToEvent(msg)
+		require.NoError(t, err)
+		abciEvents = append(abciEvents, event)
+	}
+	abciEvents = append(abciEvents, sdk.NewEvent(types.EventTypeClaim, sdk.NewAttribute(types.AttributeAmount, "10stk/uatom")).ToABCIEvent())
+
+	for name, res := range map[string]*sdk.TxResponse{
+		"abci events": {Events: abciEvents},
+		"message logs": {Logs: sdk.ABCIMessageLogs{
+			sdk.NewABCIMessageLog(0, "", abciEvents),
+		}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			msgs, err := events.ParseTxResponse(res)
+			require.NoError(t, err)
+			require.Len(t, msgs, 3)
+
+			stakes, err := events.LiquidStakeEvents(res)
+			require.NoError(t, err)
+			require.Len(t, stakes, 1)
+			require.True(t, liquidStake.Equal(stakes[0]))
+
+			parsedClaims, err := events.ClaimEvents(res)
+			require.NoError(t, err)
+			require.Len(t, parsedClaims, len(claims))
+			for i := range claims {
+				require.True(t, claims[i].Equal(parsedClaims[i]))
+			}
+		})
+	}
+}
@@ -16,9 +16,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	k.SetModuleState(ctx, genState.ModuleEnabled)
 	k.SetHostChainParams(ctx, genState.HostChainParams)
 	if !genState.HostChainParams.IsEmpty() {
-		err := k.NewCapability(ctx, host.ChannelCapabilityPath(genState.HostChainParams.TransferPort, genState.HostChainParams.TransferChannel))
-		if err != nil {
-			panic(err)
+		// the capability is restored by the capability module when importing an exported genesis
+		capabilityPath := host.ChannelCapabilityPath(genState.HostChainParams.TransferPort, genState.HostChainParams.TransferChannel)
+		if !k.HasCapability(ctx, capabilityPath) {
+			err := k.NewCapability(ctx, capabilityPath)
+			if err != nil {
+				panic(err)
+			}
 		}
 	}
 	k.SetAllowListedValidators(ctx, genState.AllowListedValidators)
@@ -32,6 +36,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetDelegatorUnbondingEpochEntry(ctx, delegatorUnbondingEntry)
 	}
 	k.SetHostAccounts(ctx, genState.HostAccounts)
+	if genState.HostMaxEntries != 0 {
+		k.SetHostMaxEntries(ctx, genState.HostMaxEntries)
+	}
+	for _, icaTxSendTime := range genState.ICATxSendTimes {
+		k.SetICATxSendTime(ctx, icaTxSendTime.PortID, icaTxSendTime.Sequence, icaTxSendTime.SendTime)
+	}
 
 	k.GetDepositModuleAccount(ctx)
 	k.GetDelegationModuleAccount(ctx)
@@ -54,6 +64,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.UnbondingEpochCValues = k.IterateAllUnbondingEpochCValues(ctx)
 	genesis.DelegatorUnbondingEpochEntries = k.IterateAllDelegatorUnbondingEpochEntry(ctx)
 	genesis.HostAccounts = k.GetHostAccounts(ctx)
+	genesis.HostMaxEntries = k.GetHostMaxEntries(ctx)
+	genesis.ICATxSendTimes = k.GetAllICATxSendTimes(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
@@ -2,11 +2,16 @@ package lscosmos_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/merlin-network/estake-native/v2/app/helpers"
 	"github.com/merlin-network/estake-native/v2/x/lscosmos"
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/keeper"
 	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
 )
 
@@ -24,3 +29,124 @@ func TestGenesis(t *testing.T) {
 
 	// this line is used by starport scaffolding # genesis/test/assert
 }
+
+// TestGenesisRoundTrip exports a state with undelegations and ica txs in flight mid epoch and checks importing it
+// in a fresh chain exports the exact same state.
+func TestGenesisRoundTrip(t *testing.T) {
+	_, eStakeApp, ctx := helpers.CreateTestApp(t)
+	k := eStakeApp.LSCosmosKeeper
+
+	hostChainParams := types.HostChainParams{
+		ChainID:         "cosmoshub-4",
+		ConnectionID:    "connection-0",
+		TransferChannel: "channel-0",
+		TransferPort:    ibctransfertypes.PortID,
+		BaseDenom:       "uatom",
+		MintDenom:       "stk/uatom",
+		MinDeposit:      sdk.NewInt(5),
+		EstakeParams: types.EstakeParams{
+			EstakeDepositFee:    sdk.ZeroDec(),
+			EstakeRestakeFee:    sdk.ZeroDec(),
+			EstakeUnstakeFee:    sdk.ZeroDec(),
+			EstakeRedemptionFee: sdk.ZeroDec(),
+			EstakeFeeAddress:    sdk.AccAddress("fee_address_________").String(),
+		},
+	}
+	ibcDenom := ibctransfertypes.ParseDenomTrace(
+		ibctransfertypes.GetPrefixedDenom(hostChainParams.TransferPort, hostChainParams.TransferChannel, hostChainParams.BaseDenom),
+	).IBCDenom()
+	delegator1 := sdk.AccAddress("delegator1__________").String()
+	delegator2 := sdk.AccAddress("delegator2__________").String()
+	completionTime := time.Date(2023, 3, 1, 12, 30, 0, 123, time.UTC)
+
+	genesisState := types.DefaultGenesis()
+	genesisState.ModuleEnabled = true
+	genesisState.HostChainParams = hostChainParams
+	genesisState.AllowListedValidators = types.AllowListedValidators{AllowListedValidators: []types.AllowListedValidator{
+		{ValidatorAddress: "cosmosvaloper10e4vsut6suau8tk9m6dnrm0slgd6npe3jx5xpv", TargetWeight: sdk.NewDecWithPrec(5, 1)},
+		{ValidatorAddress: "cosmosvaloper18hfzxheyknesfgcrttr5dg50ffnfphtwtar9fz", TargetWeight: sdk.NewDecWithPrec(5, 1)},
+	}}
+	genesisState.DelegationState = types.DelegationState{
+		HostDelegationAccountBalance: sdk.NewCoins(sdk.NewInt64Coin(hostChainParams.BaseDenom, 100)),
+		HostChainDelegationAddress:   "cosmos1hcqg5wj9t42zawqkqucs7la85ffyv08lum327c",
+		HostAccountDelegations: []types.HostAccountDelegation{
+			types.NewHostAccountDelegation("cosmosvaloper10e4vsut6suau8tk9m6dnrm0slgd6npe3jx5xpv", sdk.NewInt64Coin(hostChainParams.BaseDenom, 5000)),
+		},
+		HostAccountUndelegations: []types.HostAccountUndelegation{
+			{
+				// undelegated and waiting for the host chain unbonding period
+				EpochNumber:             8,
+				TotalUndelegationAmount: sdk.NewInt64Coin(hostChainParams.MintDenom, 300),
+				CompletionTime:          completionTime,
+				UndelegationEntries: []types.UndelegationEntry{
+					{ValidatorAddress: "cosmosvaloper10e4vsut6suau8tk9m6dnrm0slgd6npe3jx5xpv", Amount: sdk.NewInt64Coin(hostChainParams.BaseDenom, 300)},
+				},
+			},
+			{
+				// the current unbonding epoch
+				EpochNumber:             12,
+				TotalUndelegationAmount: sdk.NewInt64Coin(hostChainParams.MintDenom, 200),
+			},
+		},
+	}
+	genesisState.HostChainRewardAddress = types.NewHostChainRewardAddress("cosmos1hcqg5wj9t42zawqkqucs7la85ffyv08lum327c")
+	genesisState.IBCAmountTransientStore = types.IBCAmountTransientStore{
+		IBCTransfer: sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 50)),
+		ICADelegate: sdk.NewInt64Coin(hostChainParams.BaseDenom, 40),
+		UndelegatonCompleteIBCTransfer: []types.TransientUndelegationTransfer{
+			{EpochNumber: 4, AmountUnbonded: sdk.NewInt64Coin(hostChainParams.BaseDenom, 110)},
+		},
+	}
+	genesisState.UnbondingEpochCValues = []types.UnbondingEpochCValue{
+		{EpochNumber: 0, STKBurn: sdk.NewInt64Coin(hostChainParams.MintDenom, 100), AmountUnbonded: sdk.NewInt64Coin(hostChainParams.BaseDenom, 110), IsMatured: true},
+		{EpochNumber: 4, STKBurn: sdk.NewInt64Coin(hostChainParams.MintDenom, 100), AmountUnbonded: sdk.NewInt64Coin(hostChainParams.BaseDenom, 110)},
+		{EpochNumber: 8, STKBurn: sdk.NewInt64Coin(hostChainParams.MintDenom, 300), AmountUnbonded: sdk.NewInt64Coin(hostChainParams.BaseDenom, 330)},
+	}
+	genesisState.DelegatorUnbondingEpochEntries = []types.DelegatorUnbondingEpochEntry{
+		types.NewDelegatorUnbondingEpochEntry(delegator1, 0, sdk.NewInt64Coin(hostChainParams.MintDenom, 100)),
+		types.NewDelegatorUnbondingEpochEntry(delegator1, 4, sdk.NewInt64Coin(hostChainParams.MintDenom, 100)),
+		types.NewDelegatorUnbondingEpochEntry(delegator1, 12, sdk.NewInt64Coin(hostChainParams.MintDenom, 150)),
+		types.NewDelegatorUnbondingEpochEntry(delegator2, 8, sdk.NewInt64Coin(hostChainParams.MintDenom, 300)),
+		types.NewDelegatorUnbondingEpochEntry(delegator2, 12, sdk.NewInt64Coin(hostChainParams.MintDenom, 50)),
+	}
+	genesisState.HostMaxEntries = 5
+	genesisState.ICATxSendTimes = []types.ICATxSendTime{
+		{PortID: genesisState.HostAccounts.DelegatorAccountPortID(), Sequence: 12, SendTime: completionTime.Add(-time.Hour)},
+		{PortID: genesisState.HostAccounts.RewardsAccountPortID(), Sequence: 3, SendTime: completionTime.Add(-time.Minute)},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	// the stk tokens of the current unbonding epoch and the tokens claimable for the matured one
+	undelegationBalance := sdk.NewCoins(sdk.NewInt64Coin(hostChainParams.MintDenom, 200), sdk.NewInt64Coin(ibcDenom, 110))
+	require.NoError(t, testutil.FundModuleAccount(eStakeApp.BankKeeper, ctx, types.UndelegationModuleAccount, undelegationBalance))
+
+	lscosmos.InitGenesis(ctx, k, *genesisState)
+	exported := lscosmos.ExportGenesis(ctx, k)
+	require.NoError(t, exported.Validate())
+
+	// importing the exported genesis again must not try to create the restored transfer capability again
+	require.NotPanics(t, func() { lscosmos.InitGenesis(ctx, k, *exported) })
+
+	bz, err := eStakeApp.AppCodec().MarshalJSON(exported)
+	require.NoError(t, err)
+	var imported types.GenesisState
+	require.NoError(t, eStakeApp.AppCodec().UnmarshalJSON(bz, &imported))
+
+	// the undelegation module account balance is restored by the bank genesis, the registered invariant catches it missing
+	_, freshApp, freshCtx := helpers.CreateTestApp(t)
+	lscosmos.InitGenesis(freshCtx, freshApp.LSCosmosKeeper, imported)
+	_, broken := keeper.UndelegationBalanceInvariant(freshApp.LSCosmosKeeper)(freshCtx)
+	require.True(t, broken)
+
+	_, freshApp, freshCtx = helpers.CreateTestApp(t)
+	require.NoError(t, testutil.FundModuleAccount(freshApp.BankKeeper, freshCtx, types.UndelegationModuleAccount, undelegationBalance))
+	lscosmos.InitGenesis(freshCtx, freshApp.LSCosmosKeeper, imported)
+	_, broken = keeper.UndelegationBalanceInvariant(freshApp.LSCosmosKeeper)(freshCtx)
+	require.False(t, broken)
+	require.Equal(t, exported, lscosmos.ExportGenesis(freshCtx, freshApp.LSCosmosKeeper))
+
+	count, oldest := freshApp.LSCosmosKeeper.GetInFlightICATxs(freshCtx)
+	require.Equal(t, 2, count)
+	require.Equal(t, completionTime.Add(-time.Hour), oldest)
+	require.Equal(t, uint32(5), freshApp.LSCosmosKeeper.GetHostMaxEntries(freshCtx))
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
@@ -19,6 +20,10 @@ import (
 // BeginBlock will use utils.ApplyFuncIfNoError to apply the changes made by the functions
 // passed as parameters
 func (k Keeper) BeginBlock(ctx sdk.Context) {
+	if telemetry.IsTelemetryEnabled() {
+		defer k.EmitTelemetry(ctx)
+	}
+
 	if !k.GetModuleState(ctx) {
 		return
 	}
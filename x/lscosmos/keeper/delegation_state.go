@@ -3,6 +3,7 @@ package keeper
 import (
 	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
 
@@ -172,6 +173,51 @@ func (k Keeper) AddEntriesForUndelegationEpoch(ctx sdk.Context, epochNumber int6
 	k.SetDelegationState(ctx, delegationState)
 }
 
+// CarryOverUndelegations leaves the undelegated share, undelegated out of amount, of the liquid unstakings of the
+// unbonding epoch in it and moves the rest to the next unbonding epoch. Every delegator unbonding epoch entry is
+// split pro rata, the stk tokens left in the unbonding epoch are returned.
+func (k Keeper) CarryOverUndelegations(ctx sdk.Context, epochNumber int64, undelegated, amount math.Int) (sdk.Coin, error) {
+	undelegation, err := k.GetHostAccountUndelegationForEpoch(ctx, epochNumber)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	nextEpochNumber := epochNumber + types.UndelegationEpochNumberFactor
+
+	left := sdk.NewCoin(undelegation.TotalUndelegationAmount.Denom, sdk.ZeroInt())
+	for _, entry := range k.IterateAllDelegatorUnbondingEpochEntry(ctx) {
+		if entry.EpochNumber != epochNumber {
+			continue
+		}
+		delegatorAddress, err := sdk.AccAddressFromBech32(entry.DelegatorAddress)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		leftAmount := entry.Amount.Amount.Mul(undelegated).Quo(amount)
+		carried := entry.Amount.SubAmount(leftAmount)
+		if leftAmount.IsPositive() {
+			entry.Amount.Amount = leftAmount
+			k.SetDelegatorUnbondingEpochEntry(ctx, entry)
+			left = left.AddAmount(leftAmount)
+		} else {
+			k.RemoveDelegatorUnbondingEpochEntry(ctx, delegatorAddress, epochNumber)
+		}
+		if carried.IsPositive() {
+			k.AddDelegatorUnbondingEpochEntry(ctx, delegatorAddress, nextEpochNumber, carried)
+		}
+	}
+
+	if err := k.RemoveHostAccountUndelegation(ctx, epochNumber); err != nil {
+		return sdk.Coin{}, err
+	}
+	if left.IsPositive() {
+		k.AddTotalUndelegationForEpoch(ctx, epochNumber, left)
+	}
+	if carried := undelegation.TotalUndelegationAmount.Sub(left); carried.IsPositive() {
+		k.AddTotalUndelegationForEpoch(ctx, nextEpochNumber, carried)
+	}
+	return left, nil
+}
+
 // UpdateCompletionTimeForUndelegationEpoch updates the completion time for undelegation epoch
 // corresponding to the input epoch number in types.DelegationState
 func (k Keeper) UpdateCompletionTimeForUndelegationEpoch(ctx sdk.Context, epochNumber int64, completionTime time.Time) {
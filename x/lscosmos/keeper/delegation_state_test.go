@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
@@ -64,3 +66,29 @@ func (suite *IntegrationTestSuite) TestDelegationState() {
 	suite.Error(err)
 
 }
+
+func (suite *IntegrationTestSuite) TestOpenUndelegationEntries() {
+	app, ctx := suite.app, suite.ctx
+
+	baseDenom := app.LSCosmosKeeper.GetHostChainParams(ctx).BaseDenom
+	entries := []types.UndelegationEntry{
+		{ValidatorAddress: "address_______________1", Amount: sdk.NewInt64Coin(baseDenom, 10)},
+		{ValidatorAddress: "address_______________2", Amount: sdk.NewInt64Coin(baseDenom, 10)},
+	}
+	delegationState := types.DelegationState{
+		HostAccountUndelegations: []types.HostAccountUndelegation{
+			// matured
+			{EpochNumber: 1, CompletionTime: ctx.BlockTime().Add(-time.Hour), UndelegationEntries: entries},
+			// unbonding on host chain
+			{EpochNumber: 2, CompletionTime: ctx.BlockTime().Add(time.Hour), UndelegationEntries: entries},
+			// not acknowledged yet
+			{EpochNumber: 3, CompletionTime: time.Time{}, UndelegationEntries: entries[:1]},
+		},
+	}
+
+	openEntries := delegationState.OpenUndelegationEntries(ctx.BlockTime())
+	suite.Equal(map[string]uint32{
+		"address_______________1": 2,
+		"address_______________2": 1,
+	}, openEntries)
+}
@@ -0,0 +1,299 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+var (
+	_ types.DelegationStrategy = WeightedDiffStrategy{}
+	_ types.DelegationStrategy = ProRataStrategy{}
+	_ types.DelegationStrategy = MinMessagesStrategy{}
+	_ types.DelegationStrategy = UnbondingAwareStrategy{}
+)
+
+// NewDelegationStrategy returns the DelegationStrategy implementation of the strategy type
+func NewDelegationStrategy(strategyType types.DelegationStrategyType) (types.DelegationStrategy, error) {
+	switch strategyType {
+	case types.DelegationStrategyWeightedDiff:
+		return WeightedDiffStrategy{}, nil
+	case types.DelegationStrategyProRata:
+		return ProRataStrategy{}, nil
+	case types.DelegationStrategyMinMessages:
+		return MinMessagesStrategy{}, nil
+	case types.DelegationStrategyUnbondingAware:
+		return UnbondingAwareStrategy{}, nil
+	default:
+		return nil, errorsmod.Wrapf(types.ErrInvalidDelegationStrategy, "unknown delegation strategy %d", strategyType)
+	}
+}
+
+// GetDelegationStrategy returns the delegation strategy selected by governance in the module params
+func (k Keeper) GetDelegationStrategy(ctx sdk.Context) (types.DelegationStrategy, error) {
+	strategy, err := NewDelegationStrategy(k.GetParams(ctx).DelegationStrategy)
+	if err != nil {
+		return nil, err
+	}
+	if unbondingAware, ok := strategy.(UnbondingAwareStrategy); ok {
+		unbondingAware.BlockTime = ctx.BlockTime()
+		return unbondingAware, nil
+	}
+	return strategy, nil
+}
+
+// WeightedDiffStrategy moves every validator towards its target weight, delegating to the most
+// under-delegated and undelegating from the most over-delegated validators first.
+type WeightedDiffStrategy struct{}
+
+// Delegate implements types.DelegationStrategy
+func (WeightedDiffStrategy) Delegate(valList types.AllowListedValidators, delegationState types.DelegationState, amount sdk.Coin) (types.ValAddressAmounts, error) {
+	return FetchValidatorsToDelegate(valList, delegationState, amount)
+}
+
+// Undelegate implements types.DelegationStrategy
+func (WeightedDiffStrategy) Undelegate(valList types.AllowListedValidators, delegationState types.DelegationState, amount sdk.Coin) (types.ValAddressAmounts, error) {
+	return FetchValidatorsToUndelegate(valList, delegationState, amount)
+}
+
+// ProRataStrategy divides the amount by target weight regardless of the current delegations.
+// Undelegations are capped by the validator delegation, the excess is undelegated from the
+// validators with the largest remaining delegations.
+type ProRataStrategy struct{}
+
+// Delegate implements types.DelegationStrategy
+func (ProRataStrategy) Delegate(valList types.AllowListedValidators, _ types.DelegationState, amount sdk.Coin) (types.ValAddressAmounts, error) {
+	if amount.IsZero() {
+		return nil, nil
+	}
+
+	_, nonZeroWeighted := types.GetZeroNonZeroWightedAddrAmts(weightedAddressAmounts(valList, amount.Denom))
+	if len(nonZeroWeighted) == 0 {
+		return nil, types.ErrInValidAllowListedValidators
+	}
+
+	valAmounts, remainder := divideByWeight(nonZeroWeighted, amount)
+	// Remaining token is the slippage from the multiplication with dec,
+	// assign it to the validator with the highest weight.
+	if remainder.IsPositive() {
+		highest := 0
+		for i := range nonZeroWeighted {
+			if nonZeroWeighted[i].Weight.GT(nonZeroWeighted[highest].Weight) {
+				highest = i
+			}
+		}
+		valAmounts[highest].Amount = valAmounts[highest].Amount.Add(remainder)
+	}
+
+	sort.Sort(valAmounts)
+	return valAmounts, nil
+}
+
+// Undelegate implements types.DelegationStrategy
+func (ProRataStrategy) Undelegate(valList types.AllowListedValidators, delegationState types.DelegationState, amount sdk.Coin) (types.ValAddressAmounts, error) {
+	if amount.IsZero() {
+		return nil, nil
+	}
+	totalDelegations := delegationState.TotalDelegations(amount.Denom)
+	if totalDelegations.IsLT(amount) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientFundsToUndelegate, "staked:  %s, undelegate : %s", totalDelegations, amount)
+	}
+
+	delegationMap := types.GetHostAccountDelegationMap(delegationState.HostAccountDelegations)
+	_, nonZeroWeighted := types.GetZeroNonZeroWightedAddrAmts(weightedAddressAmounts(valList, amount.Denom))
+	weightedAmounts, _ := divideByWeight(nonZeroWeighted, amount)
+
+	undelegations := map[string]math.Int{}
+	remaining := amount.Amount
+	for _, valAmount := range weightedAmounts {
+		delegated := delegationAmount(delegationMap, valAmount.ValidatorAddr)
+		undelegate := sdk.MinInt(valAmount.Amount.Amount, delegated)
+		undelegations[valAmount.ValidatorAddr] = undelegate
+		remaining = remaining.Sub(undelegate)
+	}
+
+	// undelegate the capped and truncated amounts from the validators with the largest remaining delegations
+	leftovers := types.WeightedAddressAmounts{}
+	for _, val := range valList.AllowListedValidators {
+		undelegated, ok := undelegations[val.ValidatorAddress]
+		if !ok {
+			undelegated = sdk.ZeroInt()
+		}
+		leftovers = append(leftovers, types.WeightedAddressAmount{
+			Address: val.ValidatorAddress,
+			Weight:  val.TargetWeight,
+			Denom:   amount.Denom,
+			Amount:  delegationAmount(delegationMap, val.ValidatorAddress).Sub(undelegated),
+		})
+	}
+	sort.Sort(sort.Reverse(leftovers))
+	for _, leftover := range leftovers {
+		if !remaining.IsPositive() {
+			break
+		}
+		undelegate := sdk.MinInt(remaining, leftover.Amount)
+		if !undelegate.IsPositive() {
+			continue
+		}
+		undelegated, ok := undelegations[leftover.Address]
+		if !ok {
+			undelegated = sdk.ZeroInt()
+		}
+		undelegations[leftover.Address] = undelegated.Add(undelegate)
+		remaining = remaining.Sub(undelegate)
+	}
+
+	return toValAddressAmounts(undelegations, amount.Denom), nil
+}
+
+// MinMessagesStrategy touches the fewest validators per epoch. The whole amount is delegated to
+// the most under-delegated validator and undelegated from the zero weighted validators first, then from
+// the most over-delegated validators w.r.t their target weights, emptying each delegation before the next.
+type MinMessagesStrategy struct{}
+
+// Delegate implements types.DelegationStrategy
+func (MinMessagesStrategy) Delegate(valList types.AllowListedValidators, delegationState types.DelegationState, amount sdk.Coin) (types.ValAddressAmounts, error) {
+	if amount.IsZero() {
+		return nil, nil
+	}
+	curDiffDistribution, err := GetIdealCurrentDelegations(valList, delegationState, amount, false)
+	if err != nil {
+		return nil, err
+	}
+
+	_, nonZeroWeighted := types.GetZeroNonZeroWightedAddrAmts(curDiffDistribution)
+	if len(nonZeroWeighted) == 0 {
+		return nil, types.ErrInValidAllowListedValidators
+	}
+	sort.Sort(sort.Reverse(nonZeroWeighted))
+
+	return types.ValAddressAmounts{{ValidatorAddr: nonZeroWeighted[0].Address, Amount: amount}}, nil
+}
+
+// Undelegate implements types.DelegationStrategy
+func (MinMessagesStrategy) Undelegate(valList types.AllowListedValidators, delegationState types.DelegationState, amount sdk.Coin) (types.ValAddressAmounts, error) {
+	if amount.IsZero() {
+		return nil, nil
+	}
+	currDiffDistribution, err := GetIdealCurrentDelegations(valList, delegationState, amount, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Undelegate first from zero weighted validators then from the most over-delegated ones
+	zeroWeighted, nonZeroWeighted := types.GetZeroNonZeroWightedAddrAmts(currDiffDistribution)
+	sort.Sort(sort.Reverse(zeroWeighted))
+	sort.Sort(sort.Reverse(nonZeroWeighted))
+	valWeighted := append(zeroWeighted, nonZeroWeighted...)
+
+	delegationMap := types.GetHostAccountDelegationMap(delegationState.HostAccountDelegations)
+	undelegations := map[string]math.Int{}
+	remaining := amount.Amount
+	for _, w := range valWeighted {
+		if !remaining.IsPositive() {
+			break
+		}
+		undelegate := sdk.MinInt(remaining, delegationAmount(delegationMap, w.Address))
+		if !undelegate.IsPositive() {
+			continue
+		}
+		undelegations[w.Address] = undelegate
+		remaining = remaining.Sub(undelegate)
+	}
+
+	return toValAddressAmounts(undelegations, amount.Denom), nil
+}
+
+// UnbondingAwareStrategy is the WeightedDiffStrategy which undelegates from the validators having the
+// fewest open host chain unbonding entries at BlockTime that can cover the amount, spreading the entries
+// so validators keep room below the host chain MaxEntries.
+type UnbondingAwareStrategy struct {
+	BlockTime time.Time
+}
+
+// Delegate implements types.DelegationStrategy
+func (s UnbondingAwareStrategy) Delegate(valList types.AllowListedValidators, delegationState types.DelegationState, amount sdk.Coin) (types.ValAddressAmounts, error) {
+	return FetchValidatorsToDelegate(valList, delegationState, amount)
+}
+
+// Undelegate implements types.DelegationStrategy
+func (s UnbondingAwareStrategy) Undelegate(valList types.AllowListedValidators, delegationState types.DelegationState, amount sdk.Coin) (types.ValAddressAmounts, error) {
+	openEntries := delegationState.OpenUndelegationEntries(s.BlockTime)
+
+	var entryCounts []uint32
+	seen := map[uint32]bool{}
+	for _, delegation := range delegationState.HostAccountDelegations {
+		count := openEntries[delegation.ValidatorAddress]
+		if !seen[count] {
+			seen[count] = true
+			entryCounts = append(entryCounts, count)
+		}
+	}
+	sort.Slice(entryCounts, func(i, j int) bool { return entryCounts[i] < entryCounts[j] })
+
+	for _, count := range entryCounts {
+		eligibleValList, eligibleDelegationState := FilterValidatorsBelowMaxEntries(valList, delegationState, openEntries, count+1)
+		if !eligibleDelegationState.TotalDelegations(amount.Denom).IsLT(amount) {
+			return FetchValidatorsToUndelegate(eligibleValList, eligibleDelegationState, amount)
+		}
+	}
+	return FetchValidatorsToUndelegate(valList, delegationState, amount)
+}
+
+// weightedAddressAmounts returns the validators of the list as zero amount WeightedAddressAmounts
+func weightedAddressAmounts(valList types.AllowListedValidators, denom string) types.WeightedAddressAmounts {
+	ws := types.WeightedAddressAmounts{}
+	for _, val := range valList.AllowListedValidators {
+		ws = append(ws, types.WeightedAddressAmount{
+			Address: val.ValidatorAddress,
+			Weight:  val.TargetWeight,
+			Denom:   denom,
+			Amount:  sdk.ZeroInt(),
+		})
+	}
+	return ws
+}
+
+// divideByWeight divides the coin amongst the validators w.r.t their weights and returns
+// the truncated amounts along with the remainder
+func divideByWeight(ws types.WeightedAddressAmounts, coin sdk.Coin) (types.ValAddressAmounts, sdk.Coin) {
+	totalWeight := sdk.ZeroDec()
+	for _, w := range ws {
+		totalWeight = totalWeight.Add(w.Weight)
+	}
+
+	var valAmounts types.ValAddressAmounts
+	remainder := coin
+	for _, w := range ws {
+		amt := w.Weight.Quo(totalWeight).MulInt(coin.Amount).TruncateInt()
+		valAmounts = append(valAmounts, types.ValAddressAmount{ValidatorAddr: w.Address, Amount: sdk.NewCoin(coin.Denom, amt)})
+		remainder = remainder.SubAmount(amt)
+	}
+	return valAmounts, remainder
+}
+
+// delegationAmount returns the delegated amount of the validator, zero if it is not delegated to
+func delegationAmount(delegationMap map[string]sdk.Coin, validatorAddress string) math.Int {
+	delegation, ok := delegationMap[validatorAddress]
+	if !ok {
+		return sdk.ZeroInt()
+	}
+	return delegation.Amount
+}
+
+// toValAddressAmounts converts the positive amounts of the map to ValAddressAmounts sorted by address
+func toValAddressAmounts(amounts map[string]math.Int, denom string) types.ValAddressAmounts {
+	var valAmounts types.ValAddressAmounts
+	for addr, amt := range amounts {
+		if amt.IsPositive() {
+			valAmounts = append(valAmounts, types.ValAddressAmount{ValidatorAddr: addr, Amount: sdk.NewCoin(denom, amt)})
+		}
+	}
+	sort.Sort(valAmounts)
+	return valAmounts
+}
@@ -12,7 +12,8 @@ import (
 	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
 )
 
-// DelegateMsgs gives the list of Delegate Txs to be executed based on the current state and params.
+// DelegateMsgs gives the list of Delegate Txs to be executed based on the current state and the
+// delegation strategy selected in params.
 // CONTRACT: allowlistedValList.len > 0, amount > 0
 func (k Keeper) DelegateMsgs(ctx sdk.Context, amount math.Int, denom string, delegationState types.DelegationState) ([]proto.Message, error) {
 	// fetch a combined updated val set list and delegation state
@@ -23,7 +24,11 @@ func (k Keeper) DelegateMsgs(ctx sdk.Context, amount math.Int, denom string, del
 
 	updatedAllowListedValidators := types.AllowListedValidators{AllowListedValidators: updateValList}
 
-	valAddressAmount, err := FetchValidatorsToDelegate(updatedAllowListedValidators, delegationState, sdk.NewCoin(denom, amount))
+	strategy, err := k.GetDelegationStrategy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	valAddressAmount, err := strategy.Delegate(updatedAllowListedValidators, delegationState, sdk.NewCoin(denom, amount))
 	if err != nil {
 		return nil, err
 	}
@@ -47,7 +52,10 @@ func (k Keeper) DelegateMsgs(ctx sdk.Context, amount math.Int, denom string, del
 	return msgs, nil
 }
 
-// UndelegateMsgs gives the list of Undelegate Txs to be executed based on the current state and params.
+// UndelegateMsgs gives the list of Undelegate Txs to be executed based on the current state and the
+// delegation strategy selected in params. Validators which have reached the host chain max unbonding
+// entries are skipped and the amount is undelegated from the rest, at most their delegations, the caller
+// carries the rest over to the next undelegation epoch.
 // CONTRACT: allowlistedValList.len > 0, amount > 0
 func (k Keeper) UndelegateMsgs(ctx sdk.Context, amount math.Int, denom string, delegationState types.DelegationState) ([]proto.Message, []types.UndelegationEntry, error) {
 	// fetch a combined updated val set list and delegation state
@@ -58,7 +66,24 @@ func (k Keeper) UndelegateMsgs(ctx sdk.Context, amount math.Int, denom string, d
 
 	updatedAllowListedValidators := types.AllowListedValidators{AllowListedValidators: updateValList}
 
-	valAddressAmount, err := FetchValidatorsToUndelegate(updatedAllowListedValidators, delegationState, sdk.NewCoin(denom, amount))
+	// host chain rejects undelegations to validators having max entries, and with it the whole ica tx
+	maxEntries := k.GetHostMaxEntries(ctx)
+	updatedAllowListedValidators, delegationState = FilterValidatorsBelowMaxEntries(
+		updatedAllowListedValidators, delegationState, delegationState.OpenUndelegationEntries(ctx.BlockTime()), maxEntries,
+	)
+	eligibleDelegations := delegationState.TotalDelegations(denom)
+	if !eligibleDelegations.IsPositive() {
+		return nil, nil, errorsmod.Wrapf(types.ErrHostMaxEntriesReached, "max entries: %d, undelegatable: %s, undelegate: %s%s", maxEntries, eligibleDelegations, amount, denom)
+	}
+	if eligibleDelegations.Amount.LT(amount) {
+		amount = eligibleDelegations.Amount
+	}
+
+	strategy, err := k.GetDelegationStrategy(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	valAddressAmount, err := strategy.Undelegate(updatedAllowListedValidators, delegationState, sdk.NewCoin(denom, amount))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -91,6 +116,28 @@ func (k Keeper) UndelegateMsgs(ctx sdk.Context, amount math.Int, denom string, d
 	return msgs, undelegationEntries, nil
 }
 
+// FilterValidatorsBelowMaxEntries removes the validators having maxEntries or more open unbonding entries,
+// as counted by DelegationState.OpenUndelegationEntries, from the allow listed validators and the delegations
+// of the delegation state.
+func FilterValidatorsBelowMaxEntries(valList types.AllowListedValidators, delegationState types.DelegationState, openEntries map[string]uint32, maxEntries uint32) (types.AllowListedValidators, types.DelegationState) {
+	eligibleValList := types.AllowListedValidators{}
+	for _, val := range valList.AllowListedValidators {
+		if openEntries[val.ValidatorAddress] < maxEntries {
+			eligibleValList.AllowListedValidators = append(eligibleValList.AllowListedValidators, val)
+		}
+	}
+
+	eligibleDelegations := []types.HostAccountDelegation{}
+	for _, delegation := range delegationState.HostAccountDelegations {
+		if openEntries[delegation.ValidatorAddress] < maxEntries {
+			eligibleDelegations = append(eligibleDelegations, delegation)
+		}
+	}
+	delegationState.HostAccountDelegations = eligibleDelegations
+
+	return eligibleValList, delegationState
+}
+
 // FetchValidatorsToDelegate gives a list of all validators having weighted amount for few and 1uatom for rest in order to auto claim all rewards accumulated in current epoch
 func FetchValidatorsToDelegate(valList types.AllowListedValidators, delegationState types.DelegationState, amount sdk.Coin) (types.ValAddressAmounts, error) {
 	curDiffDistribution, err := GetIdealCurrentDelegations(valList, delegationState, amount, false)
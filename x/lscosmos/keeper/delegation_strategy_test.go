@@ -6,6 +6,7 @@ import (
 	"math"
 	"sort"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
@@ -17,6 +18,101 @@ import (
 
 var HostStakingDenom = "uatom"
 
+// strategyTestVector is the expected distribution of the given amount amongst the validators of testStateData
+type strategyTestVector struct {
+	given    int64
+	expected map[string]int64
+}
+
+// weightedDiffDelegateVectors are the delegate vectors of the weighted diff strategy
+var weightedDiffDelegateVectors = []strategyTestVector{
+	{
+		given: 1000,
+		expected: map[string]int64{
+			"cosmosvalidatorAddr3": 1000,
+		},
+	},
+	{
+		given: 10000000,
+		expected: map[string]int64{
+			"cosmosvalidatorAddr3": 8500000,
+			"cosmosvalidatorAddr4": 1500000,
+		},
+	},
+	{
+		given: 20000000,
+		expected: map[string]int64{
+			"cosmosvalidatorAddr3": 11500000,
+			"cosmosvalidatorAddr1": 7000000,
+			"cosmosvalidatorAddr4": 1500000,
+		},
+	},
+	{
+		given: 30000000,
+		expected: map[string]int64{
+			"cosmosvalidatorAddr1": 11000000,
+			"cosmosvalidatorAddr3": 14500000,
+			"cosmosvalidatorAddr4": 4500000,
+		},
+	},
+	{
+		given: 50000000,
+		expected: map[string]int64{
+			"cosmosvalidatorAddr1": 19000000,
+			"cosmosvalidatorAddr2": 2000000,
+			"cosmosvalidatorAddr3": 20500000,
+			"cosmosvalidatorAddr4": 8500000,
+		},
+	},
+}
+
+// weightedDiffUndelegateVectors are the undelegate vectors of the weighted diff strategy
+var weightedDiffUndelegateVectors = []strategyTestVector{
+	{
+		given: 1000,
+		expected: map[string]int64{
+			"cosmosvalidatorAddr5": 1000,
+		},
+	},
+	{
+		given: 10000000,
+		expected: map[string]int64{
+			"cosmosvalidatorAddr5": 5000000,
+			"cosmosvalidatorAddr2": 5000000,
+		},
+	},
+	{
+		given:    0,
+		expected: map[string]int64{},
+	},
+	{
+		given: 20000000,
+		expected: map[string]int64{
+			"cosmosvalidatorAddr1": 9000000,
+			"cosmosvalidatorAddr2": 6000000,
+			"cosmosvalidatorAddr5": 5000000,
+		},
+	},
+	{
+		given: 30000000,
+		expected: map[string]int64{
+			"cosmosvalidatorAddr1": 13000000,
+			"cosmosvalidatorAddr2": 9000000,
+			"cosmosvalidatorAddr3": 3000000,
+			"cosmosvalidatorAddr5": 5000000,
+		},
+	},
+	{
+		given: 35000000,
+		expected: map[string]int64{
+			"cosmosvalidatorAddr1": 15000000,
+			"cosmosvalidatorAddr2": 10000000,
+			"cosmosvalidatorAddr3": 5000000,
+			"cosmosvalidatorAddr5": 5000000,
+		},
+	},
+}
+
 func (suite *IntegrationTestSuite) SetupAllowListedValSetAndDelegationState(ws types.WeightedAddressAmounts) {
 	app, ctx := suite.app, suite.ctx
 	allList := make([]types.AllowListedValidator, ws.Len())
@@ -47,52 +143,7 @@ func (suite *IntegrationTestSuite) TestDivideAmountIntoValidatorSet() {
 	state := testStateData(denom)
 	suite.SetupAllowListedValSetAndDelegationState(state)
 
-	// Test data
-	testMatrix := []struct {
-		given    int64
-		expected map[string]int64
-	}{
-		{
-			given: 1000,
-			expected: map[string]int64{
-				"cosmosvalidatorAddr3": 1000,
-			},
-		},
-		{
-			given: 10000000,
-			expected: map[string]int64{
-				"cosmosvalidatorAddr3": 8500000,
-				"cosmosvalidatorAddr4": 1500000,
-			},
-		},
-		{
-			given: 20000000,
-			expected: map[string]int64{
-				"cosmosvalidatorAddr3": 11500000,
-				"cosmosvalidatorAddr1": 7000000,
-				"cosmosvalidatorAddr4": 1500000,
-			},
-		},
-		{
-			given: 30000000,
-			expected: map[string]int64{
-				"cosmosvalidatorAddr1": 11000000,
-				"cosmosvalidatorAddr3": 14500000,
-				"cosmosvalidatorAddr4": 4500000,
-			},
-		},
-		{
-			given: 50000000,
-			expected: map[string]int64{
-				"cosmosvalidatorAddr1": 19000000,
-				"cosmosvalidatorAddr2": 2000000,
-				"cosmosvalidatorAddr3": 20500000,
-				"cosmosvalidatorAddr4": 8500000,
-			},
-		},
-	}
-
-	for _, test := range testMatrix {
+	for _, test := range weightedDiffDelegateVectors {
 		givenCoin := sdk.NewInt64Coin(HostStakingDenom, test.given)
 		expectedMap := map[string]int64{}
 
@@ -182,60 +233,7 @@ func (suite *IntegrationTestSuite) TestUndelegateDivideAmountIntoValidatorSet()
 	state := testStateData(denom)
 	suite.SetupAllowListedValSetAndDelegationState(state)
 
-	// Test data
-	// Test data
-	testMatrix := []struct {
-		given    int64
-		expected map[string]int64
-	}{
-		{
-			given: 1000,
-			expected: map[string]int64{
-				"cosmosvalidatorAddr5": 1000,
-			},
-		},
-		{
-			given: 10000000,
-			expected: map[string]int64{
-				"cosmosvalidatorAddr5": 5000000,
-				"cosmosvalidatorAddr2": 5000000,
-			},
-		},
-		{
-			given:    0,
-			expected: map[string]int64{},
-		},
-		{
-			given: 20000000,
-			expected: map[string]int64{
-				"cosmosvalidatorAddr1": 9000000,
-				"cosmosvalidatorAddr2": 6000000,
-				"cosmosvalidatorAddr5": 5000000,
-			},
-		},
-		{
-			given: 30000000,
-			expected: map[string]int64{
-				"cosmosvalidatorAddr1": 13000000,
-				"cosmosvalidatorAddr2": 9000000,
-				"cosmosvalidatorAddr3": 3000000,
-				"cosmosvalidatorAddr5": 5000000,
-			},
-		},
-		{
-			given: 35000000,
-			expected: map[string]int64{
-				"cosmosvalidatorAddr1": 15000000,
-				"cosmosvalidatorAddr2": 10000000,
-				"cosmosvalidatorAddr3": 5000000,
-				"cosmosvalidatorAddr5": 5000000,
-			},
-		},
-	}
-
-	// Create input parameters
-
-	for _, test := range testMatrix {
+	for _, test := range weightedDiffUndelegateVectors {
 		givenCoin := sdk.NewInt64Coin(HostStakingDenom, test.given)
 		expectedMap := map[string]int64{}
 
@@ -2599,3 +2597,271 @@ func (suite *IntegrationTestSuite) TestDelegateAndUndelegate() {
 		}
 	}
 }
+
+func (suite *IntegrationTestSuite) TestDelegationStrategies() {
+	_, ctx := suite.app, suite.ctx
+
+	denom := HostStakingDenom
+	state := testStateData(denom)
+	suite.SetupAllowListedValSetAndDelegationState(state)
+
+	allowlistedVals := suite.app.LSCosmosKeeper.GetAllowListedValidators(ctx)
+	delegationState := suite.app.LSCosmosKeeper.GetDelegationState(ctx)
+
+	// every strategy is run on the amounts of the weighted diff vectors
+	testMatrix := []struct {
+		strategyType      types.DelegationStrategyType
+		delegateVectors   []strategyTestVector
+		undelegateVectors []strategyTestVector
+	}{
+		{
+			strategyType:      types.DelegationStrategyWeightedDiff,
+			delegateVectors:   weightedDiffDelegateVectors,
+			undelegateVectors: weightedDiffUndelegateVectors,
+		},
+		{
+			// no unbonding entries, same as weighted diff
+			strategyType:      types.DelegationStrategyUnbondingAware,
+			delegateVectors:   weightedDiffDelegateVectors,
+			undelegateVectors: weightedDiffUndelegateVectors,
+		},
+		{
+			strategyType: types.DelegationStrategyProRata,
+			delegateVectors: []strategyTestVector{
+				{given: 1000, expected: map[string]int64{"cosmosvalidatorAddr1": 400, "cosmosvalidatorAddr2": 200, "cosmosvalidatorAddr3": 300, "cosmosvalidatorAddr4": 100}},
+				{given: 10000000, expected: map[string]int64{"cosmosvalidatorAddr1": 4000000, "cosmosvalidatorAddr2": 2000000, "cosmosvalidatorAddr3": 3000000, "cosmosvalidatorAddr4": 1000000}},
+				{given: 20000000, expected: map[string]int64{"cosmosvalidatorAddr1": 8000000, "cosmosvalidatorAddr2": 4000000, "cosmosvalidatorAddr3": 6000000, "cosmosvalidatorAddr4": 2000000}},
+				{given: 30000000, expected: map[string]int64{"cosmosvalidatorAddr1": 12000000, "cosmosvalidatorAddr2": 6000000, "cosmosvalidatorAddr3": 9000000, "cosmosvalidatorAddr4": 3000000}},
+				{given: 50000000, expected: map[string]int64{"cosmosvalidatorAddr1": 20000000, "cosmosvalidatorAddr2": 10000000, "cosmosvalidatorAddr3": 15000000, "cosmosvalidatorAddr4": 5000000}},
+			},
+			// capped by the delegations, the excess is taken from the largest remaining delegations
+			undelegateVectors: []strategyTestVector{
+				{given: 1000, expected: map[string]int64{"cosmosvalidatorAddr1": 500, "cosmosvalidatorAddr2": 200, "cosmosvalidatorAddr3": 300}},
+				{given: 10000000, expected: map[string]int64{"cosmosvalidatorAddr1": 5000000, "cosmosvalidatorAddr2": 2000000, "cosmosvalidatorAddr3": 3000000}},
+				{given: 0, expected: map[string]int64{}},
+				{given: 20000000, expected: map[string]int64{"cosmosvalidatorAddr1": 11000000, "cosmosvalidatorAddr2": 4000000, "cosmosvalidatorAddr3": 5000000}},
+				{given: 30000000, expected: map[string]int64{"cosmosvalidatorAddr1": 12000000, "cosmosvalidatorAddr2": 8000000, "cosmosvalidatorAddr3": 5000000, "cosmosvalidatorAddr5": 5000000}},
+				{given: 35000000, expected: map[string]int64{"cosmosvalidatorAddr1": 15000000, "cosmosvalidatorAddr2": 10000000, "cosmosvalidatorAddr3": 5000000, "cosmosvalidatorAddr5": 5000000}},
+			},
+		},
+		{
+			strategyType: types.DelegationStrategyMinMessages,
+			delegateVectors: []strategyTestVector{
+				{given: 1000, expected: map[string]int64{"cosmosvalidatorAddr3": 1000}},
+				{given: 10000000, expected: map[string]int64{"cosmosvalidatorAddr3": 10000000}},
+				{given: 20000000, expected: map[string]int64{"cosmosvalidatorAddr3": 20000000}},
+				{given: 30000000, expected: map[string]int64{"cosmosvalidatorAddr3": 30000000}},
+				{given: 50000000, expected: map[string]int64{"cosmosvalidatorAddr3": 50000000}},
+			},
+			// zero weighted first, then the most over-delegated
+			undelegateVectors: []strategyTestVector{
+				{given: 1000, expected: map[string]int64{"cosmosvalidatorAddr5": 1000}},
+				{given: 10000000, expected: map[string]int64{"cosmosvalidatorAddr5": 5000000, "cosmosvalidatorAddr2": 5000000}},
+				{given: 0, expected: map[string]int64{}},
+				{given: 20000000, expected: map[string]int64{"cosmosvalidatorAddr5": 5000000, "cosmosvalidatorAddr1": 15000000}},
+				{given: 30000000, expected: map[string]int64{"cosmosvalidatorAddr5": 5000000, "cosmosvalidatorAddr1": 15000000, "cosmosvalidatorAddr2": 10000000}},
+				{given: 35000000, expected: map[string]int64{"cosmosvalidatorAddr5": 5000000, "cosmosvalidatorAddr1": 15000000, "cosmosvalidatorAddr2": 10000000, "cosmosvalidatorAddr3": 5000000}},
+			},
+		},
+	}
+
+	toValAddressMap := func(expected map[string]int64) map[string]int64 {
+		expectedMap := map[string]int64{}
+		for k, v := range expected {
+			valAddress, _ := Bech32ifyValAddressBytes(types.CosmosValOperPrefix, sdk.ValAddress(k))
+			expectedMap[valAddress] = v
+		}
+		return expectedMap
+	}
+	toActualMap := func(valAmounts types.ValAddressAmounts) map[string]int64 {
+		actualMap := map[string]int64{}
+		for _, va := range valAmounts {
+			actualMap[va.ValidatorAddr] = va.Amount.Amount.Int64()
+		}
+		return actualMap
+	}
+
+	suite.Len(testMatrix, len(types.DelegationStrategyType_name))
+	for _, test := range testMatrix {
+		strategy, err := keeper.NewDelegationStrategy(test.strategyType)
+		suite.NoError(err)
+		name := test.strategyType.String()
+
+		for _, vector := range test.delegateVectors {
+			valAmounts, err := strategy.Delegate(allowlistedVals, delegationState, sdk.NewInt64Coin(denom, vector.given))
+			suite.NoError(err, name)
+			suite.Equal(toValAddressMap(vector.expected), toActualMap(valAmounts), "%s delegate %d", name, vector.given)
+		}
+
+		for _, vector := range test.undelegateVectors {
+			valAmounts, err := strategy.Undelegate(allowlistedVals, delegationState, sdk.NewInt64Coin(denom, vector.given))
+			suite.NoError(err, name)
+			suite.Equal(toValAddressMap(vector.expected), toActualMap(valAmounts), "%s undelegate %d", name, vector.given)
+		}
+
+		_, err = strategy.Undelegate(allowlistedVals, delegationState, sdk.NewInt64Coin(denom, 35000001))
+		suite.ErrorIs(err, types.ErrInsufficientFundsToUndelegate, name)
+	}
+
+	// weighted diff is the default strategy
+	strategy, err := suite.app.LSCosmosKeeper.GetDelegationStrategy(ctx)
+	suite.NoError(err)
+	suite.Equal(keeper.WeightedDiffStrategy{}, strategy)
+
+	// unbonding aware undelegates from the validators with the fewest open entries, matured ones are not counted
+	blockTime := time.Unix(1000, 0)
+	entriesState := delegationState
+	entriesState.HostAccountUndelegations = nil
+	for i := int64(0); i < 3; i++ {
+		entriesState.HostAccountUndelegations = append(entriesState.HostAccountUndelegations,
+			types.HostAccountUndelegation{
+				EpochNumber:             i,
+				TotalUndelegationAmount: sdk.NewInt64Coin(denom, 1),
+				UndelegationEntries:     []types.UndelegationEntry{{ValidatorAddress: state[0].Address, Amount: sdk.NewInt64Coin(denom, 1)}},
+			},
+			types.HostAccountUndelegation{
+				EpochNumber:             i,
+				TotalUndelegationAmount: sdk.NewInt64Coin(denom, 1),
+				UndelegationEntries:     []types.UndelegationEntry{{ValidatorAddress: state[1].Address, Amount: sdk.NewInt64Coin(denom, 1)}},
+				CompletionTime:          blockTime.Add(-time.Second),
+			},
+		)
+	}
+	unbondingAware := keeper.UnbondingAwareStrategy{BlockTime: blockTime}
+	valAmounts, err := unbondingAware.Undelegate(allowlistedVals, entriesState, sdk.NewInt64Coin(denom, 20000000))
+	suite.NoError(err)
+	actualMap := toActualMap(valAmounts)
+	suite.NotContains(actualMap, state[0].Address)
+	suite.Contains(actualMap, state[1].Address)
+	total := int64(0)
+	for _, amount := range actualMap {
+		total += amount
+	}
+	suite.Equal(int64(20000000), total)
+
+	// the validators without open entries do not cover the amount
+	valAmounts, err = unbondingAware.Undelegate(allowlistedVals, entriesState, sdk.NewInt64Coin(denom, 30000000))
+	suite.NoError(err)
+	suite.Equal(toValAddressMap(weightedDiffUndelegateVectors[4].expected), toActualMap(valAmounts))
+
+	suite.app.LSCosmosKeeper.SetParams(ctx, types.NewParams(types.DelegationStrategyUnbondingAware, sdk.ZeroInt(), nil))
+	strategy, err = suite.app.LSCosmosKeeper.GetDelegationStrategy(ctx)
+	suite.NoError(err)
+	suite.Equal(keeper.UnbondingAwareStrategy{BlockTime: ctx.BlockTime()}, strategy)
+
+	// strategy can be switched through params
+	suite.app.LSCosmosKeeper.SetParams(ctx, types.NewParams(types.DelegationStrategyMinMessages, sdk.ZeroInt(), nil))
+	strategy, err = suite.app.LSCosmosKeeper.GetDelegationStrategy(ctx)
+	suite.NoError(err)
+	suite.Equal(keeper.MinMessagesStrategy{}, strategy)
+	suite.app.LSCosmosKeeper.SetParams(ctx, types.DefaultParams())
+
+	_, err = keeper.NewDelegationStrategy(types.DelegationStrategyType(100))
+	suite.ErrorIs(err, types.ErrInvalidDelegationStrategy)
+}
+
+func (suite *IntegrationTestSuite) TestFilterValidatorsBelowMaxEntries() {
+	denom := HostStakingDenom
+	state := testStateData(denom)
+	suite.SetupAllowListedValSetAndDelegationState(state)
+
+	allowlistedVals := suite.app.LSCosmosKeeper.GetAllowListedValidators(suite.ctx)
+	delegationState := suite.app.LSCosmosKeeper.GetDelegationState(suite.ctx)
+
+	openEntries := map[string]uint32{
+		state[0].Address: 7,
+		state[1].Address: 6,
+		state[2].Address: 8,
+	}
+	eligibleVals, eligibleDelegationState := keeper.FilterValidatorsBelowMaxEntries(allowlistedVals, delegationState, openEntries, 7)
+	suite.Len(eligibleVals.AllowListedValidators, 3)
+	for _, val := range eligibleVals.AllowListedValidators {
+		suite.NotContains([]string{state[0].Address, state[2].Address}, val.ValidatorAddress)
+	}
+	for _, delegation := range eligibleDelegationState.HostAccountDelegations {
+		suite.NotContains([]string{state[0].Address, state[2].Address}, delegation.ValidatorAddress)
+	}
+	// input delegation state is left as is
+	suite.Equal(suite.app.LSCosmosKeeper.GetDelegationState(suite.ctx), delegationState)
+
+	valAmounts, err := keeper.FetchValidatorsToUndelegate(eligibleVals, eligibleDelegationState, sdk.NewInt64Coin(denom, 15000000))
+	suite.NoError(err)
+	total := sdk.ZeroInt()
+	for _, va := range valAmounts {
+		suite.NotContains([]string{state[0].Address, state[2].Address}, va.ValidatorAddr)
+		total = total.Add(va.Amount.Amount)
+	}
+	suite.Equal(sdk.NewInt(15000000), total)
+
+	_, err = keeper.FetchValidatorsToUndelegate(eligibleVals, eligibleDelegationState, sdk.NewInt64Coin(denom, 15000001))
+	suite.ErrorIs(err, types.ErrInsufficientFundsToUndelegate)
+}
+
+func (suite *IntegrationTestSuite) TestUndelegateAboveMaxEntriesCapacity() {
+	app, ctx := suite.app, suite.ctx
+	lscosmosKeeper := app.LSCosmosKeeper
+	state := testStateData(BaseDenom)
+	suite.SetupAllowListedValSetAndDelegationState(state)
+	lscosmosKeeper.SetHostMaxEntries(ctx, 1)
+
+	// only cosmosvalidatorAddr4 and cosmosvalidatorAddr5 are below the max entries, they hold 5000000
+	openEntries := []types.UndelegationEntry{}
+	for _, ws := range state[:3] {
+		openEntries = append(openEntries, types.UndelegationEntry{ValidatorAddress: ws.Address, Amount: sdk.NewInt64Coin(BaseDenom, 1)})
+	}
+	lscosmosKeeper.AddHostAccountUndelegation(ctx, types.HostAccountUndelegation{
+		EpochNumber:             0,
+		TotalUndelegationAmount: sdk.NewInt64Coin(MintDenom, 3),
+		UndelegationEntries:     openEntries,
+	})
+
+	delegator1, delegator2 := sdk.AccAddress("delegator1__________"), sdk.AccAddress("delegator2__________")
+	lscosmosKeeper.AddDelegatorUnbondingEpochEntry(ctx, delegator1, 4, sdk.NewInt64Coin(MintDenom, 300))
+	lscosmosKeeper.AddDelegatorUnbondingEpochEntry(ctx, delegator2, 4, sdk.NewInt64Coin(MintDenom, 100))
+	lscosmosKeeper.AddTotalUndelegationForEpoch(ctx, 4, sdk.NewInt64Coin(MintDenom, 400))
+
+	// the eligible validators are undelegated from as much as they hold
+	_, entries, err := lscosmosKeeper.UndelegateMsgs(ctx, sdk.NewInt(20000000), BaseDenom, lscosmosKeeper.GetDelegationState(ctx))
+	suite.NoError(err)
+	undelegated := sdk.ZeroInt()
+	for _, entry := range entries {
+		if entry.Amount.IsPositive() {
+			suite.Equal(state[4].Address, entry.ValidatorAddress)
+		}
+		undelegated = undelegated.Add(entry.Amount.Amount)
+	}
+	suite.Equal(sdk.NewInt(5000000), undelegated)
+
+	// a quarter is undelegated, the rest of every entry is carried over to the next unbonding epoch
+	left, err := lscosmosKeeper.CarryOverUndelegations(ctx, 4, undelegated, sdk.NewInt(20000000))
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt64Coin(MintDenom, 100), left)
+	suite.Equal(sdk.NewInt64Coin(MintDenom, 75), lscosmosKeeper.GetDelegatorUnbondingEpochEntry(ctx, delegator1, 4).Amount)
+	suite.Equal(sdk.NewInt64Coin(MintDenom, 25), lscosmosKeeper.GetDelegatorUnbondingEpochEntry(ctx, delegator2, 4).Amount)
+	suite.Equal(sdk.NewInt64Coin(MintDenom, 225), lscosmosKeeper.GetDelegatorUnbondingEpochEntry(ctx, delegator1, 8).Amount)
+	suite.Equal(sdk.NewInt64Coin(MintDenom, 75), lscosmosKeeper.GetDelegatorUnbondingEpochEntry(ctx, delegator2, 8).Amount)
+	undelegation, err := lscosmosKeeper.GetHostAccountUndelegationForEpoch(ctx, 4)
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt64Coin(MintDenom, 100), undelegation.TotalUndelegationAmount)
+	undelegation, err = lscosmosKeeper.GetHostAccountUndelegationForEpoch(ctx, 8)
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt64Coin(MintDenom, 300), undelegation.TotalUndelegationAmount)
+
+	// without eligible validators the undelegation epoch is carried over as a whole instead of failing
+	lscosmosKeeper.AddEntriesForUndelegationEpoch(ctx, 4, []types.UndelegationEntry{
+		{ValidatorAddress: state[3].Address, Amount: sdk.NewInt64Coin(BaseDenom, 1)},
+		{ValidatorAddress: state[4].Address, Amount: sdk.NewInt64Coin(BaseDenom, 1)},
+	})
+	_, _, err = lscosmosKeeper.UndelegateMsgs(ctx, sdk.NewInt(1000), BaseDenom, lscosmosKeeper.GetDelegationState(ctx))
+	suite.ErrorIs(err, types.ErrHostMaxEntriesReached)
+
+	suite.NoError(lscosmosKeeper.AfterEpochEnd(ctx, types.UndelegationEpochIdentifier, 8))
+	_, err = lscosmosKeeper.GetHostAccountUndelegationForEpoch(ctx, 8)
+	suite.ErrorIs(err, types.ErrUndelegationEpochNotFound)
+	undelegation, err = lscosmosKeeper.GetHostAccountUndelegationForEpoch(ctx, 12)
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt64Coin(MintDenom, 300), undelegation.TotalUndelegationAmount)
+	suite.Equal(sdk.NewInt64Coin(MintDenom, 225), lscosmosKeeper.GetDelegatorUnbondingEpochEntry(ctx, delegator1, 12).Amount)
+	suite.Equal(types.DelegatorUnbondingEpochEntry{}, lscosmosKeeper.GetDelegatorUnbondingEpochEntry(ctx, delegator1, 8))
+	suite.False(lscosmosKeeper.GetUnbondingEpochCValue(ctx, 8).IsFailed)
+}
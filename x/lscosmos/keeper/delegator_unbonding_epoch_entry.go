@@ -56,6 +56,33 @@ func (k Keeper) IterateDelegatorUnbondingEpochEntry(ctx sdk.Context, delegatorAd
 	return delegatorUnbondingEntries
 }
 
+// GetClaimableDelegatorUnbondingEpochEntries returns the delegator unbonding epoch entries of matured or failed
+// unbonding epochs, at most maxEntries of them unless maxEntries is 0. remaining is true if claimable entries are
+// left after the returned ones.
+func (k Keeper) GetClaimableDelegatorUnbondingEpochEntries(ctx sdk.Context, delegatorAddress sdk.AccAddress, maxEntries uint32) (claimable []types.DelegatorUnbondingEpochEntry, remaining bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPartialDelegatorUnbondingEpochEntryKey(delegatorAddress))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var unbondingEntry types.DelegatorUnbondingEpochEntry
+
+		k.cdc.MustUnmarshal(iterator.Value(), &unbondingEntry)
+
+		unbondingEpochCValue := k.GetUnbondingEpochCValue(ctx, unbondingEntry.EpochNumber)
+		if !unbondingEpochCValue.IsMatured && !unbondingEpochCValue.IsFailed {
+			continue
+		}
+		if maxEntries != 0 && len(claimable) == int(maxEntries) {
+			return claimable, true
+		}
+		claimable = append(claimable, unbondingEntry)
+	}
+
+	return claimable, false
+}
+
 // IterateAllDelegatorUnbondingEpochEntry returns a list of all epoch entries ever created in the KV store
 // by using the prefix iterator
 func (k Keeper) IterateAllDelegatorUnbondingEpochEntry(ctx sdk.Context) []types.DelegatorUnbondingEpochEntry {
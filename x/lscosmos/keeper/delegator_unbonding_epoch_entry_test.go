@@ -95,3 +95,28 @@ func (suite *IntegrationTestSuite) TestKVStore() {
 		suite.Equal(int64(0), entry.EpochNumber)
 	}
 }
+
+func (suite *IntegrationTestSuite) TestGetClaimableDelegatorUnbondingEpochEntries() {
+	app, ctx := suite.app, suite.ctx
+	keeper := app.LSCosmosKeeper
+
+	delegator := sdk.AccAddress("delegator___________")
+	for _, epochNumber := range []int64{4, 8, 12, 16} {
+		keeper.AddDelegatorUnbondingEpochEntry(ctx, delegator, epochNumber, sdk.NewInt64Coin("stk/uatom", 100))
+	}
+	keeper.SetUnbondingEpochCValue(ctx, types.UnbondingEpochCValue{EpochNumber: 4, STKBurn: sdk.NewInt64Coin("stk/uatom", 100), AmountUnbonded: sdk.NewInt64Coin("uatom", 100), IsMatured: true})
+	keeper.SetUnbondingEpochCValue(ctx, types.UnbondingEpochCValue{EpochNumber: 8, STKBurn: sdk.NewInt64Coin("stk/uatom", 100), AmountUnbonded: sdk.NewInt64Coin("uatom", 100), IsFailed: true})
+	keeper.SetUnbondingEpochCValue(ctx, types.UnbondingEpochCValue{EpochNumber: 12, STKBurn: sdk.NewInt64Coin("stk/uatom", 100), AmountUnbonded: sdk.NewInt64Coin("uatom", 100)})
+
+	claimable, remaining := keeper.GetClaimableDelegatorUnbondingEpochEntries(ctx, delegator, 0)
+	suite.Len(claimable, 2)
+	suite.False(remaining)
+
+	claimable, remaining = keeper.GetClaimableDelegatorUnbondingEpochEntries(ctx, delegator, 1)
+	suite.Len(claimable, 1)
+	suite.True(remaining)
+
+	claimable, remaining = keeper.GetClaimableDelegatorUnbondingEpochEntries(ctx, delegator, 2)
+	suite.Len(claimable, 2)
+	suite.False(remaining)
+}
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -272,3 +274,54 @@ func (k Keeper) DelegatorUnbondingEpochEntries(c context.Context, request *types
 
 	return &types.QueryAllDelegatorUnbondingEpochEntriesResponse{DelegatorUnbondingEpochEntries: list}, nil
 }
+
+// UnbondingEpochEntries queries the delegator unbonding epoch entries of all the delegators, ordered by delegator
+// address, with pagination
+func (k Keeper) UnbondingEpochEntries(c context.Context, request *types.QueryUnbondingEpochEntriesRequest) (*types.QueryUnbondingEpochEntriesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelegatorUnbondingEpochEntryKey)
+	var entries []types.DelegatorUnbondingEpochEntry
+	pageRes, err := query.Paginate(store, request.Pagination, func(_, value []byte) error {
+		var entry types.DelegatorUnbondingEpochEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnbondingEpochEntriesResponse{DelegatorUnbondingEpochEntries: entries, Pagination: pageRes}, nil
+}
+
+// UnbondingEpochCValues queries the unbonding epoch c values of all the unbonding epochs with pagination
+func (k Keeper) UnbondingEpochCValues(c context.Context, request *types.QueryUnbondingEpochCValuesRequest) (*types.QueryUnbondingEpochCValuesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingEpochCValueKey)
+	var unbondingEpochCValues []types.UnbondingEpochCValue
+	pageRes, err := query.Paginate(store, request.Pagination, func(_, value []byte) error {
+		var unbondingEpochCValue types.UnbondingEpochCValue
+		if err := k.cdc.Unmarshal(value, &unbondingEpochCValue); err != nil {
+			return err
+		}
+		unbondingEpochCValues = append(unbondingEpochCValues, unbondingEpochCValue)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnbondingEpochCValuesResponse{UnbondingEpochCValues: unbondingEpochCValues, Pagination: pageRes}, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
 )
@@ -88,3 +89,56 @@ func (suite *IntegrationTestSuite) TestQueryModuleState() {
 	suite.NoError(err)
 	suite.Equal(&types.QueryModuleStateResponse{ModuleState: true}, res)
 }
+
+func (suite *IntegrationTestSuite) TestQueryUnbondingEpochEntries() {
+	app, ctx := suite.app, suite.ctx
+
+	c := sdk.WrapSDKContext(ctx)
+
+	qrysrv := types.QueryServer(app.LSCosmosKeeper)
+
+	delegator1 := sdk.AccAddress("delegator1__________").String()
+	delegator2 := sdk.AccAddress("delegator2__________").String()
+	entries := []types.DelegatorUnbondingEpochEntry{
+		types.NewDelegatorUnbondingEpochEntry(delegator1, 4, sdk.NewInt64Coin("stk/uatom", 10)),
+		types.NewDelegatorUnbondingEpochEntry(delegator1, 8, sdk.NewInt64Coin("stk/uatom", 20)),
+		types.NewDelegatorUnbondingEpochEntry(delegator2, 4, sdk.NewInt64Coin("stk/uatom", 30)),
+	}
+	for _, entry := range entries {
+		app.LSCosmosKeeper.SetDelegatorUnbondingEpochEntry(ctx, entry)
+	}
+
+	res, err := qrysrv.UnbondingEpochEntries(c, &types.QueryUnbondingEpochEntriesRequest{Pagination: &query.PageRequest{Limit: 2}})
+	suite.NoError(err)
+	suite.Equal(entries[:2], res.DelegatorUnbondingEpochEntries)
+	suite.NotNil(res.Pagination.NextKey)
+
+	res, err = qrysrv.UnbondingEpochEntries(c, &types.QueryUnbondingEpochEntriesRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	suite.NoError(err)
+	suite.Equal(entries[2:], res.DelegatorUnbondingEpochEntries)
+	suite.Nil(res.Pagination.NextKey)
+
+	_, err = qrysrv.UnbondingEpochEntries(c, nil)
+	suite.Error(err)
+}
+
+func (suite *IntegrationTestSuite) TestQueryUnbondingEpochCValues() {
+	app, ctx := suite.app, suite.ctx
+
+	c := sdk.WrapSDKContext(ctx)
+
+	qrysrv := types.QueryServer(app.LSCosmosKeeper)
+
+	unbondingEpochCValues := []types.UnbondingEpochCValue{
+		{EpochNumber: 4, STKBurn: sdk.NewInt64Coin("stk/uatom", 100), AmountUnbonded: sdk.NewInt64Coin("uatom", 110), IsMatured: true},
+		{EpochNumber: 8, STKBurn: sdk.NewInt64Coin("stk/uatom", 200), AmountUnbonded: sdk.NewInt64Coin("uatom", 220)},
+	}
+	for _, unbondingEpochCValue := range unbondingEpochCValues {
+		app.LSCosmosKeeper.SetUnbondingEpochCValue(ctx, unbondingEpochCValue)
+	}
+
+	res, err := qrysrv.UnbondingEpochCValues(c, &types.QueryUnbondingEpochCValuesRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	suite.NoError(err)
+	suite.Equal(unbondingEpochCValues, res.UnbondingEpochCValues)
+	suite.Equal(uint64(2), res.Pagination.Total)
+}
@@ -185,10 +185,12 @@ func (k Keeper) OnAcknowledgementPacket(
 	if err := icatypes.ModuleCdc.UnmarshalJSON(modulePacket.GetData(), &icaPacket); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-27 tx message data: %v", err)
 	}
+	k.RemoveICATxSendTime(ctx, modulePacket.GetSourcePort(), modulePacket.GetSequence())
 
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
 		k.Logger(ctx).Info(fmt.Sprintln("ICA tx ack failed with ack:", ack.String()))
+		k.incrICATxCounter(types.MetricKeyFailedICATxs, icaPacket)
 		err := k.resetToPreICATx(ctx, icaPacket)
 		if err != nil {
 			return err
@@ -231,7 +233,13 @@ func (k Keeper) OnAcknowledgementPacket(
 		)
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&types.EventICATxAck{
+		PortId:    modulePacket.GetSourcePort(),
+		ChannelId: modulePacket.GetSourceChannel(),
+		Sequence:  modulePacket.GetSequence(),
+		Success:   ack.Success(),
+		Error:     ack.GetError(),
+	})
 }
 
 // OnTimeoutPacket implements the IBCModule interface
@@ -251,6 +259,8 @@ func (k Keeper) OnTimeoutPacket(
 	if err != nil {
 		return err
 	}
+	k.RemoveICATxSendTime(ctx, modulePacket.GetSourcePort(), modulePacket.GetSequence())
+	k.incrICATxCounter(types.MetricKeyTimedOutICATxs, icaPacket)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -258,7 +268,13 @@ func (k Keeper) OnTimeoutPacket(
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 		),
 	)
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&types.EventICATxAck{
+		PortId:    modulePacket.GetSourcePort(),
+		ChannelId: modulePacket.GetSourceChannel(),
+		Sequence:  modulePacket.GetSequence(),
+		Success:   false,
+		Timeout:   true,
+	})
 }
 
 // handleSuccessfulAck handles successful acknowledgements.
@@ -364,6 +380,13 @@ func (k Keeper) handleAckMsgData(ctx sdk.Context, data []byte, msg sdk.Msg, host
 		// Add delegation state
 		k.AddHostAccountDelegation(ctx, types.NewHostAccountDelegation(parsedMsg.ValidatorAddress, parsedMsg.Amount))
 		k.RemoveICADelegateFromTransientStore(ctx, parsedMsg.Amount)
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventDelegate{
+			DelegatorAddress: parsedMsg.DelegatorAddress,
+			ValidatorAddress: parsedMsg.ValidatorAddress,
+			Amount:           parsedMsg.Amount,
+		}); err != nil {
+			return "", err
+		}
 
 		return msgResponse.String(), nil
 
@@ -413,6 +436,8 @@ func (k Keeper) handleAckMsgData(ctx sdk.Context, data []byte, msg sdk.Msg, host
 				if err != nil {
 					return "", types.ErrFailedDeposit
 				}
+
+				k.AfterCValueChange(ctx, cValue, k.GetCValue(ctx))
 			}
 		}
 		return msgResponse.String(), nil
@@ -473,7 +498,10 @@ func (k Keeper) resetToPreICATx(ctx sdk.Context, icaPacket icatypes.InterchainAc
 			if err != nil {
 				return err
 			}
-			k.FailUnbondingEpochCValue(ctx, previousEpochNumber, sdk.NewCoin(hostChainParams.MintDenom, sdk.ZeroInt()))
+			err = k.FailUnbondingEpochCValue(ctx, previousEpochNumber, sdk.NewCoin(hostChainParams.MintDenom, sdk.ZeroInt()))
+			if err != nil {
+				return err
+			}
 			k.Logger(ctx).Info(fmt.Sprintf("Failed unbonding msgs: %s, for undelegationEpoch: %v", msgs, previousEpochNumber))
 		}
 
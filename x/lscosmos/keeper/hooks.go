@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,7 +27,10 @@ import (
 
 // BeforeEpochStart - call hook if registered
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&lscosmostypes.EventEpochStarted{
+		EpochIdentifier: epochIdentifier,
+		EpochNumber:     epochNumber,
+	})
 }
 
 // AfterEpochEnd handle the "stake", "reward" and "undelegate" epoch and their respective actions
@@ -59,6 +63,13 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 			k.Logger(ctx).Error("Failed RewardEpochIdentifier Function with:", "err: ", err)
 		}
 	}
+	if epochIdentifier == lscosmostypes.UndelegationEpochIdentifier {
+		// keep the host chain max unbonding entries up to date for the undelegation epochs
+		err := k.QueryHostStakingParams(ctx, hostChainParams)
+		if err != nil {
+			k.Logger(ctx).Error("Failed QueryHostStakingParams with:", "err: ", err)
+		}
+	}
 	if epochIdentifier == lscosmostypes.UndelegationEpochIdentifier && epochNumber%lscosmostypes.UndelegationEpochNumberFactor == 0 {
 		wrapperFn := func(ctx sdk.Context) error {
 			return k.UndelegationEpochWorkFlow(ctx, hostChainParams, epochNumber)
@@ -76,7 +87,10 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 			if err != nil {
 				return err
 			}
-			k.FailUnbondingEpochCValue(ctx, currentUnbondingEpochNumber, hostAccountUndelegationForEpoch.TotalUndelegationAmount)
+			err = k.FailUnbondingEpochCValue(ctx, currentUnbondingEpochNumber, hostAccountUndelegationForEpoch.TotalUndelegationAmount)
+			if err != nil {
+				return err
+			}
 			k.Logger(ctx).Info(fmt.Sprintf("Failed unbonding for undelegationEpoch: %v", currentUnbondingEpochNumber))
 
 		}
@@ -202,7 +216,8 @@ func (k Keeper) RewardEpochEpochWorkFlow(ctx sdk.Context, hostChainParams lscosm
 // UndelegationEpochWorkFlow handles the undelegation epoch work flow :
 // 1. Fetches host account undelegations using in GetHostAccountUndelegationForEpoch
 // 2. Convert stk coin to token using ConvertStkToToken based on the current c value
-// 3. Form undelegation messages using the current delegation state
+// 3. Form undelegation messages using the current delegation state, the amount the validators below the host
+// chain max entries can not take is carried over to the next undelegation epoch with CarryOverUndelegations
 // 4. Generate and execute the ICA transaction for the undelegation messages
 // 5. Perform KV store changes based on the previous actions
 // Returns nil or an error based on the checks in the function
@@ -228,9 +243,30 @@ func (k Keeper) UndelegationEpochWorkFlow(ctx sdk.Context, hostChainParams lscos
 	}
 	delegationState := k.GetDelegationState(ctx)
 	undelegateMsgs, undelegationEntries, err := k.UndelegateMsgs(ctx, amountToUnstake.Amount, hostChainParams.BaseDenom, delegationState)
-	if err != nil {
+	if err != nil && !errors.Is(err, lscosmostypes.ErrHostMaxEntriesReached) {
 		return err
 	}
+
+	// the validators below the host chain max entries may not cover the amount, the rest is carried over to
+	// the next undelegation epoch instead of failing the unbonding epoch
+	undelegated := sdk.ZeroInt()
+	for _, entry := range undelegationEntries {
+		undelegated = undelegated.Add(entry.Amount.Amount)
+	}
+	stkBurn := hostAccountUndelegationForEpoch.TotalUndelegationAmount
+	if undelegated.LT(amountToUnstake.Amount) {
+		stkBurn, err = k.CarryOverUndelegations(ctx, currentEpoch, undelegated, amountToUnstake.Amount)
+		if err != nil {
+			return err
+		}
+		k.Logger(ctx).Info(fmt.Sprintf("Carried over undelegations of epochNumber: %v to the next undelegation epoch", currentEpoch),
+			"undelegated", undelegated, "undelegate", amountToUnstake, "stkBurn", stkBurn)
+		if !stkBurn.IsPositive() {
+			return nil
+		}
+		amountToUnstake = sdk.NewCoin(hostChainParams.BaseDenom, undelegated)
+	}
+
 	hostAccounts := k.GetHostAccounts(ctx)
 	err = k.GenerateAndExecuteICATx(ctx, hostChainParams.ConnectionID, hostAccounts.DelegatorAccountOwnerID, undelegateMsgs)
 	if err != nil {
@@ -241,13 +277,18 @@ func (k Keeper) UndelegationEpochWorkFlow(ctx sdk.Context, hostChainParams lscos
 	//optimistic about this -> it retries till the ICA passes, if ICA undelegate fails the module is paused.
 	k.SetUnbondingEpochCValue(ctx, lscosmostypes.UnbondingEpochCValue{
 		EpochNumber:    currentEpoch,
-		STKBurn:        hostAccountUndelegationForEpoch.TotalUndelegationAmount,
+		STKBurn:        stkBurn,
 		AmountUnbonded: amountToUnstake,
 		IsMatured:      false,
 		IsFailed:       false,
 	})
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&lscosmostypes.EventUndelegateEpoch{
+		EpochNumber:    currentEpoch,
+		StkBurn:        stkBurn,
+		AmountUnbonded: amountToUnstake,
+		CValue:         cValue,
+	})
 }
 
 // ___________________________________________________________________________________________________
@@ -294,8 +335,7 @@ func (k Keeper) OnRecvIBCTransferPacket(ctx sdk.Context, packet channeltypes.Pac
 	if err != nil {
 		return err
 	}
-	k.MatureUnbondingEpochCValue(ctx, removedTransientUndelegationTransfer.EpochNumber)
-	return nil
+	return k.MatureUnbondingEpochCValue(ctx, removedTransientUndelegationTransfer.EpochNumber)
 }
 
 // OnAcknowledgementIBCTransferPacket performs the following steps :
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+// SetHostMaxEntries sets the host chain staking max unbonding entries in store
+func (k Keeper) SetHostMaxEntries(ctx sdk.Context, maxEntries uint32) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.HostMaxEntriesKey, sdk.Uint64ToBigEndian(uint64(maxEntries)))
+}
+
+// GetHostMaxEntries gets the host chain staking max unbonding entries from store, returns
+// types.DefaultHostMaxEntries if the host staking params were never queried
+func (k Keeper) GetHostMaxEntries(ctx sdk.Context) uint32 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.HostMaxEntriesKey)
+	if bz == nil {
+		return types.DefaultHostMaxEntries
+	}
+	return uint32(sdk.BigEndianToUint64(bz))
+}
+
+// QueryHostStakingParams makes an interchain query for the host chain staking params, the response
+// updates the host max entries in HandleHostStakingParamsCallback
+func (k Keeper) QueryHostStakingParams(ctx sdk.Context, hostChainParams types.HostChainParams) error {
+	bz, err := k.cdc.Marshal(&stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+	k.icqKeeper.MakeRequest(
+		ctx,
+		hostChainParams.ConnectionID,
+		hostChainParams.ChainID,
+		"cosmos.staking.v1beta1.Query/Params",
+		bz,
+		sdk.NewInt(int64(-1)),
+		types.ModuleName,
+		HostStakingParams,
+		0,
+	)
+	return nil
+}
@@ -0,0 +1,640 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/merlin-network/estake-native/v2/app"
+	"github.com/merlin-network/estake-native/v2/app/helpers"
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/keeper"
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+const (
+	// hostUnbondingTime is kept short so undelegations mature without ending any controller epoch.
+	hostUnbondingTime = time.Hour
+	// maxRelayRounds bounds relayAll, so a packet loop fails the test instead of hanging it.
+	maxRelayRounds = 50
+)
+
+// recordingApp wraps EstakeApp to keep the events emitted by BeginBlock, which ibctesting
+// discards, so the packets sent by the lscosmos BeginBlocker can be relayed.
+type recordingApp struct {
+	*app.EstakeApp
+
+	beginBlockEvents []abci.Event
+}
+
+func (a *recordingApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	res := a.EstakeApp.BeginBlock(req)
+	a.beginBlockEvents = append(a.beginBlockEvents, res.Events...)
+
+	return res
+}
+
+func (a *recordingApp) popBeginBlockEvents() []abci.Event {
+	events := a.beginBlockEvents
+	a.beginBlockEvents = nil
+
+	return events
+}
+
+func setupRecordingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	testingApp, genesisState := helpers.SetupTestingApp()
+
+	return &recordingApp{EstakeApp: testingApp.(*app.EstakeApp)}, genesisState
+}
+
+// inFlight holds the packets sent by each chain that are yet to be relayed.
+type inFlight struct {
+	fromController []channeltypes.Packet
+	fromHost       []channeltypes.Packet
+}
+
+func (f inFlight) empty() bool {
+	return len(f.fromController) == 0 && len(f.fromHost) == 0
+}
+
+// merge adds the packets of other, keeping each chain's packets ordered by sequence so
+// ordered ICA channels receive them in the order they were sent.
+func (f inFlight) merge(other inFlight) inFlight {
+	return inFlight{
+		fromController: mergePackets(f.fromController, other.fromController),
+		fromHost:       mergePackets(f.fromHost, other.fromHost),
+	}
+}
+
+func mergePackets(packets, others []channeltypes.Packet) []channeltypes.Packet {
+	seen := make(map[string]bool)
+	all := append(append([]channeltypes.Packet{}, packets...), others...)
+	merged := make([]channeltypes.Packet, 0, len(all))
+	for _, packet := range all {
+		key := string(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		merged = append(merged, packet)
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].GetSequence() < merged[j].GetSequence()
+	})
+
+	return merged
+}
+
+// ICAEndToEndTestSuite drives the lscosmos liquid staking lifecycle between a controller
+// EstakeApp and a host EstakeApp running the ICA host module, relaying every packet
+// in-process and answering interchain queries from the host state.
+type ICAEndToEndTestSuite struct {
+	suite.Suite
+
+	coordinator     *ibctesting.Coordinator
+	controllerChain *ibctesting.TestChain
+	hostChain       *ibctesting.TestChain
+	transferPath    *ibctesting.Path
+	delegationPath  *ibctesting.Path
+
+	feeAddress sdk.AccAddress
+}
+
+func TestICAEndToEndTestSuite(t *testing.T) {
+	suite.Run(t, new(ICAEndToEndTestSuite))
+}
+
+func (suite *ICAEndToEndTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = setupRecordingApp
+	defer func() { ibctesting.DefaultTestingAppInit = helpers.SetupTestingApp }()
+
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.controllerChain = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.hostChain = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.transferPath = newEstakeAppPath(suite.controllerChain, suite.hostChain)
+	suite.coordinator.Setup(suite.transferPath)
+
+	hostApp, hostCtx := suite.hostApp(), suite.hostChain.GetContext()
+	hostApp.ICAHostKeeper.SetParams(hostCtx, icahosttypes.NewParams(true, []string{
+		sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+		sdk.MsgTypeURL(&distributiontypes.MsgSetWithdrawAddress{}),
+		sdk.MsgTypeURL(&distributiontypes.MsgWithdrawDelegatorReward{}),
+		sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{}),
+	}))
+	stakingParams := hostApp.StakingKeeper.GetParams(hostCtx)
+	stakingParams.UnbondingTime = hostUnbondingTime
+	hostApp.StakingKeeper.SetParams(hostCtx, stakingParams)
+	suite.coordinator.CommitBlock(suite.hostChain)
+
+	suite.feeAddress = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+}
+
+func (suite *ICAEndToEndTestSuite) controllerApp() *recordingApp {
+	return suite.controllerChain.App.(*recordingApp)
+}
+
+func (suite *ICAEndToEndTestSuite) hostApp() *recordingApp {
+	return suite.hostChain.App.(*recordingApp)
+}
+
+// parsePackets returns the packets of the send_packet events in events.
+func (suite *ICAEndToEndTestSuite) parsePackets(events []abci.Event) []channeltypes.Packet {
+	var packets []channeltypes.Packet
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+		var (
+			packet channeltypes.Packet
+			err    error
+		)
+		for _, attribute := range event.Attributes {
+			value := string(attribute.Value)
+			switch string(attribute.Key) {
+			case channeltypes.AttributeKeyDataHex:
+				packet.Data, err = hex.DecodeString(value)
+			case channeltypes.AttributeKeySequence:
+				packet.Sequence, err = strconv.ParseUint(value, 10, 64)
+			case channeltypes.AttributeKeyTimeoutHeight:
+				packet.TimeoutHeight, err = clienttypes.ParseHeight(value)
+			case channeltypes.AttributeKeyTimeoutTimestamp:
+				packet.TimeoutTimestamp, err = strconv.ParseUint(value, 10, 64)
+			case channeltypes.AttributeKeySrcPort:
+				packet.SourcePort = value
+			case channeltypes.AttributeKeySrcChannel:
+				packet.SourceChannel = value
+			case channeltypes.AttributeKeyDstPort:
+				packet.DestinationPort = value
+			case channeltypes.AttributeKeyDstChannel:
+				packet.DestinationChannel = value
+			}
+			suite.Require().NoError(err)
+		}
+		packets = append(packets, packet)
+	}
+
+	return packets
+}
+
+// parseAck returns the acknowledgement written by the write_acknowledgement event in events.
+func (suite *ICAEndToEndTestSuite) parseAck(events []abci.Event) []byte {
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeWriteAck {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if string(attribute.Key) == channeltypes.AttributeKeyAckHex {
+				ack, err := hex.DecodeString(string(attribute.Value))
+				suite.Require().NoError(err)
+
+				return ack
+			}
+		}
+	}
+	suite.FailNow("no acknowledgement written")
+
+	return nil
+}
+
+// collect returns the packets chain sent in events, along with the packets either chain
+// sent from BeginBlock since the last collection.
+func (suite *ICAEndToEndTestSuite) collect(chain *ibctesting.TestChain, events []abci.Event) inFlight {
+	var f inFlight
+	if chain == suite.controllerChain {
+		f.fromController = suite.parsePackets(events)
+	} else {
+		f.fromHost = suite.parsePackets(events)
+	}
+
+	return f.merge(inFlight{
+		fromController: suite.parsePackets(suite.controllerApp().popBeginBlockEvents()),
+		fromHost:       suite.parsePackets(suite.hostApp().popBeginBlockEvents()),
+	})
+}
+
+// executeOnController runs fn against the current controller block without going through
+// a transaction, then commits the block.
+func (suite *ICAEndToEndTestSuite) executeOnController(fn func(ctx sdk.Context)) inFlight {
+	ctx := suite.controllerChain.GetContext()
+	fn(ctx)
+	suite.coordinator.CommitBlock(suite.controllerChain)
+
+	return suite.collect(suite.controllerChain, ctx.EventManager().ABCIEvents())
+}
+
+func (suite *ICAEndToEndTestSuite) sendOnController(msgs ...sdk.Msg) inFlight {
+	res, err := suite.controllerChain.SendMsgs(msgs...)
+	suite.Require().NoError(err)
+
+	return suite.collect(suite.controllerChain, res.GetEvents())
+}
+
+func (suite *ICAEndToEndTestSuite) sendOnHost(msgs ...sdk.Msg) inFlight {
+	res, err := suite.hostChain.SendMsgs(msgs...)
+	suite.Require().NoError(err)
+
+	return suite.collect(suite.hostChain, res.GetEvents())
+}
+
+// recvPacket delivers a packet sent by the chain of src to the chain of dst and returns the
+// acknowledgement written by dst.
+func (suite *ICAEndToEndTestSuite) recvPacket(src, dst *ibctesting.Endpoint, packet channeltypes.Packet) ([]byte, inFlight) {
+	// packets sent from BeginBlock are only committed with the block
+	suite.coordinator.CommitBlock(src.Chain)
+	suite.Require().NoError(dst.UpdateClient())
+
+	packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	proof, proofHeight := src.Chain.QueryProof(packetKey)
+	res, err := dst.Chain.SendMsgs(channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, dst.Chain.SenderAccount.GetAddress().String()))
+	suite.Require().NoError(err)
+
+	return suite.parseAck(res.GetEvents()), suite.collect(dst.Chain, res.GetEvents())
+}
+
+// acknowledgePacket delivers the acknowledgement dst wrote for packet back to src.
+func (suite *ICAEndToEndTestSuite) acknowledgePacket(src, dst *ibctesting.Endpoint, packet channeltypes.Packet, ack []byte) inFlight {
+	suite.Require().NoError(src.UpdateClient())
+
+	packetKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := dst.Chain.QueryProof(packetKey)
+	res, err := src.Chain.SendMsgs(channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, src.Chain.SenderAccount.GetAddress().String()))
+	suite.Require().NoError(err)
+
+	return suite.collect(src.Chain, res.GetEvents())
+}
+
+// timeoutOnController times out a controller packet the host never received.
+func (suite *ICAEndToEndTestSuite) timeoutOnController(packet channeltypes.Packet) inFlight {
+	suite.Require().NoError(suite.transferPath.EndpointA.UpdateClient())
+
+	nextSequenceRecv, found := suite.hostApp().IBCKeeper.ChannelKeeper.GetNextSequenceRecv(suite.hostChain.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+	suite.Require().True(found)
+	proof, proofHeight := suite.hostChain.QueryProof(host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel()))
+	res, err := suite.controllerChain.SendMsgs(channeltypes.NewMsgTimeout(packet, nextSequenceRecv, proof, proofHeight, suite.controllerChain.SenderAccount.GetAddress().String()))
+	suite.Require().NoError(err)
+
+	return suite.collect(suite.controllerChain, res.GetEvents())
+}
+
+// relayOnce relays the packets in f and returns the packets sent in turn, along with the
+// acknowledgements of the relayed packets.
+func (suite *ICAEndToEndTestSuite) relayOnce(f inFlight) (inFlight, []channeltypes.Acknowledgement) {
+	var (
+		next inFlight
+		acks []channeltypes.Acknowledgement
+	)
+	relay := func(src, dst *ibctesting.Endpoint, packets []channeltypes.Packet) {
+		for _, packet := range packets {
+			ack, sent := suite.recvPacket(src, dst, packet)
+			next = next.merge(sent)
+			next = next.merge(suite.acknowledgePacket(src, dst, packet, ack))
+
+			var acknowledgement channeltypes.Acknowledgement
+			suite.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(ack, &acknowledgement))
+			acks = append(acks, acknowledgement)
+		}
+	}
+	// every channel shares the connection of the transfer path, so its endpoints are used
+	// to keep the light clients up to date.
+	relay(suite.transferPath.EndpointA, suite.transferPath.EndpointB, f.fromController)
+	relay(suite.transferPath.EndpointB, suite.transferPath.EndpointA, f.fromHost)
+
+	return next, acks
+}
+
+// relayAll relays the packets in f, everything they trigger on either chain and the
+// interchain queries made on the way until nothing is left in flight.
+func (suite *ICAEndToEndTestSuite) relayAll(f inFlight) []channeltypes.Acknowledgement {
+	var acks []channeltypes.Acknowledgement
+	for round := 0; ; round++ {
+		suite.Require().Less(round, maxRelayRounds, "packets still in flight after %d rounds", maxRelayRounds)
+
+		if f.empty() {
+			if len(suite.controllerApp().InterchainQueryKeeper.AllQueries(suite.controllerChain.GetContext())) == 0 {
+				return acks
+			}
+			f = suite.executeOnController(suite.respondToQueries)
+			continue
+		}
+
+		var roundAcks []channeltypes.Acknowledgement
+		f, roundAcks = suite.relayOnce(f)
+		acks = append(acks, roundAcks...)
+	}
+}
+
+// respondToQueries stands in for the interchain query relayer: every pending query is run
+// against the latest host state and its response handed to the lscosmos callback.
+func (suite *ICAEndToEndTestSuite) respondToQueries(ctx sdk.Context) {
+	controllerApp := suite.controllerApp()
+	callbacks := controllerApp.LSCosmosKeeper.CallbackHandler().RegisterCallbacks()
+
+	for _, query := range controllerApp.InterchainQueryKeeper.AllQueries(ctx) {
+		res := suite.hostChain.App.Query(abci.RequestQuery{Path: "/" + query.QueryType, Data: query.Request})
+		suite.Require().Zero(res.Code, res.Log)
+		suite.Require().NoError(callbacks.Call(ctx, query.CallbackId, res.Value, query))
+		controllerApp.InterchainQueryKeeper.DeleteQuery(ctx, query.Id)
+	}
+}
+
+// endEpoch ends the lscosmos epoch number on the controller the way the epochs BeginBlocker
+// does: the hooks run for number, after which the next epoch is the current one.
+func (suite *ICAEndToEndTestSuite) endEpoch(number int64) inFlight {
+	return suite.executeOnController(func(ctx sdk.Context) {
+		suite.setCurrentEpoch(ctx, number)
+		suite.Require().NoError(suite.controllerApp().LSCosmosKeeper.AfterEpochEnd(ctx, types.DelegationEpochIdentifier, number))
+		suite.setCurrentEpoch(ctx, number+1)
+	})
+}
+
+func (suite *ICAEndToEndTestSuite) setCurrentEpoch(ctx sdk.Context, number int64) {
+	epochsKeeper := suite.controllerApp().EpochsKeeper
+
+	epochInfo := epochsKeeper.GetEpochInfo(ctx, types.DelegationEpochIdentifier)
+	epochInfo.CurrentEpoch = number
+	epochsKeeper.DeleteEpochInfo(ctx, epochInfo.Identifier)
+	suite.Require().NoError(epochsKeeper.AddEpochInfo(ctx, epochInfo))
+}
+
+// openICAChannel completes the handshake of the interchain account channel channelID the
+// controller initialised for owner.
+func (suite *ICAEndToEndTestSuite) openICAChannel(owner, channelID string) (*ibctesting.Path, inFlight) {
+	portID, err := icatypes.NewControllerPortID(owner)
+	suite.Require().NoError(err)
+
+	path := ibctesting.NewPath(suite.controllerChain, suite.hostChain)
+	path.EndpointA.ClientID = suite.transferPath.EndpointA.ClientID
+	path.EndpointA.ConnectionID = suite.transferPath.EndpointA.ConnectionID
+	path.EndpointA.ChannelID = channelID
+	path.EndpointA.ChannelConfig.PortID = portID
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointA.ChannelConfig.Version = path.EndpointA.GetChannel().Version
+	path.EndpointB.ClientID = suite.transferPath.EndpointB.ClientID
+	path.EndpointB.ConnectionID = suite.transferPath.EndpointB.ConnectionID
+	path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.Version = path.EndpointA.ChannelConfig.Version
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+
+	// the ack is delivered by hand, since lscosmos sends packets while handling it
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	proof, proofHeight := suite.hostChain.QueryProof(host.ChannelKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+	sent := suite.sendOnController(channeltypes.NewMsgChannelOpenAck(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelID,
+		path.EndpointB.ChannelConfig.Version, proof, proofHeight, suite.controllerChain.SenderAccount.GetAddress().String(),
+	))
+	path.EndpointA.ChannelConfig.Version = path.EndpointA.GetChannel().Version
+
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+	return path, sent
+}
+
+func (suite *ICAEndToEndTestSuite) nextControllerChannelID() string {
+	sequence := suite.controllerApp().IBCKeeper.ChannelKeeper.GetNextChannelSequence(suite.controllerChain.GetContext())
+	return channeltypes.FormatChannelIdentifier(sequence)
+}
+
+// jumpStart enables lscosmos on the controller against the host chain and opens its
+// delegation and rewards interchain accounts.
+func (suite *ICAEndToEndTestSuite) jumpStart() {
+	controllerApp, hostApp := suite.controllerApp(), suite.hostApp()
+
+	validators := hostApp.StakingKeeper.GetAllValidators(suite.hostChain.GetContext())
+	suite.Require().GreaterOrEqual(len(validators), 2)
+	allowListedValidators := types.AllowListedValidators{
+		AllowListedValidators: []types.AllowListedValidator{
+			{ValidatorAddress: validators[0].OperatorAddress, TargetWeight: sdk.NewDecWithPrec(5, 1)},
+			{ValidatorAddress: validators[1].OperatorAddress, TargetWeight: sdk.NewDecWithPrec(5, 1)},
+		},
+	}
+	estakeParams := types.EstakeParams{
+		EstakeDepositFee:    sdk.ZeroDec(),
+		EstakeRestakeFee:    sdk.NewDecWithPrec(5, 2),
+		EstakeUnstakeFee:    sdk.ZeroDec(),
+		EstakeRedemptionFee: sdk.ZeroDec(),
+		EstakeFeeAddress:    suite.feeAddress.String(),
+	}
+	msg := types.NewMsgJumpStart(suite.feeAddress, suite.hostChain.ChainID,
+		suite.transferPath.EndpointA.ConnectionID, suite.transferPath.EndpointA.ChannelID, ibctransfertypes.PortID,
+		sdk.DefaultBondDenom, types.ConvertBaseDenomToMintDenom(sdk.DefaultBondDenom), sdk.NewInt(1),
+		allowListedValidators, estakeParams, types.HostAccounts{
+			DelegatorAccountOwnerID: types.DelegationModuleAccount,
+			RewardsAccountOwnerID:   types.RewardModuleAccount,
+		},
+	)
+
+	delegationChannelID := suite.nextControllerChannelID()
+	suite.executeOnController(func(ctx sdk.Context) {
+		proposal := types.NewEstakeFeeAddressChangeProposal("fee address", "set the fee address", suite.feeAddress.String())
+		suite.Require().NoError(keeper.HandleEstakeFeeAddressChangeProposal(ctx, controllerApp.LSCosmosKeeper, *proposal))
+
+		_, err := keeper.NewMsgServerImpl(controllerApp.LSCosmosKeeper).JumpStart(sdk.WrapSDKContext(ctx), msg)
+		suite.Require().NoError(err)
+	})
+
+	// acknowledging the delegation channel initialises the rewards channel
+	rewardsChannelID := suite.nextControllerChannelID()
+	var sent inFlight
+	suite.delegationPath, sent = suite.openICAChannel(types.DelegationModuleAccount, delegationChannelID)
+	suite.Require().True(sent.empty())
+
+	// acknowledging the rewards channel sets the withdraw address of the delegation account,
+	// and the module is enabled once that is acknowledged in turn.
+	_, sent = suite.openICAChannel(types.RewardModuleAccount, rewardsChannelID)
+	suite.Require().Len(sent.fromController, 1)
+	suite.relayAll(sent)
+
+	ctx := suite.controllerChain.GetContext()
+	suite.Require().True(controllerApp.LSCosmosKeeper.GetModuleState(ctx))
+
+	delegationAddress := controllerApp.LSCosmosKeeper.GetDelegationState(ctx).HostChainDelegationAddress
+	rewardAddress := controllerApp.LSCosmosKeeper.GetHostChainRewardAddress(ctx).Address
+	suite.Require().NotEmpty(delegationAddress)
+	suite.Require().NotEmpty(rewardAddress)
+
+	withdrawAddress := hostApp.DistrKeeper.GetDelegatorWithdrawAddr(suite.hostChain.GetContext(), sdk.MustAccAddressFromBech32(delegationAddress))
+	suite.Require().Equal(rewardAddress, withdrawAddress.String())
+}
+
+// liquidStake transfers amount of the host staking denom to the controller sender and
+// liquid stakes it, returning the ibc denom of the deposit.
+func (suite *ICAEndToEndTestSuite) liquidStake(amount int64) string {
+	hostSender := suite.hostChain.SenderAccount.GetAddress()
+	controllerSender := suite.controllerChain.SenderAccount.GetAddress()
+
+	timeoutTimestamp := uint64(suite.hostChain.CurrentHeader.Time.Add(time.Hour).UnixNano())
+	suite.relayAll(suite.sendOnHost(ibctransfertypes.NewMsgTransfer(
+		ibctransfertypes.PortID, suite.transferPath.EndpointB.ChannelID, sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+		hostSender.String(), controllerSender.String(), clienttypes.ZeroHeight(), timeoutTimestamp, "",
+	)))
+
+	ibcDenom := ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(
+		ibctransfertypes.PortID, suite.transferPath.EndpointA.ChannelID, sdk.DefaultBondDenom,
+	)).IBCDenom()
+	suite.Require().True(suite.controllerApp().BankKeeper.GetBalance(suite.controllerChain.GetContext(), controllerSender, ibcDenom).Amount.Equal(sdk.NewInt(amount)))
+
+	suite.Require().True(suite.sendOnController(types.NewMsgLiquidStake(sdk.NewInt64Coin(ibcDenom, amount), controllerSender)).empty())
+
+	return ibcDenom
+}
+
+func (suite *ICAEndToEndTestSuite) TestLiquidStakingLifecycle() {
+	controllerApp, hostApp := suite.controllerApp(), suite.hostApp()
+	lscosmosKeeper := controllerApp.LSCosmosKeeper
+	delegator := suite.controllerChain.SenderAccount.GetAddress()
+	amount := sdk.NewInt(10_000_000)
+	mintDenom := types.ConvertBaseDenomToMintDenom(sdk.DefaultBondDenom)
+
+	suite.jumpStart()
+	ibcDenom := suite.liquidStake(amount.Int64())
+
+	ctx := suite.controllerChain.GetContext()
+	suite.Require().Equal(amount, controllerApp.BankKeeper.GetBalance(ctx, delegator, mintDenom).Amount)
+
+	// delegation epoch: the deposit is transferred to the delegation account and delegated
+	// once the transfer is acknowledged; the host staking params are queried as well.
+	suite.relayAll(suite.endEpoch(1))
+
+	ctx, hostCtx := suite.controllerChain.GetContext(), suite.hostChain.GetContext()
+	delegationState := lscosmosKeeper.GetDelegationState(ctx)
+	delegationAddress := sdk.MustAccAddressFromBech32(delegationState.HostChainDelegationAddress)
+	suite.Require().Equal(amount, delegationState.TotalDelegations(sdk.DefaultBondDenom).Amount)
+	suite.Require().Equal(amount, hostApp.StakingKeeper.GetDelegatorBonded(hostCtx, delegationAddress))
+	suite.Require().True(lscosmosKeeper.GetDelegationTransientAmount(ctx).IsZero())
+	suite.Require().Equal(hostApp.StakingKeeper.MaxEntries(hostCtx), lscosmosKeeper.GetHostMaxEntries(ctx))
+
+	// reward epoch: rewards are withdrawn to the rewards account, whose balance is queried
+	// and sent back to the delegation account to be restaked.
+	rewardAddress := lscosmosKeeper.GetHostChainRewardAddress(ctx).Address
+	suite.sendOnHost(banktypes.NewMsgSend(suite.hostChain.SenderAccount.GetAddress(),
+		sdk.MustAccAddressFromBech32(rewardAddress), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000))))
+	cValue := lscosmosKeeper.GetCValue(ctx)
+
+	suite.relayAll(suite.endEpoch(2))
+
+	ctx = suite.controllerChain.GetContext()
+	suite.Require().True(lscosmosKeeper.GetDelegationState(ctx).TotalDelegations(sdk.DefaultBondDenom).Amount.GT(amount))
+	suite.Require().True(lscosmosKeeper.GetCValue(ctx).LT(cValue))
+	suite.Require().True(controllerApp.BankKeeper.GetBalance(ctx, suite.feeAddress, mintDenom).IsPositive())
+
+	// unstaking during epoch 3 is undelegated at the end of epoch 4
+	unstakeAmount := sdk.NewCoin(mintDenom, amount.QuoRaw(2))
+	suite.Require().True(suite.sendOnController(types.NewMsgLiquidUnstake(delegator, unstakeAmount)).empty())
+
+	ctx = suite.controllerChain.GetContext()
+	suite.Require().Equal(unstakeAmount, lscosmosKeeper.GetDelegatorUnbondingEpochEntry(ctx, delegator, 4).Amount)
+	undelegationAddress := authtypes.NewModuleAddress(types.UndelegationModuleAccount)
+	suite.Require().Equal(unstakeAmount, controllerApp.BankKeeper.GetBalance(ctx, undelegationAddress, mintDenom))
+
+	suite.relayAll(suite.endEpoch(4))
+
+	ctx, hostCtx = suite.controllerChain.GetContext(), suite.hostChain.GetContext()
+	suite.Require().True(controllerApp.BankKeeper.GetBalance(ctx, undelegationAddress, mintDenom).IsZero())
+	undelegation, err := lscosmosKeeper.GetHostAccountUndelegationForEpoch(ctx, 4)
+	suite.Require().NoError(err)
+	suite.Require().False(undelegation.CompletionTime.IsZero())
+	suite.Require().NotEmpty(hostApp.StakingKeeper.GetUnbondingDelegations(hostCtx, delegationAddress, 10))
+
+	// maturity: once the host completes the unbonding, the controller transfers the
+	// unbonded tokens back through the delegation account.
+	suite.coordinator.IncrementTimeBy(hostUnbondingTime + 2*types.UndelegationCompletionTimeBuffer)
+	suite.coordinator.CommitBlock(suite.hostChain)
+	suite.relayAll(suite.collect(suite.controllerChain, nil))
+
+	ctx = suite.controllerChain.GetContext()
+	suite.Require().True(lscosmosKeeper.GetUnbondingEpochCValue(ctx, 4).IsMatured)
+	suite.Require().Empty(lscosmosKeeper.GetHostAccountMaturedUndelegations(ctx))
+
+	balance := controllerApp.BankKeeper.GetBalance(ctx, delegator, ibcDenom)
+	suite.Require().True(suite.sendOnController(types.NewMsgClaim(delegator, 0)).empty())
+
+	ctx = suite.controllerChain.GetContext()
+	suite.Require().True(controllerApp.BankKeeper.GetBalance(ctx, delegator, ibcDenom).Amount.GT(balance.Amount))
+	suite.Require().Empty(lscosmosKeeper.IterateDelegatorUnbondingEpochEntry(ctx, delegator))
+}
+
+func (suite *ICAEndToEndTestSuite) TestDelegationErrorAck() {
+	lscosmosKeeper, hostApp := suite.controllerApp().LSCosmosKeeper, suite.hostApp()
+	amount := sdk.NewInt(10_000_000)
+
+	suite.jumpStart()
+	suite.liquidStake(amount.Int64())
+
+	// the host stops accepting delegations from interchain accounts
+	hostCtx := suite.hostChain.GetContext()
+	hostParams := hostApp.ICAHostKeeper.GetParams(hostCtx)
+	allowMessages := hostParams.AllowMessages
+	hostParams.AllowMessages = []string{sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{})}
+	hostApp.ICAHostKeeper.SetParams(hostCtx, hostParams)
+	suite.coordinator.CommitBlock(suite.hostChain)
+
+	// relaying the delegation transfer triggers the delegation
+	sent, _ := suite.relayOnce(suite.endEpoch(1))
+	suite.Require().Len(sent.fromController, 1)
+
+	sent, acks := suite.relayOnce(sent)
+	suite.Require().Len(acks, 1)
+	suite.Require().False(acks[0].Success())
+
+	// the failed delegation is reset and sent again with the next block
+	ctx := suite.controllerChain.GetContext()
+	suite.Require().Empty(lscosmosKeeper.GetDelegationState(ctx).HostAccountDelegations)
+	suite.Require().Equal(amount, lscosmosKeeper.GetDelegationTransientAmount(ctx))
+	suite.Require().Len(sent.fromController, 1)
+
+	hostParams.AllowMessages = allowMessages
+	hostApp.ICAHostKeeper.SetParams(suite.hostChain.GetContext(), hostParams)
+	suite.coordinator.CommitBlock(suite.hostChain)
+	suite.relayAll(sent)
+
+	ctx = suite.controllerChain.GetContext()
+	suite.Require().Equal(amount, lscosmosKeeper.GetDelegationState(ctx).TotalDelegations(sdk.DefaultBondDenom).Amount)
+	suite.Require().True(lscosmosKeeper.GetDelegationTransientAmount(ctx).IsZero())
+}
+
+func (suite *ICAEndToEndTestSuite) TestDelegationTimeout() {
+	lscosmosKeeper := suite.controllerApp().LSCosmosKeeper
+	amount := sdk.NewInt(10_000_000)
+
+	suite.jumpStart()
+	suite.liquidStake(amount.Int64())
+
+	sent, _ := suite.relayOnce(suite.endEpoch(1))
+	suite.Require().Len(sent.fromController, 1)
+	suite.Require().Equal(amount, lscosmosKeeper.GetDelegationTransientAmount(suite.controllerChain.GetContext()))
+
+	// the delegation is never relayed and times out on the ordered delegation channel
+	suite.coordinator.IncrementTimeBy(types.ICATimeoutTimestamp + time.Minute)
+	suite.coordinator.CommitBlock(suite.hostChain)
+	suite.timeoutOnController(sent.fromController[0])
+
+	ctx := suite.controllerChain.GetContext()
+	suite.Require().Equal(channeltypes.CLOSED, suite.delegationPath.EndpointA.GetChannel().State)
+	delegationState := lscosmosKeeper.GetDelegationState(ctx)
+	suite.Require().Empty(delegationState.HostAccountDelegations)
+	suite.Require().Equal(amount, delegationState.HostDelegationAccountBalance.AmountOf(sdk.DefaultBondDenom))
+	suite.Require().True(lscosmosKeeper.GetDelegationTransientAmount(ctx).IsZero())
+}
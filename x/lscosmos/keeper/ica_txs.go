@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -44,12 +45,17 @@ func (k Keeper) GenerateAndExecuteICATx(ctx sdk.Context, connectionID string, ow
 	}
 	ctx.EventManager().EmitEvents(res.GetEvents())
 
+	portID, err := icatypes.NewControllerPortID(ownerID)
+	if err != nil {
+		return err
+	}
 	for _, msgResponse := range res.MsgResponses {
 		var parsedMsgResponse types.MsgSendTxResponse
 		if err := k.cdc.Unmarshal(msgResponse.Value, &parsedMsgResponse); err != nil {
 			return errorsmod.Wrapf(sdkerrors.ErrJSONUnmarshal, "cannot unmarshal ica sendtx response message: %s", err.Error())
 		}
 		k.Logger(ctx).Info(fmt.Sprintf("sent ICA transactions with seq: %v,  connectionID: %s, ownerID: %s, msgs: %s", parsedMsgResponse.Sequence, connectionID, ownerID, msgs))
+		k.SetICATxSendTime(ctx, portID, parsedMsgResponse.Sequence, ctx.BlockTime())
 	}
 
 	return nil
@@ -91,3 +97,57 @@ func (k Keeper) CheckPendingICATxs(ctx sdk.Context) (bool, error) {
 	}
 	return false, nil
 }
+
+// SetICATxSendTime stores the block time at which the ica tx with the given port and sequence was sent
+func (k Keeper) SetICATxSendTime(ctx sdk.Context, portID string, sequence uint64, sendTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(lscosmostypes.GetICATxSendTimeKey(portID, sequence), sdk.FormatTimeBytes(sendTime))
+}
+
+// RemoveICATxSendTime removes the send time of an acknowledged or timed out ica tx
+func (k Keeper) RemoveICATxSendTime(ctx sdk.Context, portID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(lscosmostypes.GetICATxSendTimeKey(portID, sequence))
+}
+
+// GetInFlightICATxs returns the number of ica txs pending an acknowledgement and the send time
+// of the oldest one. The send time is zero if there are no ica txs in flight.
+func (k Keeper) GetInFlightICATxs(ctx sdk.Context) (count int, oldest time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, lscosmostypes.ICATxSendTimeKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		sendTime, err := sdk.ParseTimeBytes(iterator.Value())
+		if err != nil {
+			continue
+		}
+		count++
+		if oldest.IsZero() || sendTime.Before(oldest) {
+			oldest = sendTime
+		}
+	}
+	return count, oldest
+}
+
+// GetAllICATxSendTimes returns the send times of all the ica txs pending an acknowledgement
+func (k Keeper) GetAllICATxSendTimes(ctx sdk.Context) []lscosmostypes.ICATxSendTime {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, lscosmostypes.ICATxSendTimeKey)
+	defer iterator.Close()
+
+	var icaTxSendTimes []lscosmostypes.ICATxSendTime
+	for ; iterator.Valid(); iterator.Next() {
+		sendTime, err := sdk.ParseTimeBytes(iterator.Value())
+		if err != nil {
+			continue
+		}
+		portID, sequence := lscosmostypes.ParseICATxSendTimeKey(iterator.Key())
+		icaTxSendTimes = append(icaTxSendTimes, lscosmostypes.ICATxSendTime{
+			PortID:   portID,
+			Sequence: sequence,
+			SendTime: sendTime,
+		})
+	}
+	return icaTxSendTimes
+}
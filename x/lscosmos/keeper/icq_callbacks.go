@@ -17,6 +17,7 @@ import (
 const (
 	RewardsAccountBalance = "reward_account_balance"
 	Delegation            = "delegation"
+	HostStakingParams     = "host_staking_params"
 )
 
 // CallbackFn wrapper struct for interchainstaking keeper
@@ -44,7 +45,8 @@ func (c Callbacks) AddCallback(id string, fn interface{}) icqtypes.QueryCallback
 func (c Callbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
 	a := c.
 		AddCallback(RewardsAccountBalance, CallbackFn(RewardsAccountBalanceCallback)).
-		AddCallback(Delegation, CallbackFn(DelegationCallback))
+		AddCallback(Delegation, CallbackFn(DelegationCallback)).
+		AddCallback(HostStakingParams, CallbackFn(HostStakingParamsCallback))
 
 	return a.(Callbacks)
 }
@@ -70,6 +72,11 @@ func DelegationCallback(k Keeper, ctx sdk.Context, response []byte, query icqtyp
 	return k.HandleDelegationCallback(ctx, response, query)
 }
 
+// HostStakingParamsCallback returns response of HandleHostStakingParamsCallback
+func HostStakingParamsCallback(k Keeper, ctx sdk.Context, response []byte, query icqtypes.Query) error {
+	return k.HandleHostStakingParamsCallback(ctx, response, query)
+}
+
 // HandleRewardsAccountBalanceCallback generates and executes rewards account balance query
 func (k Keeper) HandleRewardsAccountBalanceCallback(ctx sdk.Context, response []byte, _ icqtypes.Query) error {
 	resp := banktypes.QueryBalanceResponse{}
@@ -131,6 +138,8 @@ func (k Keeper) HandleDelegationCallback(ctx sdk.Context, response []byte, _ icq
 			"validator:", resp.GetDelegationResponse().Delegation.ValidatorAddress,
 			"delegationState:", existingDelegation.Amount,
 			"hostDelegation:", resp.GetDelegationResponse().Balance)
+		incrSlashingCounter(resp.GetDelegationResponse().Delegation.ValidatorAddress)
+		cValue := k.GetCValue(ctx)
 		// emit event slashing fixed
 		ctx.EventManager().EmitEvents(sdk.Events{
 			sdk.NewEvent(
@@ -140,7 +149,36 @@ func (k Keeper) HandleDelegationCallback(ctx sdk.Context, response []byte, _ icq
 				sdk.NewAttribute(types.AttributeUpdatedDelegation, resp.GetDelegationResponse().Balance.String()),
 				sdk.NewAttribute(types.AttributeSlashedAmount, existingDelegation.Amount.Sub(resp.GetDelegationResponse().Balance).String()),
 			)})
+		err = ctx.EventManager().EmitTypedEvent(&types.EventSlashingDetected{
+			ValidatorAddress:   resp.GetDelegationResponse().Delegation.ValidatorAddress,
+			ExistingDelegation: existingDelegation.Amount,
+			UpdatedDelegation:  resp.GetDelegationResponse().Balance,
+			SlashedAmount:      existingDelegation.Amount.Sub(resp.GetDelegationResponse().Balance),
+		})
+		if err != nil {
+			return err
+		}
 		k.ForceUpdateHostAccountDelegation(ctx, types.NewHostAccountDelegation(resp.GetDelegationResponse().Delegation.ValidatorAddress, resp.GetDelegationResponse().GetBalance()))
+
+		k.AfterSlashingReconciled(ctx, resp.GetDelegationResponse().Delegation.ValidatorAddress, existingDelegation.Amount.Sub(resp.GetDelegationResponse().Balance))
+		k.AfterCValueChange(ctx, cValue, k.GetCValue(ctx))
+	}
+	return nil
+}
+
+// HandleHostStakingParamsCallback updates the host max entries from the host chain staking params
+func (k Keeper) HandleHostStakingParamsCallback(ctx sdk.Context, response []byte, _ icqtypes.Query) error {
+	resp := stakingtypes.QueryParamsResponse{}
+	err := k.cdc.Unmarshal(response, &resp)
+	if err != nil {
+		return err
+	}
+	k.Logger(ctx).Info("Callback for Host Staking Params", "MaxEntries: ", resp.Params.MaxEntries)
+
+	// keep the existing value, a host chain can never have zero max entries
+	if resp.Params.MaxEntries == 0 {
+		return nil
 	}
+	k.SetHostMaxEntries(ctx, resp.Params.MaxEntries)
 	return nil
 }
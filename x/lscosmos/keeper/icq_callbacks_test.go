@@ -1,7 +1,10 @@
 package keeper_test
 
 import (
+	"errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
@@ -12,6 +15,7 @@ import (
 	icqtypes "github.com/merlin-network/elysium-sdk/v2/x/interchainquery/types"
 >>>>>>> 4b25098 (::)
 
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/keeper"
 	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
 )
 
@@ -131,3 +135,105 @@ func (suite *IntegrationTestSuite) TestHandleDelegationCallbacks() {
 	//slashed
 	suite.Equal(lscosmosKeeper.GetHostAccountDelegation(ctx, valAddrStr1).Amount, sdk.NewInt64Coin(hostChainParams.BaseDenom, 24))
 }
+
+func (suite *IntegrationTestSuite) TestHandleHostStakingParamsCallback() {
+	app, ctx := suite.app, suite.ctx
+	lscosmosKeeper := app.LSCosmosKeeper
+
+	suite.Equal(types.DefaultHostMaxEntries, lscosmosKeeper.GetHostMaxEntries(ctx))
+
+	params := stakingtypes.DefaultParams()
+	params.MaxEntries = 5
+	response, err := proto.Marshal(&stakingtypes.QueryParamsResponse{Params: params})
+	suite.NoError(err)
+
+	err = lscosmosKeeper.HandleHostStakingParamsCallback(ctx, response, icqtypes.Query{})
+	suite.NoError(err)
+	suite.Equal(uint32(5), lscosmosKeeper.GetHostMaxEntries(ctx))
+
+	// zero max entries does not overwrite the stored value
+	params.MaxEntries = 0
+	response, err = proto.Marshal(&stakingtypes.QueryParamsResponse{Params: params})
+	suite.NoError(err)
+
+	err = lscosmosKeeper.HandleHostStakingParamsCallback(ctx, response, icqtypes.Query{})
+	suite.NoError(err)
+	suite.Equal(uint32(5), lscosmosKeeper.GetHostMaxEntries(ctx))
+
+	err = lscosmosKeeper.HandleHostStakingParamsCallback(ctx, []byte("invalid"), icqtypes.Query{})
+	suite.Error(err)
+}
+
+// setupDelegationCallback returns a keeper with mock hooks and the delegation response of a slashed delegation
+func (suite *IntegrationTestSuite) setupDelegationCallback() (keeper.Keeper, *mockLSCosmosHooks, []byte, string) {
+	app, ctx := suite.app, suite.ctx
+	lscosmosKeeper, hooks := suite.keeperWithMockHooks()
+	hostChainParams := lscosmosKeeper.GetHostChainParams(ctx)
+	hostAccounts := lscosmosKeeper.GetHostAccounts(ctx)
+
+	valAddrStr, err := types.Bech32FromValAddress(sdk.ValAddress("valAddr1"), types.CosmosValOperPrefix)
+	suite.NoError(err)
+	lscosmosKeeper.SetDelegationState(ctx, types.DelegationState{
+		HostChainDelegationAddress: "address_________________",
+		HostAccountDelegations: []types.HostAccountDelegation{
+			types.NewHostAccountDelegation(valAddrStr, sdk.NewInt64Coin(hostChainParams.BaseDenom, 100)),
+		},
+	})
+	// stk supply for the c value
+	suite.NoError(testutil.FundAccount(app.BankKeeper, ctx, sdk.AccAddress("delegator___________"), sdk.NewCoins(sdk.NewInt64Coin(hostChainParams.MintDenom, 100))))
+
+	// no pending ica txs
+	for _, portID := range []string{hostAccounts.DelegatorAccountPortID(), hostAccounts.RewardsAccountPortID()} {
+		app.ICAControllerKeeper.SetActiveChannelID(ctx, hostChainParams.ConnectionID, portID, "channel-"+portID)
+		app.IBCKeeper.ChannelKeeper.SetChannel(ctx, portID, "channel-"+portID, channeltypes.Channel{
+			State:          channeltypes.OPEN,
+			Ordering:       channeltypes.ORDERED,
+			ConnectionHops: []string{hostChainParams.ConnectionID},
+		})
+		app.IBCKeeper.ChannelKeeper.SetNextSequenceSend(ctx, portID, "channel-"+portID, 1)
+		app.IBCKeeper.ChannelKeeper.SetNextSequenceAck(ctx, portID, "channel-"+portID, 1)
+	}
+
+	delegationResponse, err := proto.Marshal(&stakingtypes.QueryDelegationResponse{DelegationResponse: &stakingtypes.DelegationResponse{
+		Delegation: stakingtypes.Delegation{
+			DelegatorAddress: "address_________________",
+			ValidatorAddress: valAddrStr,
+			Shares:           sdk.MustNewDecFromStr("100"),
+		},
+		Balance: sdk.NewInt64Coin(hostChainParams.BaseDenom, 80),
+	}})
+	suite.NoError(err)
+	return lscosmosKeeper, hooks, delegationResponse, valAddrStr
+}
+
+func (suite *IntegrationTestSuite) TestHandleDelegationCallbackHooks() {
+	ctx := suite.ctx
+	lscosmosKeeper, hooks, delegationResponse, valAddrStr := suite.setupDelegationCallback()
+	hostChainParams := lscosmosKeeper.GetHostChainParams(ctx)
+	suite.NoError(lscosmosKeeper.HandleDelegationCallback(ctx, delegationResponse, icqtypes.Query{}))
+
+	suite.Equal([]string{valAddrStr}, hooks.slashedValAddrs)
+	suite.Equal([]sdk.Coin{sdk.NewInt64Coin(hostChainParams.BaseDenom, 20)}, hooks.slashedAmounts)
+	suite.Len(hooks.cValueChanges, 1)
+	suite.Equal(sdk.OneDec(), hooks.cValueChanges[0][0])
+	suite.Equal(sdk.NewDecWithPrec(125, 2), hooks.cValueChanges[0][1])
+}
+
+func (suite *IntegrationTestSuite) TestHandleDelegationCallbackFailingHooks() {
+	ctx := suite.ctx
+	lscosmosKeeper, hooks, delegationResponse, valAddrStr := suite.setupDelegationCallback()
+	hostChainParams := lscosmosKeeper.GetHostChainParams(ctx)
+	hostMaxEntries := lscosmosKeeper.GetHostMaxEntries(ctx)
+	hooks.write = func(ctx sdk.Context) { lscosmosKeeper.SetHostMaxEntries(ctx, hostMaxEntries+1) }
+	hooks.err = errors.New("hook failure")
+
+	// the slashing is reconciled, the writes of the failing hooks are dropped
+	suite.NoError(lscosmosKeeper.HandleDelegationCallback(ctx, delegationResponse, icqtypes.Query{}))
+	suite.Len(hooks.slashedAmounts, 1)
+	suite.Len(hooks.cValueChanges, 1)
+	suite.Equal(hostMaxEntries, lscosmosKeeper.GetHostMaxEntries(ctx))
+	suite.Equal(
+		[]types.HostAccountDelegation{types.NewHostAccountDelegation(valAddrStr, sdk.NewInt64Coin(hostChainParams.BaseDenom, 80))},
+		lscosmosKeeper.GetDelegationState(ctx).HostAccountDelegations,
+	)
+}
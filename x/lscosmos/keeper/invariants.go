@@ -11,6 +11,7 @@ import (
 // RegisterInvariants registers the lscosmos module invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "c-value-range", CValueRangeInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "undelegation-balance", UndelegationBalanceInvariant(k))
 }
 
 // CValueRangeInvariant checks that if CValue is within module safety range
@@ -33,3 +34,54 @@ func CValueRangeInvariant(k Keeper) sdk.Invariant {
 		), false
 	}
 }
+
+// UndelegationBalanceInvariant checks that the undelegation module account holds the stk tokens of the
+// undelegations not yet burnt or failed, and the tokens claimable for matured unbonding epochs
+func UndelegationBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if !k.GetModuleState(ctx) {
+			return "Module is disabled, cannot check invariant", false
+		}
+
+		hostChainParams := k.GetHostChainParams(ctx)
+		ibcDenom := k.GetIBCDenom(ctx)
+		expected := sdk.NewCoins()
+
+		// stk tokens are burnt once the host chain acknowledges the undelegation with its completion time
+		for _, undelegation := range k.GetDelegationState(ctx).HostAccountUndelegations {
+			if undelegation.CompletionTime.IsZero() && undelegation.TotalUndelegationAmount.Denom == hostChainParams.MintDenom {
+				expected = expected.Add(undelegation.TotalUndelegationAmount)
+			}
+		}
+
+		cValues := make(map[int64]types.UnbondingEpochCValue)
+		for _, cValue := range k.IterateAllUnbondingEpochCValues(ctx) {
+			cValues[cValue.EpochNumber] = cValue
+		}
+		for _, entry := range k.IterateAllDelegatorUnbondingEpochEntry(ctx) {
+			cValue, ok := cValues[entry.EpochNumber]
+			switch {
+			case !ok:
+				continue
+			case cValue.IsFailed:
+				expected = expected.Add(entry.Amount)
+			case cValue.IsMatured:
+				claimableAmount := sdk.NewDecFromInt(entry.Amount.Amount).Quo(cValue.GetUnbondingEpochCValue())
+				claimableCoin, _ := sdk.NewDecCoinFromDec(ibcDenom, claimableAmount).TruncateDecimal()
+				expected = expected.Add(claimableCoin)
+			}
+		}
+
+		balance := k.bankKeeper.GetAllBalances(ctx, k.GetUndelegationModuleAccount(ctx).GetAddress())
+		if !balance.IsAllGTE(expected) {
+			return sdk.FormatInvariant(
+				types.ModuleName, "undelegation balance insufficient",
+				fmt.Sprintf("undelegation module account is expected to hold at least %s, currently holds %s", expected, balance),
+			), true
+		}
+		return sdk.FormatInvariant(
+			types.ModuleName, "undelegation balance sufficient",
+			fmt.Sprintf("undelegation module account is expected to hold at least %s, currently holds %s", expected, balance),
+		), false
+	}
+}
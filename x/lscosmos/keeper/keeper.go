@@ -37,6 +37,7 @@ type Keeper struct {
 	lscosmosScopedKeeper types.ScopedKeeper
 
 	msgRouter *baseapp.MsgServiceRouter
+	hooks     types.LSCosmosHooks
 }
 
 // NewKeeper returns a new instance of ls cosmos module keeper
@@ -119,6 +120,12 @@ func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability
 	return k.lscosmosScopedKeeper.ClaimCapability(ctx, cap, name)
 }
 
+// HasCapability checks if the module already owns the capability with the given name
+func (k Keeper) HasCapability(ctx sdk.Context, name string) bool {
+	_, ok := k.lscosmosScopedKeeper.GetCapability(ctx, name)
+	return ok
+}
+
 // NewCapability allows the module that can initiate and claim a capability that IBC module passes to it
 func (k Keeper) NewCapability(ctx sdk.Context, name string) error {
 	_, err := k.lscosmosScopedKeeper.NewCapability(ctx, name)
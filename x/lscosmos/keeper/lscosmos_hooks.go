@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+// SetHooks sets the lscosmos hooks, it can only be called once
+func (k *Keeper) SetHooks(hooks types.LSCosmosHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set lscosmos hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
+// AfterLiquidStake calls the registered AfterLiquidStake hook
+func (k Keeper) AfterLiquidStake(ctx sdk.Context, delegator sdk.AccAddress, amount sdk.Coin, received sdk.Coin) error {
+	if k.hooks != nil {
+		return k.hooks.AfterLiquidStake(ctx, delegator, amount, received)
+	}
+	return nil
+}
+
+// AfterLiquidUnstake calls the registered AfterLiquidUnstake hook
+func (k Keeper) AfterLiquidUnstake(ctx sdk.Context, delegator sdk.AccAddress, amount sdk.Coin, unbondingEpochNumber int64) error {
+	if k.hooks != nil {
+		return k.hooks.AfterLiquidUnstake(ctx, delegator, amount, unbondingEpochNumber)
+	}
+	return nil
+}
+
+// AfterRedeem calls the registered AfterRedeem hook
+func (k Keeper) AfterRedeem(ctx sdk.Context, delegator sdk.AccAddress, amount sdk.Coin, received sdk.Coin) error {
+	if k.hooks != nil {
+		return k.hooks.AfterRedeem(ctx, delegator, amount, received)
+	}
+	return nil
+}
+
+// AfterClaim calls the registered AfterClaim hook
+func (k Keeper) AfterClaim(ctx sdk.Context, delegator sdk.AccAddress, epochNumber int64, claimed sdk.Coin) error {
+	if k.hooks != nil {
+		return k.hooks.AfterClaim(ctx, delegator, epochNumber, claimed)
+	}
+	return nil
+}
+
+// AfterCValueChange calls the registered AfterCValueChange hook if the c value has changed. It is called from the
+// ica acknowledgements and the icq callbacks, so an error of the hook is logged and its writes are dropped.
+func (k Keeper) AfterCValueChange(ctx sdk.Context, oldCValue, newCValue sdk.Dec) {
+	if k.hooks != nil && !oldCValue.Equal(newCValue) {
+		k.applyHookIfNoError(ctx, "AfterCValueChange", func(ctx sdk.Context) error {
+			return k.hooks.AfterCValueChange(ctx, oldCValue, newCValue)
+		})
+	}
+}
+
+// AfterSlashingReconciled calls the registered AfterSlashingReconciled hook. It is called from the icq delegation
+// callback, so an error of the hook is logged and its writes are dropped.
+func (k Keeper) AfterSlashingReconciled(ctx sdk.Context, validatorAddress string, slashedAmount sdk.Coin) {
+	if k.hooks != nil {
+		k.applyHookIfNoError(ctx, "AfterSlashingReconciled", func(ctx sdk.Context) error {
+			return k.hooks.AfterSlashingReconciled(ctx, validatorAddress, slashedAmount)
+		})
+	}
+}
+
+// applyHookIfNoError runs hook in a cached context and writes its changes only if it succeeds
+func (k Keeper) applyHookIfNoError(ctx sdk.Context, hookName string, hook func(ctx sdk.Context) error) {
+	cachedCtx, writeCache := ctx.CacheContext()
+	if err := hook(cachedCtx); err != nil {
+		k.Logger(ctx).Error("lscosmos hook failed, its changes are dropped", "hook", hookName, "err", err)
+		return
+	}
+	writeCache()
+}
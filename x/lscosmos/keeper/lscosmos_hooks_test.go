@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/keeper"
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+// mockLSCosmosHooks records the calls of the lscosmos hooks, the c value and slashing hooks call write and fail
+// with err when err is set
+type mockLSCosmosHooks struct {
+	liquidStakes    []sdk.Coin
+	liquidUnstakes  []sdk.Coin
+	redeems         []sdk.Coin
+	claims          []sdk.Coin
+	cValueChanges   [][2]sdk.Dec
+	slashedAmounts  []sdk.Coin
+	slashedValAddrs []string
+	write           func(ctx sdk.Context)
+	err             error
+}
+
+var _ types.LSCosmosHooks = &mockLSCosmosHooks{}
+
+func (h *mockLSCosmosHooks) AfterLiquidStake(_ sdk.Context, _ sdk.AccAddress, amount sdk.Coin, _ sdk.Coin) error {
+	h.liquidStakes = append(h.liquidStakes, amount)
+	return nil
+}
+
+func (h *mockLSCosmosHooks) AfterLiquidUnstake(_ sdk.Context, _ sdk.AccAddress, amount sdk.Coin, _ int64) error {
+	h.liquidUnstakes = append(h.liquidUnstakes, amount)
+	return nil
+}
+
+func (h *mockLSCosmosHooks) AfterRedeem(_ sdk.Context, _ sdk.AccAddress, amount sdk.Coin, _ sdk.Coin) error {
+	h.redeems = append(h.redeems, amount)
+	return nil
+}
+
+func (h *mockLSCosmosHooks) AfterClaim(_ sdk.Context, _ sdk.AccAddress, _ int64, claimed sdk.Coin) error {
+	h.claims = append(h.claims, claimed)
+	return nil
+}
+
+func (h *mockLSCosmosHooks) AfterCValueChange(ctx sdk.Context, oldCValue, newCValue sdk.Dec) error {
+	h.cValueChanges = append(h.cValueChanges, [2]sdk.Dec{oldCValue, newCValue})
+	return h.fail(ctx)
+}
+
+func (h *mockLSCosmosHooks) AfterSlashingReconciled(ctx sdk.Context, validatorAddress string, slashedAmount sdk.Coin) error {
+	h.slashedValAddrs = append(h.slashedValAddrs, validatorAddress)
+	h.slashedAmounts = append(h.slashedAmounts, slashedAmount)
+	return h.fail(ctx)
+}
+
+func (h *mockLSCosmosHooks) fail(ctx sdk.Context) error {
+	if h.err == nil {
+		return nil
+	}
+	if h.write != nil {
+		h.write(ctx)
+	}
+	return h.err
+}
+
+// keeperWithMockHooks returns a keeper on the app stores with mock hooks, the hooks of the app keeper are already set
+func (suite *IntegrationTestSuite) keeperWithMockHooks() (keeper.Keeper, *mockLSCosmosHooks) {
+	app := suite.app
+	hooks := &mockLSCosmosHooks{}
+	k := keeper.NewKeeper(
+		app.AppCodec(),
+		app.GetKey(types.StoreKey),
+		app.GetMemKey(types.MemStoreKey),
+		app.GetSubspace(types.ModuleName),
+		app.BankKeeper,
+		app.AccountKeeper,
+		app.EpochsKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		app.TransferKeeper,
+		app.ICAControllerKeeper,
+		&app.InterchainQueryKeeper,
+		app.ScopedLSCosmosKeeper,
+		app.MsgServiceRouter(),
+	)
+	return *k.SetHooks(hooks), hooks
+}
+
+func (suite *IntegrationTestSuite) TestLSCosmosHooksOnMsgs() {
+	app, ctx := suite.app, suite.ctx
+	lscosmosKeeper, hooks := suite.keeperWithMockHooks()
+	msgServer := keeper.NewMsgServerImpl(lscosmosKeeper)
+	hostChainParams := lscosmosKeeper.GetHostChainParams(ctx)
+
+	lscosmosKeeper.SetModuleState(ctx, true)
+	denomTrace := ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(TransferPort, TransferChannel, BaseDenom))
+	app.TransferKeeper.SetDenomTrace(ctx, denomTrace)
+	ibcDenom := denomTrace.IBCDenom()
+
+	delegator := sdk.AccAddress("delegator___________")
+	suite.NoError(testutil.FundAccount(app.BankKeeper, ctx, delegator, sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 1000))))
+
+	_, err := msgServer.LiquidStake(sdk.WrapSDKContext(ctx), types.NewMsgLiquidStake(sdk.NewInt64Coin(ibcDenom, 1000), delegator))
+	suite.NoError(err)
+	suite.Equal([]sdk.Coin{sdk.NewInt64Coin(ibcDenom, 1000)}, hooks.liquidStakes)
+
+	_, err = msgServer.Redeem(sdk.WrapSDKContext(ctx), types.NewMsgRedeem(sdk.NewInt64Coin(hostChainParams.MintDenom, 100), delegator))
+	suite.NoError(err)
+	suite.Equal([]sdk.Coin{sdk.NewInt64Coin(hostChainParams.MintDenom, 100)}, hooks.redeems)
+
+	// the host chain delegations have to cover the unstaked amount
+	lscosmosKeeper.SetDelegationState(ctx, types.DelegationState{
+		HostChainDelegationAddress: "address_________________",
+		HostAccountDelegations: []types.HostAccountDelegation{
+			types.NewHostAccountDelegation(allowListedValidators.AllowListedValidators[0].ValidatorAddress, sdk.NewInt64Coin(BaseDenom, 10000)),
+		},
+	})
+	_, err = msgServer.LiquidUnstake(sdk.WrapSDKContext(ctx), types.NewMsgLiquidUnstake(delegator, sdk.NewInt64Coin(hostChainParams.MintDenom, 500)))
+	suite.NoError(err)
+	suite.Len(hooks.liquidUnstakes, 1)
+	unstaked := hooks.liquidUnstakes[0]
+
+	// mature the unbonding epoch of the unstake
+	entries := lscosmosKeeper.IterateDelegatorUnbondingEpochEntry(ctx, delegator)
+	suite.Len(entries, 1)
+	lscosmosKeeper.SetUnbondingEpochCValue(ctx, types.UnbondingEpochCValue{
+		EpochNumber:    entries[0].EpochNumber,
+		STKBurn:        unstaked,
+		AmountUnbonded: sdk.NewCoin(BaseDenom, unstaked.Amount),
+	})
+	suite.NoError(lscosmosKeeper.MatureUnbondingEpochCValue(ctx, entries[0].EpochNumber))
+	suite.NoError(testutil.FundModuleAccount(app.BankKeeper, ctx, types.UndelegationModuleAccount, sdk.NewCoins(sdk.NewCoin(ibcDenom, unstaked.Amount))))
+
+	_, err = msgServer.Claim(sdk.WrapSDKContext(ctx), types.NewMsgClaim(delegator, 0))
+	suite.NoError(err)
+	suite.Equal([]sdk.Coin{sdk.NewCoin(ibcDenom, unstaked.Amount)}, hooks.claims)
+
+	// the msgs neither restake nor reconcile slashing
+	suite.Empty(hooks.cValueChanges)
+	suite.Empty(hooks.slashedAmounts)
+}
@@ -138,6 +138,21 @@ func (m msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.DelegatorAddress),
 		)},
 	)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLiquidStake{
+		DelegatorAddress: delegatorAddress.String(),
+		Amount:           msg.Amount,
+		Minted:           mintToken,
+		AmountReceived:   mintToken.Sub(protocolCoin),
+		DepositFee:       protocolCoin,
+		CValue:           cValue,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = m.AfterLiquidStake(ctx, delegatorAddress, msg.Amount, mintToken.Sub(protocolCoin))
+	if err != nil {
+		return nil, err
+	}
 	return &types.MsgLiquidStakeResponse{}, nil
 }
 
@@ -192,7 +207,8 @@ func (m msgServer) LiquidUnstake(goCtx context.Context, msg *types.MsgLiquidUnst
 		return nil, err
 	}
 	totalDelegations := delegationState.TotalDelegations(hostChainParams.BaseDenom)
-	baseDenomUndelegations, _ := m.ConvertStkToToken(ctx, sdktypes.NewDecCoinFromCoin(undelegations.TotalUndelegationAmount), m.GetCValue(ctx))
+	cValue := m.GetCValue(ctx)
+	baseDenomUndelegations, _ := m.ConvertStkToToken(ctx, sdktypes.NewDecCoinFromCoin(undelegations.TotalUndelegationAmount), cValue)
 	if totalDelegations.IsLT(sdktypes.NewCoin(hostChainParams.BaseDenom, baseDenomUndelegations.Amount)) {
 		return nil, errorsmod.Wrapf(types.ErrHostChainDelegationsLTUndelegations, "Delegated amount: %s is less than total undelegations for the epoch: %s", totalDelegations, undelegations.TotalUndelegationAmount)
 	}
@@ -211,6 +227,21 @@ func (m msgServer) LiquidUnstake(goCtx context.Context, msg *types.MsgLiquidUnst
 			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.GetDelegatorAddress()),
 		)},
 	)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLiquidUnstake{
+		DelegatorAddress:     msg.GetDelegatorAddress(),
+		Amount:               msg.Amount,
+		UnstakeAmount:        unstakeCoin,
+		UnstakeFee:           estakeFee,
+		UnbondingEpochNumber: unbondingEpochNumber,
+		CValue:               cValue,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = m.AfterLiquidUnstake(ctx, delegatorAddress, unstakeCoin, unbondingEpochNumber)
+	if err != nil {
+		return nil, err
+	}
 	return &types.MsgLiquidUnstakeResponse{}, nil
 }
 
@@ -266,7 +297,8 @@ func (m msgServer) Redeem(goCtx context.Context, msg *types.MsgRedeem) (*types.M
 	}
 	// convert redeem amount to ibc/allow-listed-denom amount (sub protocolCoin) based on the current c-value
 	redeemStk := msg.Amount.Sub(protocolCoin)
-	redeemToken, _ := m.ConvertStkToToken(ctx, sdktypes.NewDecCoinFromCoin(redeemStk), m.GetCValue(ctx))
+	cValue := m.GetCValue(ctx)
+	redeemToken, _ := m.ConvertStkToToken(ctx, sdktypes.NewDecCoinFromCoin(redeemStk), cValue)
 
 	// get all deposit account balances
 	allDepositBalances := m.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.DepositModuleAccount))
@@ -308,6 +340,20 @@ func (m msgServer) Redeem(goCtx context.Context, msg *types.MsgRedeem) (*types.M
 			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.DelegatorAddress),
 		)},
 	)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRedeem{
+		DelegatorAddress: redeemAddress.String(),
+		Amount:           msg.Amount,
+		AmountReceived:   redeemToken,
+		RedeemFee:        protocolCoin,
+		CValue:           cValue,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = m.AfterRedeem(ctx, redeemAddress, msg.Amount, redeemToken)
+	if err != nil {
+		return nil, err
+	}
 	return &types.MsgRedeemResponse{}, nil
 }
 
@@ -326,10 +372,10 @@ func (m msgServer) Claim(goCtx context.Context, msg *types.MsgClaim) (*types.Msg
 		return nil, err
 	}
 
-	// get all the entries corresponding to the delegator address
-	delegatorUnbondingEntries := m.IterateDelegatorUnbondingEpochEntry(ctx, delegatorAddress)
+	// get the matured or failed entries corresponding to the delegator address, bounded by max entries
+	delegatorUnbondingEntries, remaining := m.GetClaimableDelegatorUnbondingEpochEntries(ctx, delegatorAddress, msg.MaxEntries)
 
-	// loop through all the epoch and send tokens if an entry has matured.
+	// loop through the claimable epochs and send tokens if an entry has matured or failed.
 	for _, unbondingEntry := range delegatorUnbondingEntries {
 		unbondingEpochCValue := m.GetUnbondingEpochCValue(ctx, unbondingEntry.EpochNumber)
 		if unbondingEpochCValue.IsMatured {
@@ -354,6 +400,21 @@ func (m msgServer) Claim(goCtx context.Context, msg *types.MsgClaim) (*types.Msg
 					sdktypes.NewAttribute(types.AttributeClaimedAmount, claimableAmount.String()),
 				)},
 			)
+			err = ctx.EventManager().EmitTypedEvent(&types.EventClaim{
+				DelegatorAddress:     delegatorAddress.String(),
+				EpochNumber:          unbondingEntry.EpochNumber,
+				Amount:               unbondingEntry.Amount,
+				Claimed:              claimableCoin,
+				UnbondingEpochCValue: unbondingEpochCValue.GetUnbondingEpochCValue(),
+				IsFailed:             false,
+			})
+			if err != nil {
+				return nil, err
+			}
+			err = m.AfterClaim(ctx, delegatorAddress, unbondingEntry.EpochNumber, claimableCoin)
+			if err != nil {
+				return nil, err
+			}
 
 			// remove entry from unbonding epoch entry
 			m.RemoveDelegatorUnbondingEpochEntry(ctx, delegatorAddress, unbondingEntry.EpochNumber)
@@ -363,6 +424,21 @@ func (m msgServer) Claim(goCtx context.Context, msg *types.MsgClaim) (*types.Msg
 			if err != nil {
 				return nil, err
 			}
+			err = ctx.EventManager().EmitTypedEvent(&types.EventClaim{
+				DelegatorAddress:     delegatorAddress.String(),
+				EpochNumber:          unbondingEntry.EpochNumber,
+				Amount:               unbondingEntry.Amount,
+				Claimed:              unbondingEntry.Amount,
+				UnbondingEpochCValue: sdktypes.ZeroDec(),
+				IsFailed:             true,
+			})
+			if err != nil {
+				return nil, err
+			}
+			err = m.AfterClaim(ctx, delegatorAddress, unbondingEntry.EpochNumber, unbondingEntry.Amount)
+			if err != nil {
+				return nil, err
+			}
 
 			// remove entry from unbonding epoch entry
 			m.RemoveDelegatorUnbondingEpochEntry(ctx, delegatorAddress, unbondingEntry.EpochNumber)
@@ -378,7 +454,7 @@ func (m msgServer) Claim(goCtx context.Context, msg *types.MsgClaim) (*types.Msg
 		)},
 	)
 
-	return &types.MsgClaimResponse{}, nil
+	return &types.MsgClaimResponse{Remaining: remaining}, nil
 }
 
 // JumpStart defines a method for jump-starting the module through fee address account.
@@ -550,6 +626,13 @@ func (m msgServer) ChangeModuleState(goCtx context.Context, msg *types.MsgChange
 			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.EstakeAddress),
 		)},
 	)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventModuleStateChanged{
+		ModuleState: msg.ModuleState,
+		Authority:   msg.EstakeAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
 	return &types.MsgChangeModuleStateResponse{}, nil
 
 }
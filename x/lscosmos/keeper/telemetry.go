@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+
+	"github.com/merlin-network/estake-native/v2/utils"
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+// EmitTelemetry updates the liquid staking health gauges: c value, mint rate, TVL components,
+// stk supply, in-flight ica txs, pending undelegation epochs and unclaimed balance. It is called at the
+// BeginBlock only when telemetry is enabled.
+func (k Keeper) EmitTelemetry(ctx sdk.Context) {
+	moduleEnabled := float32(0)
+	if k.GetModuleState(ctx) {
+		moduleEnabled = 1
+	}
+	telemetry.ModuleSetGauge(types.ModuleName, moduleEnabled, types.MetricKeyModuleEnabled)
+
+	hostChainParams := k.GetHostChainParams(ctx)
+	if hostChainParams.IsEmpty() {
+		return
+	}
+
+	cValue := k.GetCValue(ctx)
+	telemetry.ModuleSetGauge(types.ModuleName, utils.DecToFloat32(cValue), types.MetricKeyCValue)
+	// mint rate is the amount of stk tokens a delegator receives for one token deposited
+	mintRate := cValue.Mul(sdk.OneDec().Sub(hostChainParams.EstakeParams.EstakeDepositFee))
+	telemetry.ModuleSetGauge(types.ModuleName, utils.DecToFloat32(mintRate), types.MetricKeyMintRate)
+
+	for component, amount := range map[string]math.Int{
+		types.MetricValueTVLDeposit:           k.GetDepositAccountAmount(ctx),
+		types.MetricValueTVLIBCTransfer:       k.GetIBCTransferTransientAmount(ctx),
+		types.MetricValueTVLDelegateTransient: k.GetDelegationTransientAmount(ctx),
+		types.MetricValueTVLStaked:            k.GetStakedAmount(ctx),
+		types.MetricValueTVLHostAccount:       k.GetHostDelegationAccountAmount(ctx),
+	} {
+		telemetry.SetGaugeWithLabels(
+			[]string{types.ModuleName, types.MetricKeyTVL},
+			utils.IntToFloat32(amount),
+			[]metrics.Label{telemetry.NewLabel(types.MetricLabelTVLComponent, component)},
+		)
+	}
+	telemetry.ModuleSetGauge(types.ModuleName, utils.IntToFloat32(k.GetMintedAmount(ctx)), types.MetricKeyStkSupply)
+
+	inFlight, oldest := k.GetInFlightICATxs(ctx)
+	telemetry.ModuleSetGauge(types.ModuleName, float32(inFlight), types.MetricKeyInFlightICATxs)
+	oldestAge := float32(0)
+	if !oldest.IsZero() {
+		oldestAge = float32(ctx.BlockTime().Sub(oldest).Seconds())
+	}
+	telemetry.ModuleSetGauge(types.ModuleName, oldestAge, types.MetricKeyOldestICATxAge)
+
+	pendingUndelegations := len(k.GetDelegationState(ctx).HostAccountUndelegations)
+	telemetry.ModuleSetGauge(types.ModuleName, float32(pendingUndelegations), types.MetricKeyPendingUndelegations)
+
+	unclaimedBalance := k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.UndelegationModuleAccount), k.GetIBCDenom(ctx))
+	telemetry.ModuleSetGauge(types.ModuleName, utils.IntToFloat32(unclaimedBalance.Amount), types.MetricKeyUnclaimedBalance)
+}
+
+// incrICATxCounter increments the failed or timed out ica tx counter once for every validator of the ica tx
+// msgs, txs without any validator msg are counted under the MetricValueNoValidator label.
+func (k Keeper) incrICATxCounter(metricKey string, icaPacket icatypes.InterchainAccountPacketData) {
+	validators := k.icaTxValidators(icaPacket)
+	if len(validators) == 0 {
+		validators = []string{types.MetricValueNoValidator}
+	}
+	for _, validator := range validators {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, metricKey},
+			1,
+			[]metrics.Label{telemetry.NewLabel(types.MetricLabelValidator, validator)},
+		)
+	}
+}
+
+// icaTxValidators returns the distinct host chain validators of the ica tx msgs in order
+func (k Keeper) icaTxValidators(icaPacket icatypes.InterchainAccountPacketData) []string {
+	msgs, err := icatypes.DeserializeCosmosTx(k.cdc, icaPacket.GetData())
+	if err != nil {
+		return nil
+	}
+
+	var validators []string
+	seen := make(map[string]struct{})
+	add := func(validator string) {
+		if _, ok := seen[validator]; ok || validator == "" {
+			return
+		}
+		seen[validator] = struct{}{}
+		validators = append(validators, validator)
+	}
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *stakingtypes.MsgDelegate:
+			add(msg.ValidatorAddress)
+		case *stakingtypes.MsgUndelegate:
+			add(msg.ValidatorAddress)
+		case *stakingtypes.MsgBeginRedelegate:
+			add(msg.ValidatorSrcAddress)
+			add(msg.ValidatorDstAddress)
+		case *distributiontypes.MsgWithdrawDelegatorReward:
+			add(msg.ValidatorAddress)
+		}
+	}
+	return validators
+}
+
+// incrSlashingCounter increments the slashing counter for the validator
+func incrSlashingCounter(validatorAddress string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, types.MetricKeySlashingDetected},
+		1,
+		[]metrics.Label{telemetry.NewLabel(types.MetricLabelValidator, validatorAddress)},
+	)
+}
@@ -40,14 +40,20 @@ func (k Keeper) IterateAllUnbondingEpochCValues(ctx sdk.Context) []types.Unbondi
 }
 
 // MatureUnbondingEpochCValue sets unbonding epochCValue as matured
-func (k Keeper) MatureUnbondingEpochCValue(ctx sdk.Context, epochNumber int64) {
+func (k Keeper) MatureUnbondingEpochCValue(ctx sdk.Context, epochNumber int64) error {
 	unbondingEpochCValue := k.GetUnbondingEpochCValue(ctx, epochNumber)
 	unbondingEpochCValue.IsMatured = true
 	k.SetUnbondingEpochCValue(ctx, unbondingEpochCValue)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventUnbondingEpochMatured{
+		EpochNumber:    epochNumber,
+		StkBurn:        unbondingEpochCValue.STKBurn,
+		AmountUnbonded: unbondingEpochCValue.AmountUnbonded,
+	})
 }
 
 // FailUnbondingEpochCValue sets unbonding epochCValue as timeout for undelegation
-func (k Keeper) FailUnbondingEpochCValue(ctx sdk.Context, epochNumber int64, undelegationAmount sdk.Coin) {
+func (k Keeper) FailUnbondingEpochCValue(ctx sdk.Context, epochNumber int64, undelegationAmount sdk.Coin) error {
 	unbondingEpochCValue := k.GetUnbondingEpochCValue(ctx, epochNumber)
 	if unbondingEpochCValue.EpochNumber != epochNumber {
 		unbondingEpochCValue.EpochNumber = epochNumber
@@ -55,4 +61,14 @@ func (k Keeper) FailUnbondingEpochCValue(ctx sdk.Context, epochNumber int64, und
 	}
 	unbondingEpochCValue.IsFailed = true
 	k.SetUnbondingEpochCValue(ctx, unbondingEpochCValue)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventUnbondingEpochFailed{
+		EpochNumber: epochNumber,
+		StkBurn:     unbondingEpochCValue.STKBurn,
+	})
+}
+
+// GetCurrentUndelegationEpoch returns the current epoch number of the undelegation epoch
+func (k Keeper) GetCurrentUndelegationEpoch(ctx sdk.Context) int64 {
+	return k.epochKeeper.GetEpochInfo(ctx, types.UndelegationEpochIdentifier).CurrentEpoch
 }
@@ -0,0 +1,38 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+func (suite *IntegrationTestSuite) TestMatureAndFailUnbondingEpochCValue() {
+	app, ctx := suite.app, suite.ctx.WithEventManager(sdk.NewEventManager())
+	keeper := app.LSCosmosKeeper
+
+	keeper.SetUnbondingEpochCValue(ctx, types.UnbondingEpochCValue{
+		EpochNumber:    4,
+		STKBurn:        sdk.NewInt64Coin("stk/uatom", 100),
+		AmountUnbonded: sdk.NewInt64Coin("uatom", 110),
+	})
+	suite.NoError(keeper.MatureUnbondingEpochCValue(ctx, 4))
+	suite.True(keeper.GetUnbondingEpochCValue(ctx, 4).IsMatured)
+
+	suite.NoError(keeper.FailUnbondingEpochCValue(ctx, 8, sdk.NewInt64Coin("stk/uatom", 50)))
+	failed := keeper.GetUnbondingEpochCValue(ctx, 8)
+	suite.True(failed.IsFailed)
+	suite.Equal(sdk.NewInt64Coin("stk/uatom", 50), failed.STKBurn)
+
+	events := ctx.EventManager().Events()
+	suite.Len(events, 2)
+	matured, err := sdk.ParseTypedEvent(events[0].ToABCIEvent())
+	suite.NoError(err)
+	suite.Equal(&types.EventUnbondingEpochMatured{
+		EpochNumber:    4,
+		StkBurn:        sdk.NewInt64Coin("stk/uatom", 100),
+		AmountUnbonded: sdk.NewInt64Coin("uatom", 110),
+	}, matured)
+	failedEvent, err := sdk.ParseTypedEvent(events[1].ToABCIEvent())
+	suite.NoError(err)
+	suite.Equal(&types.EventUnbondingEpochFailed{EpochNumber: 8, StkBurn: sdk.NewInt64Coin("stk/uatom", 50)}, failedEvent)
+}
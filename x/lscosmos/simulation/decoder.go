@@ -0,0 +1,88 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding lscosmos type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.ModuleEnableKey):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.HostChainParamsKey):
+			var hostChainParamsA, hostChainParamsB types.HostChainParams
+			cdc.MustUnmarshal(kvA.Value, &hostChainParamsA)
+			cdc.MustUnmarshal(kvB.Value, &hostChainParamsB)
+			return fmt.Sprintf("%v\n%v", hostChainParamsA, hostChainParamsB)
+
+		case bytes.Equal(kvA.Key[:1], types.AllowListedValidatorsKey):
+			var allowListedValidatorsA, allowListedValidatorsB types.AllowListedValidators
+			cdc.MustUnmarshal(kvA.Value, &allowListedValidatorsA)
+			cdc.MustUnmarshal(kvB.Value, &allowListedValidatorsB)
+			return fmt.Sprintf("%v\n%v", allowListedValidatorsA, allowListedValidatorsB)
+
+		case bytes.Equal(kvA.Key[:1], types.DelegationStateKey):
+			var delegationStateA, delegationStateB types.DelegationState
+			cdc.MustUnmarshal(kvA.Value, &delegationStateA)
+			cdc.MustUnmarshal(kvB.Value, &delegationStateB)
+			return fmt.Sprintf("%v\n%v", delegationStateA, delegationStateB)
+
+		case bytes.Equal(kvA.Key[:1], types.HostChainRewardAddressKey):
+			var rewardAddressA, rewardAddressB types.HostChainRewardAddress
+			cdc.MustUnmarshal(kvA.Value, &rewardAddressA)
+			cdc.MustUnmarshal(kvB.Value, &rewardAddressB)
+			return fmt.Sprintf("%v\n%v", rewardAddressA, rewardAddressB)
+
+		case bytes.Equal(kvA.Key[:1], types.IBCTransientStoreKey):
+			var transientStoreA, transientStoreB types.IBCAmountTransientStore
+			cdc.MustUnmarshal(kvA.Value, &transientStoreA)
+			cdc.MustUnmarshal(kvB.Value, &transientStoreB)
+			return fmt.Sprintf("%v\n%v", transientStoreA, transientStoreB)
+
+		case bytes.Equal(kvA.Key[:1], types.UnbondingEpochCValueKey):
+			var cValueA, cValueB types.UnbondingEpochCValue
+			cdc.MustUnmarshal(kvA.Value, &cValueA)
+			cdc.MustUnmarshal(kvB.Value, &cValueB)
+			return fmt.Sprintf("%v\n%v", cValueA, cValueB)
+
+		case bytes.Equal(kvA.Key[:1], types.DelegatorUnbondingEpochEntryKey):
+			var entryA, entryB types.DelegatorUnbondingEpochEntry
+			cdc.MustUnmarshal(kvA.Value, &entryA)
+			cdc.MustUnmarshal(kvB.Value, &entryB)
+			return fmt.Sprintf("%v\n%v", entryA, entryB)
+
+		case bytes.Equal(kvA.Key[:1], types.HostAccountsKey):
+			var hostAccountsA, hostAccountsB types.HostAccounts
+			cdc.MustUnmarshal(kvA.Value, &hostAccountsA)
+			cdc.MustUnmarshal(kvB.Value, &hostAccountsB)
+			return fmt.Sprintf("%v\n%v", hostAccountsA, hostAccountsB)
+
+		case bytes.Equal(kvA.Key[:1], types.ICATxSendTimeKey):
+			sendTimeA, err := sdk.ParseTimeBytes(kvA.Value)
+			if err != nil {
+				panic(err)
+			}
+			sendTimeB, err := sdk.ParseTimeBytes(kvB.Value)
+			if err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", sendTimeA, sendTimeB)
+
+		case bytes.Equal(kvA.Key[:1], types.HostMaxEntriesKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		default:
+			panic(fmt.Sprintf("invalid lscosmos key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/simulation"
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+func TestDecodeLSCosmosStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig()
+	dec := simulation.NewDecodeStore(cdc.Codec)
+
+	hostChainParams := types.HostChainParams{
+		ChainID:         simulation.HostChainID,
+		ConnectionID:    simulation.ConnectionID,
+		TransferChannel: simulation.TransferChannel,
+		TransferPort:    simulation.TransferPort,
+		BaseDenom:       simulation.BaseDenom,
+		MintDenom:       simulation.MintDenom,
+		MinDeposit:      sdk.NewInt(5),
+		EstakeParams: types.EstakeParams{
+			EstakeDepositFee:    sdk.ZeroDec(),
+			EstakeRestakeFee:    sdk.ZeroDec(),
+			EstakeUnstakeFee:    sdk.ZeroDec(),
+			EstakeRedemptionFee: sdk.ZeroDec(),
+		},
+	}
+	allowListedValidators := types.AllowListedValidators{AllowListedValidators: []types.AllowListedValidator{
+		{ValidatorAddress: "cosmosvaloper13w4ueuk80d3kmwk7ntlhp84fk0arlm3m9ammr5", TargetWeight: sdk.OneDec()},
+	}}
+	delegationState := types.DelegationState{
+		HostDelegationAccountBalance: sdk.NewCoins(sdk.NewInt64Coin(simulation.BaseDenom, 100)),
+		HostChainDelegationAddress:   "cosmos1address",
+	}
+	rewardAddress := types.HostChainRewardAddress{Address: "cosmos1reward"}
+	transientStore := types.IBCAmountTransientStore{ICADelegate: sdk.NewInt64Coin(simulation.BaseDenom, 10)}
+	cValue := types.UnbondingEpochCValue{
+		EpochNumber:    4,
+		STKBurn:        sdk.NewInt64Coin(simulation.MintDenom, 10),
+		AmountUnbonded: sdk.NewInt64Coin(simulation.BaseDenom, 10),
+	}
+	entry := types.DelegatorUnbondingEpochEntry{
+		DelegatorAddress: "persistence1delegator",
+		EpochNumber:      4,
+		Amount:           sdk.NewInt64Coin(simulation.MintDenom, 10),
+	}
+	hostAccounts := types.DefaultGenesis().HostAccounts
+	sendTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.ModuleEnableKey, Value: []byte("true")},
+			{Key: types.HostChainParamsKey, Value: cdc.Codec.MustMarshal(&hostChainParams)},
+			{Key: types.AllowListedValidatorsKey, Value: cdc.Codec.MustMarshal(&allowListedValidators)},
+			{Key: types.DelegationStateKey, Value: cdc.Codec.MustMarshal(&delegationState)},
+			{Key: types.HostChainRewardAddressKey, Value: cdc.Codec.MustMarshal(&rewardAddress)},
+			{Key: types.IBCTransientStoreKey, Value: cdc.Codec.MustMarshal(&transientStore)},
+			{Key: types.GetUnbondingEpochCValueKey(4), Value: cdc.Codec.MustMarshal(&cValue)},
+			{Key: append(types.DelegatorUnbondingEpochEntryKey, 0x01), Value: cdc.Codec.MustMarshal(&entry)},
+			{Key: types.HostAccountsKey, Value: cdc.Codec.MustMarshal(&hostAccounts)},
+			{Key: append(types.ICATxSendTimeKey, 0x01), Value: sdk.FormatTimeBytes(sendTime)},
+			{Key: types.HostMaxEntriesKey, Value: sdk.Uint64ToBigEndian(7)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"ModuleEnabled", "true\ntrue"},
+		{"HostChainParams", fmt.Sprintf("%v\n%v", hostChainParams, hostChainParams)},
+		{"AllowListedValidators", fmt.Sprintf("%v\n%v", allowListedValidators, allowListedValidators)},
+		{"DelegationState", fmt.Sprintf("%v\n%v", delegationState, delegationState)},
+		{"HostChainRewardAddress", fmt.Sprintf("%v\n%v", rewardAddress, rewardAddress)},
+		{"IBCAmountTransientStore", fmt.Sprintf("%v\n%v", transientStore, transientStore)},
+		{"UnbondingEpochCValue", fmt.Sprintf("%v\n%v", cValue, cValue)},
+		{"DelegatorUnbondingEpochEntry", fmt.Sprintf("%v\n%v", entry, entry)},
+		{"HostAccounts", fmt.Sprintf("%v\n%v", hostAccounts, hostAccounts)},
+		{"ICATxSendTime", fmt.Sprintf("%v\n%v", sendTime, sendTime)},
+		{"HostMaxEntries", "7\n7"},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+// DONTCOVER
+
+// Simulation parameter constants
+const (
+	delegationStrategy    = "delegation_strategy"
+	minDeposit            = "min_deposit"
+	estakeDepositFee      = "estake_deposit_fee"
+	estakeRestakeFee      = "estake_restake_fee"
+	estakeUnstakeFee      = "estake_unstake_fee"
+	estakeRedemptionFee   = "estake_redemption_fee"
+	allowListedValidators = "allow_listed_validators"
+	MaxAllowListedVals    = 5
+)
+
+// Host chain of the simulation, its ICA, ICQ and transfer layer is mocked by SimulateHostChain.
+const (
+	HostChainID     = "cosmoshub-4"
+	ConnectionID    = "connection-0"
+	TransferChannel = "channel-0"
+	TransferPort    = ibctransfertypes.PortID
+	BaseDenom       = "uatom"
+	MintDenom       = types.LiquidStakedDenomPrefix + "/" + BaseDenom
+
+	hostChainAccountPrefix = "cosmos"
+)
+
+func genDelegationStrategy(r *rand.Rand) types.DelegationStrategyType {
+	return types.DelegationStrategyType(r.Intn(len(types.DelegationStrategyType_name)))
+}
+
+func genMinDeposit(r *rand.Rand) math.Int {
+	return sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 1000000)))
+}
+
+// genFee returns a random fee in [0, maxFee)
+func genFee(r *rand.Rand, maxFee sdk.Dec) sdk.Dec {
+	fee := simtypes.RandomDecAmount(r, maxFee)
+	if fee.Equal(maxFee) {
+		return sdk.ZeroDec()
+	}
+	return fee
+}
+
+// genHostChainAddress returns a random bech32 address of the host chain with the given prefix.
+func genHostChainAddress(r *rand.Rand, prefix string) string {
+	bz := make([]byte, 20)
+	r.Read(bz)
+	addr, err := bech32.ConvertAndEncode(prefix, bz)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// genAllowListedValidators returns random host chain validators whose target weights sum up to one.
+func genAllowListedValidators(r *rand.Rand) types.AllowListedValidators {
+	valAddrs := make([]string, simtypes.RandIntBetween(r, 1, MaxAllowListedVals+1))
+	for i := range valAddrs {
+		valAddrs[i] = genHostChainAddress(r, types.CosmosValOperPrefix)
+	}
+	return genTargetWeights(r, valAddrs)
+}
+
+// genTargetWeights returns the validators with random target weights summing up to one.
+func genTargetWeights(r *rand.Rand, valAddrs []string) types.AllowListedValidators {
+	n := len(valAddrs)
+	shares := make([]int64, n)
+	total := int64(0)
+	for i := range shares {
+		shares[i] = int64(simtypes.RandIntBetween(r, 1, 100))
+		total += shares[i]
+	}
+
+	vals := make([]types.AllowListedValidator, n)
+	sum := sdk.ZeroDec()
+	for i := range vals {
+		weight := sdk.OneDec().Sub(sum)
+		if i < n-1 {
+			weight = sdk.NewDec(shares[i]).QuoInt64(total)
+		}
+		sum = sum.Add(weight)
+		vals[i] = types.AllowListedValidator{
+			ValidatorAddress: valAddrs[i],
+			TargetWeight:     weight,
+		}
+	}
+	return types.AllowListedValidators{AllowListedValidators: vals}
+}
+
+// RandomizedGenState generates a random GenesisState for lscosmos, the module is enabled with a mocked host chain.
+func RandomizedGenState(simState *module.SimulationState) {
+	genesis := types.DefaultGenesis()
+	genesis.ModuleEnabled = true
+	genesis.HostChainParams = types.HostChainParams{
+		ChainID:         HostChainID,
+		ConnectionID:    ConnectionID,
+		TransferChannel: TransferChannel,
+		TransferPort:    TransferPort,
+		BaseDenom:       BaseDenom,
+		MintDenom:       MintDenom,
+	}
+	genesis.HostChainParams.EstakeParams.EstakeFeeAddress = simState.Accounts[0].Address.String()
+	genesis.DelegationState.HostChainDelegationAddress = genHostChainAddress(simState.Rand, hostChainAccountPrefix)
+	genesis.HostChainRewardAddress.Address = genHostChainAddress(simState.Rand, hostChainAccountPrefix)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, delegationStrategy, &genesis.Params.DelegationStrategy, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.DelegationStrategy = genDelegationStrategy(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, minDeposit, &genesis.HostChainParams.MinDeposit, simState.Rand,
+		func(r *rand.Rand) { genesis.HostChainParams.MinDeposit = genMinDeposit(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, estakeDepositFee, &genesis.HostChainParams.EstakeParams.EstakeDepositFee, simState.Rand,
+		func(r *rand.Rand) {
+			genesis.HostChainParams.EstakeParams.EstakeDepositFee = genFee(r, types.MaxEstakeDepositFee)
+		},
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, estakeRestakeFee, &genesis.HostChainParams.EstakeParams.EstakeRestakeFee, simState.Rand,
+		func(r *rand.Rand) {
+			genesis.HostChainParams.EstakeParams.EstakeRestakeFee = genFee(r, types.MaxEstakeRestakeFee)
+		},
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, estakeUnstakeFee, &genesis.HostChainParams.EstakeParams.EstakeUnstakeFee, simState.Rand,
+		func(r *rand.Rand) {
+			genesis.HostChainParams.EstakeParams.EstakeUnstakeFee = genFee(r, types.MaxEstakeUnstakeFee)
+		},
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, estakeRedemptionFee, &genesis.HostChainParams.EstakeParams.EstakeRedemptionFee, simState.Rand,
+		func(r *rand.Rand) {
+			genesis.HostChainParams.EstakeParams.EstakeRedemptionFee = genFee(r, types.MaxEstakeRedemptionFee)
+		},
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, allowListedValidators, &genesis.AllowListedValidators, simState.Rand,
+		func(r *rand.Rand) { genesis.AllowListedValidators = genAllowListedValidators(r) },
+	)
+
+	bz, _ := json.MarshalIndent(&genesis, "", " ")
+	fmt.Printf("Selected randomly generated lscosmos parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+
+	// the liquid staked ibc denom has to be resolvable by the transfer keeper
+	transferGenesis := ibctransfertypes.DefaultGenesisState()
+	if bz, ok := simState.GenState[ibctransfertypes.ModuleName]; ok {
+		simState.Cdc.MustUnmarshalJSON(bz, transferGenesis)
+	}
+	transferGenesis.DenomTraces = append(transferGenesis.DenomTraces,
+		ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(TransferPort, TransferChannel, BaseDenom)),
+	)
+	simState.GenState[ibctransfertypes.ModuleName] = simState.Cdc.MustMarshalJSON(transferGenesis)
+}
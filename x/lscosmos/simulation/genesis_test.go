@@ -0,0 +1,61 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/simulation"
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+// TestRandomizedGenState tests the normal scenario of applying RandomizedGenState.
+func TestRandomizedGenState(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	s := rand.NewSource(2)
+	r := rand.New(s)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 3),
+		InitialStake: sdk.NewInt(1000),
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+
+	var genState types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &genState)
+
+	require.NoError(t, genState.Validate())
+	require.True(t, genState.ModuleEnabled)
+	require.Equal(t, simulation.MintDenom, genState.HostChainParams.MintDenom)
+	require.Equal(t, simState.Accounts[0].Address.String(), genState.HostChainParams.EstakeParams.EstakeFeeAddress)
+	require.True(t, genState.HostChainParams.MinDeposit.IsPositive())
+	require.True(t, genState.HostChainParams.EstakeParams.EstakeDepositFee.LT(types.MaxEstakeDepositFee))
+	require.True(t, genState.HostChainParams.EstakeParams.EstakeRestakeFee.LT(types.MaxEstakeRestakeFee))
+	require.True(t, genState.HostChainParams.EstakeParams.EstakeUnstakeFee.LT(types.MaxEstakeUnstakeFee))
+	require.True(t, genState.HostChainParams.EstakeParams.EstakeRedemptionFee.LT(types.MaxEstakeRedemptionFee))
+	require.True(t, genState.AllowListedValidators.Valid())
+	require.NotEmpty(t, genState.DelegationState.HostChainDelegationAddress)
+
+	var transferGenState ibctransfertypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[ibctransfertypes.ModuleName], &transferGenState)
+	require.NoError(t, transferGenState.Validate())
+	require.Equal(t, ibctransfertypes.Traces{
+		{Path: simulation.TransferPort + "/" + simulation.TransferChannel, BaseDenom: simulation.BaseDenom},
+	}, transferGenState.DenomTraces)
+}
@@ -0,0 +1,471 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	appparams "github.com/merlin-network/estake-native/v2/app/params"
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/keeper"
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+// Simulation operation weights constants.
+//
+//nolint:gosec
+const (
+	OpWeightMsgLiquidStake   = "op_weight_msg_liquid_stake"
+	OpWeightMsgLiquidUnstake = "op_weight_msg_liquid_unstake"
+	OpWeightMsgRedeem        = "op_weight_msg_redeem"
+	OpWeightMsgClaim         = "op_weight_msg_claim"
+	OpWeightHostChain        = "op_weight_host_chain"
+
+	// TypeHostChain is the operation name of the mocked host chain relaying
+	TypeHostChain = "host_chain"
+)
+
+var (
+	Gas  = uint64(20000000)
+	Fees = sdk.Coins{
+		{
+			Denom:  "stake",
+			Amount: sdk.NewInt(0),
+		},
+	}
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper,
+	bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+
+	var weightMsgLiquidStake int
+	appParams.GetOrGenerate(cdc, OpWeightMsgLiquidStake, &weightMsgLiquidStake, nil,
+		func(_ *rand.Rand) {
+			weightMsgLiquidStake = appparams.DefaultWeightMsgLiquidStake
+		},
+	)
+
+	var weightMsgLiquidUnstake int
+	appParams.GetOrGenerate(cdc, OpWeightMsgLiquidUnstake, &weightMsgLiquidUnstake, nil,
+		func(_ *rand.Rand) {
+			weightMsgLiquidUnstake = appparams.DefaultWeightMsgLiquidUnstake
+		},
+	)
+
+	var weightMsgRedeem int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRedeem, &weightMsgRedeem, nil,
+		func(_ *rand.Rand) {
+			weightMsgRedeem = appparams.DefaultWeightMsgRedeem
+		},
+	)
+
+	var weightMsgClaim int
+	appParams.GetOrGenerate(cdc, OpWeightMsgClaim, &weightMsgClaim, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaim = appparams.DefaultWeightMsgClaim
+		},
+	)
+
+	var weightHostChain int
+	appParams.GetOrGenerate(cdc, OpWeightHostChain, &weightHostChain, nil,
+		func(_ *rand.Rand) {
+			weightHostChain = appparams.DefaultWeightHostChain
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgLiquidStake,
+			SimulateMsgLiquidStake(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgLiquidUnstake,
+			SimulateMsgLiquidUnstake(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRedeem,
+			SimulateMsgRedeem(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgClaim,
+			SimulateMsgClaim(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightHostChain,
+			SimulateHostChain(bk, k),
+		),
+	}
+}
+
+// randomAmount returns a random amount in [0, max], zero when max is not positive.
+func randomAmount(r *rand.Rand, max math.Int) math.Int {
+	if !max.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	return simtypes.RandomAmount(r, max)
+}
+
+// SimulateMsgLiquidStake generates a MsgLiquidStake with random values, the ibc denom of the host chain is minted
+// to the account when missing.
+func SimulateMsgLiquidStake(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation { //nolint: interfacer
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		if !k.GetModuleState(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeLiquidStake, "module is disabled"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		account := ak.GetAccount(ctx, simAccount.Address)
+		delegator := account.GetAddress()
+		spendable := bk.SpendableCoins(ctx, delegator)
+
+		hostChainParams := k.GetHostChainParams(ctx)
+		stakingAmt := hostChainParams.MinDeposit.Add(sdk.NewInt(int64(simtypes.RandIntBetween(r, 0, 100000000000))))
+		stakingCoin := sdk.NewCoin(k.GetIBCDenom(ctx), stakingAmt)
+		if spendable.AmountOf(stakingCoin.Denom).LT(stakingCoin.Amount) {
+			if err := bk.MintCoins(ctx, types.ModuleName, sdk.NewCoins(stakingCoin)); err != nil {
+				panic(err)
+			}
+			if err := bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegator, sdk.NewCoins(stakingCoin)); err != nil {
+				panic(err)
+			}
+			spendable = bk.SpendableCoins(ctx, delegator)
+		}
+
+		msg := types.NewMsgLiquidStake(stakingCoin, delegator)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+		return simulation.GenAndDeliverTx(txCtx, Fees)
+	}
+}
+
+// SimulateMsgLiquidUnstake generates a MsgLiquidUnstake with random values, bounded by the host chain delegations
+// left for the unbonding epoch.
+func SimulateMsgLiquidUnstake(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation { //nolint: interfacer
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		if !k.GetModuleState(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeLiquidUnstake, "module is disabled"), nil, nil
+		}
+
+		// the undelegation of the unbonding epoch has already been relayed by SimulateHostChain
+		unbondingEpoch := types.CurrentUnbondingEpoch(k.GetCurrentUndelegationEpoch(ctx))
+		if k.GetUnbondingEpochCValue(ctx, unbondingEpoch).EpochNumber == unbondingEpoch {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeLiquidUnstake, "unbonding epoch already undelegated"), nil, nil
+		}
+
+		hostChainParams := k.GetHostChainParams(ctx)
+		undelegatable := sdk.NewDecFromInt(k.GetDelegationState(ctx).TotalDelegations(hostChainParams.BaseDenom).Amount).
+			Mul(k.GetCValue(ctx)).TruncateInt()
+		if undelegation, err := k.GetHostAccountUndelegationForEpoch(ctx, unbondingEpoch); err == nil {
+			undelegatable = undelegatable.Sub(undelegation.TotalUndelegationAmount.Amount)
+		}
+		if !undelegatable.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeLiquidUnstake, "insufficient host chain delegations"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		account := ak.GetAccount(ctx, simAccount.Address)
+		delegator := account.GetAddress()
+		spendable := bk.SpendableCoins(ctx, delegator)
+
+		unstakingAmt := randomAmount(r, sdk.MinInt(spendable.AmountOf(hostChainParams.MintDenom), undelegatable))
+		if !unstakingAmt.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeLiquidUnstake, "insufficient funds"), nil, nil
+		}
+
+		msg := types.NewMsgLiquidUnstake(delegator, sdk.NewCoin(hostChainParams.MintDenom, unstakingAmt))
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+		return simulation.GenAndDeliverTx(txCtx, Fees)
+	}
+}
+
+// SimulateMsgRedeem generates a MsgRedeem with random values, bounded by the deposits not yet relayed to the host
+// chain.
+func SimulateMsgRedeem(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation { //nolint: interfacer
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		if !k.GetModuleState(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeRedeem, "module is disabled"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		account := ak.GetAccount(ctx, simAccount.Address)
+		delegator := account.GetAddress()
+		spendable := bk.SpendableCoins(ctx, delegator)
+
+		hostChainParams := k.GetHostChainParams(ctx)
+		redeemAmt := randomAmount(r, spendable.AmountOf(hostChainParams.MintDenom))
+		if !redeemAmt.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeRedeem, "insufficient funds"), nil, nil
+		}
+
+		// the deposit account pays out the redeemed tokens, less the redemption fee
+		redeemFee := hostChainParams.EstakeParams.EstakeRedemptionFee.MulInt(redeemAmt).TruncateInt()
+		redeemToken, _ := k.ConvertStkToToken(ctx, sdk.NewDecCoin(hostChainParams.MintDenom, redeemAmt.Sub(redeemFee)), k.GetCValue(ctx))
+		if redeemToken.Amount.GTE(k.GetDepositAccountAmount(ctx)) {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeRedeem, "insufficient deposits"), nil, nil
+		}
+
+		msg := types.NewMsgRedeem(delegator, sdk.NewCoin(hostChainParams.MintDenom, redeemAmt))
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+		return simulation.GenAndDeliverTx(txCtx, Fees)
+	}
+}
+
+// SimulateMsgClaim generates a MsgClaim for a delegator with matured or failed unbonding epoch entries.
+func SimulateMsgClaim(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation { //nolint: interfacer
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		if !k.GetModuleState(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeClaim, "module is disabled"), nil, nil
+		}
+
+		var claimable []types.DelegatorUnbondingEpochEntry
+		for _, entry := range k.IterateAllDelegatorUnbondingEpochEntry(ctx) {
+			cValue := k.GetUnbondingEpochCValue(ctx, entry.EpochNumber)
+			if cValue.IsMatured || cValue.IsFailed {
+				claimable = append(claimable, entry)
+			}
+		}
+		if len(claimable) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeClaim, "no claimable unbonding entries"), nil, nil
+		}
+
+		entry := claimable[r.Intn(len(claimable))]
+		simAccount, found := FindAccount(accs, entry.DelegatorAddress)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeClaim, "delegator is not a simulation account"), nil, nil
+		}
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		msg := types.NewMsgClaim(account.GetAddress(), uint32(r.Intn(len(claimable)+1)))
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+		return simulation.GenAndDeliverTx(txCtx, Fees)
+	}
+}
+
+// SimulateHostChain mocks the ICA, ICQ and transfer layer of the host chain, there being no counterparty in the
+// simulation. It applies the state transitions of the acknowledgements the host chain would relay back:
+// deposits are transferred to the host delegation account, restaked rewards are added to it, its balance is
+// delegated, and the undelegation of the current unbonding epoch is completed before the epoch hook starts it.
+func SimulateHostChain(bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		if !k.GetModuleState(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, TypeHostChain, "module is disabled"), nil, nil
+		}
+
+		hostChainParams := k.GetHostChainParams(ctx)
+		if err := transferDeposits(ctx, bk, k, hostChainParams); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeHostChain, "failed to transfer deposits"), nil, err
+		}
+		if err := restakeRewards(r, ctx, bk, k, hostChainParams); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeHostChain, "failed to restake rewards"), nil, err
+		}
+		if err := delegate(ctx, k, hostChainParams); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeHostChain, "failed to delegate"), nil, err
+		}
+		if err := undelegate(ctx, bk, k, hostChainParams); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeHostChain, "failed to undelegate"), nil, err
+		}
+
+		return simtypes.NewOperationMsgBasic(types.ModuleName, TypeHostChain, "", true, nil), nil, nil
+	}
+}
+
+// transferDeposits moves the deposits to the host delegation account, burning the ibc vouchers.
+func transferDeposits(ctx sdk.Context, bk types.BankKeeper, k keeper.Keeper, hostChainParams types.HostChainParams) error {
+	deposits := sdk.NewCoin(k.GetIBCDenom(ctx), k.GetDepositAccountAmount(ctx))
+	if !deposits.IsPositive() {
+		return nil
+	}
+	if err := bk.SendCoinsFromModuleToModule(ctx, types.DepositModuleAccount, types.ModuleName, sdk.NewCoins(deposits)); err != nil {
+		return err
+	}
+	if err := bk.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(deposits)); err != nil {
+		return err
+	}
+	k.AddBalanceToDelegationState(ctx, sdk.NewCoin(hostChainParams.BaseDenom, deposits.Amount))
+	return nil
+}
+
+// restakeRewards adds random rewards, capped by RestakeCapPerDay, to the host delegation account and mints the
+// restake fee, as the acknowledgement of the rewards MsgSend does.
+func restakeRewards(r *rand.Rand, ctx sdk.Context, bk types.BankKeeper, k keeper.Keeper, hostChainParams types.HostChainParams) error {
+	rewards := randomAmount(r, types.RestakeCapPerDay.MulInt(k.GetStakedAmount(ctx)).TruncateInt())
+	if !rewards.IsPositive() {
+		return nil
+	}
+
+	cValue := k.GetCValue(ctx)
+	k.AddBalanceToDelegationState(ctx, sdk.NewCoin(hostChainParams.BaseDenom, rewards))
+
+	estakeFeeAmount := hostChainParams.EstakeParams.EstakeRestakeFee.MulInt(rewards)
+	protocolFee, _ := k.ConvertTokenToStk(ctx, sdk.NewDecCoinFromDec(hostChainParams.BaseDenom, estakeFeeAmount), cValue)
+	if protocolFee.IsPositive() {
+		if err := bk.MintCoins(ctx, types.ModuleName, sdk.NewCoins(protocolFee)); err != nil {
+			return err
+		}
+		if err := k.SendProtocolFee(ctx, sdk.NewCoins(protocolFee), types.ModuleName, hostChainParams.EstakeParams.EstakeFeeAddress); err != nil {
+			return err
+		}
+	}
+	k.AfterCValueChange(ctx, cValue, k.GetCValue(ctx))
+	return nil
+}
+
+// delegate delegates the host delegation account balance, as the acknowledgement of the MsgDelegates does.
+func delegate(ctx sdk.Context, k keeper.Keeper, hostChainParams types.HostChainParams) error {
+	amount := k.GetHostDelegationAccountAmount(ctx)
+	if !amount.IsPositive() || len(k.GetAllowListedValidators(ctx).AllowListedValidators) == 0 {
+		return nil
+	}
+
+	msgs, err := k.DelegateMsgs(ctx, amount, hostChainParams.BaseDenom, k.GetDelegationState(ctx))
+	if err != nil {
+		return err
+	}
+	for _, msg := range msgs {
+		delegateMsg := msg.(*stakingtypes.MsgDelegate)
+		k.AddHostAccountDelegation(ctx, types.NewHostAccountDelegation(delegateMsg.ValidatorAddress, delegateMsg.Amount))
+	}
+	k.RemoveBalanceFromDelegationState(ctx, sdk.NewCoins(sdk.NewCoin(hostChainParams.BaseDenom, amount)))
+	return nil
+}
+
+// undelegate undelegates the liquid unstakings of the current unbonding epoch before the epoch hook does. The
+// host chain unbonds them instantly and transfers them back, so the unbonding epoch c value is matured at once
+// and its stk tokens are burnt.
+func undelegate(ctx sdk.Context, bk types.BankKeeper, k keeper.Keeper, hostChainParams types.HostChainParams) error {
+	epochNumber := k.GetCurrentUndelegationEpoch(ctx)
+	if epochNumber%types.UndelegationEpochNumberFactor != 0 {
+		return nil
+	}
+	undelegation, err := k.GetHostAccountUndelegationForEpoch(ctx, epochNumber)
+	if err != nil {
+		return nil
+	}
+
+	amountToUnstake, _ := k.ConvertStkToToken(ctx, sdk.NewDecCoinFromCoin(undelegation.TotalUndelegationAmount), k.GetCValue(ctx))
+	if !amountToUnstake.IsPositive() {
+		return nil
+	}
+	msgs, entries, err := k.UndelegateMsgs(ctx, amountToUnstake.Amount, hostChainParams.BaseDenom, k.GetDelegationState(ctx))
+	if err != nil {
+		// left to the epoch hook, which carries the unbonding epoch over or fails it
+		return nil
+	}
+	undelegated := sdk.ZeroInt()
+	for _, entry := range entries {
+		undelegated = undelegated.Add(entry.Amount.Amount)
+	}
+	if undelegated.LT(amountToUnstake.Amount) {
+		// left to the epoch hook, which carries the rest over to the next undelegation epoch
+		return nil
+	}
+	for _, msg := range msgs {
+		undelegateMsg := msg.(*stakingtypes.MsgUndelegate)
+		err = k.SubtractHostAccountDelegation(ctx, types.NewHostAccountDelegation(undelegateMsg.ValidatorAddress, undelegateMsg.Amount))
+		if err != nil {
+			return err
+		}
+	}
+
+	stkBurn := sdk.NewCoins(undelegation.TotalUndelegationAmount)
+	if err = bk.SendCoinsFromModuleToModule(ctx, types.UndelegationModuleAccount, types.ModuleName, stkBurn); err != nil {
+		return err
+	}
+	if err = bk.BurnCoins(ctx, types.ModuleName, stkBurn); err != nil {
+		return err
+	}
+
+	unbonded := sdk.NewCoins(amountToUnstake)
+	if err = bk.MintCoins(ctx, types.ModuleName, unbonded); err != nil {
+		return err
+	}
+	if err = bk.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.UndelegationModuleAccount, unbonded); err != nil {
+		return err
+	}
+
+	k.SetUnbondingEpochCValue(ctx, types.UnbondingEpochCValue{
+		EpochNumber:    epochNumber,
+		STKBurn:        undelegation.TotalUndelegationAmount,
+		AmountUnbonded: sdk.NewCoin(hostChainParams.BaseDenom, amountToUnstake.Amount),
+	})
+	if err = k.MatureUnbondingEpochCValue(ctx, epochNumber); err != nil {
+		return err
+	}
+	return k.RemoveHostAccountUndelegation(ctx, epochNumber)
+}
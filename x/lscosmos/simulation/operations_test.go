@@ -0,0 +1,151 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	chain "github.com/merlin-network/estake-native/v2/app"
+	testhelpers "github.com/merlin-network/estake-native/v2/app/helpers"
+	"github.com/merlin-network/estake-native/v2/app/params"
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/simulation"
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+// TestWeightedOperations tests the weights of the operations.
+func TestWeightedOperations(t *testing.T) {
+	app, ctx := createTestApp(t, false)
+
+	cdc := types.ModuleCdc
+	appParams := make(simtypes.AppParams)
+
+	weightedOps := simulation.WeightedOperations(appParams, cdc, app.AccountKeeper, app.BankKeeper, app.LSCosmosKeeper)
+
+	s := rand.NewSource(2)
+	r := rand.New(s)
+	accs := getTestingAccounts(t, r, app, ctx, 10)
+	setupHostChain(t, app, ctx, accs)
+
+	expected := []struct {
+		weight     int
+		opMsgRoute string
+		opMsgName  string
+	}{
+		{params.DefaultWeightMsgLiquidStake, types.ModuleName, types.MsgTypeLiquidStake},
+		{params.DefaultWeightMsgLiquidUnstake, types.ModuleName, types.MsgTypeLiquidUnstake},
+		{params.DefaultWeightMsgRedeem, types.ModuleName, types.MsgTypeRedeem},
+		{params.DefaultWeightMsgClaim, types.ModuleName, types.MsgTypeClaim},
+		{params.DefaultWeightHostChain, types.ModuleName, simulation.TypeHostChain},
+	}
+
+	for i, w := range weightedOps {
+		operationMsg, _, err := w.Op()(r, app.BaseApp, ctx, accs, ctx.ChainID())
+		require.NoError(t, err)
+		// the following checks are very much dependent from the ordering of the output given
+		// by WeightedOperations. if the ordering in WeightedOperations changes some tests
+		// will fail
+		require.Equal(t, expected[i].weight, w.Weight(), "weight should be the same")
+		require.Equal(t, expected[i].opMsgRoute, operationMsg.Route, "route should be the same")
+		require.Equal(t, expected[i].opMsgName, operationMsg.Name, "operation Msg name should be the same")
+	}
+}
+
+// TestSimulateHostChain tests the mocked host chain relays the deposits and delegates them without changing the
+// c value.
+func TestSimulateHostChain(t *testing.T) {
+	app, ctx := createTestApp(t, false)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accs := getTestingAccounts(t, r, app, ctx, 3)
+	setupHostChain(t, app, ctx, accs)
+
+	op := simulation.SimulateMsgLiquidStake(app.AccountKeeper, app.BankKeeper, app.LSCosmosKeeper)
+	operationMsg, _, err := op(r, app.BaseApp, ctx, accs, ctx.ChainID())
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+
+	deposits := app.LSCosmosKeeper.GetDepositAccountAmount(ctx)
+	require.True(t, deposits.IsPositive())
+	cValue := app.LSCosmosKeeper.GetCValue(ctx)
+
+	op = simulation.SimulateHostChain(app.BankKeeper, app.LSCosmosKeeper)
+	operationMsg, _, err = op(r, app.BaseApp, ctx, accs, ctx.ChainID())
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+
+	require.True(t, app.LSCosmosKeeper.GetDepositAccountAmount(ctx).IsZero())
+	require.True(t, app.LSCosmosKeeper.GetHostDelegationAccountAmount(ctx).IsZero())
+	require.Equal(t, deposits, app.LSCosmosKeeper.GetStakedAmount(ctx))
+	require.Equal(t, cValue, app.LSCosmosKeeper.GetCValue(ctx))
+}
+
+func createTestApp(t *testing.T, isCheckTx bool) (*chain.EstakeApp, sdk.Context) {
+	app := testhelpers.Setup(t, isCheckTx, 5)
+
+	ctx := app.BaseApp.NewContext(isCheckTx, tmproto.Header{})
+
+	return app, ctx
+}
+
+func getTestingAccounts(t *testing.T, r *rand.Rand, app *chain.EstakeApp, ctx sdk.Context, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	initAmt := app.StakingKeeper.TokensFromConsensusPower(ctx, 100_000_000)
+	initCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initAmt))
+
+	// add coins to the accounts
+	for _, account := range accounts {
+		acc := app.AccountKeeper.NewAccountWithAddress(ctx, account.Address)
+		app.AccountKeeper.SetAccount(ctx, acc)
+		err := testutil.FundAccount(app.BankKeeper, ctx, account.Address, initCoins)
+		require.NoError(t, err)
+	}
+
+	return accounts
+}
+
+// setupHostChain enables the module with the host chain mocked by the simulation, allow listing validators
+// derived from the first two accounts.
+func setupHostChain(t *testing.T, app *chain.EstakeApp, ctx sdk.Context, accounts []simtypes.Account) {
+	app.LSCosmosKeeper.SetHostChainParams(ctx, types.HostChainParams{
+		ChainID:         simulation.HostChainID,
+		ConnectionID:    simulation.ConnectionID,
+		TransferChannel: simulation.TransferChannel,
+		TransferPort:    simulation.TransferPort,
+		BaseDenom:       simulation.BaseDenom,
+		MintDenom:       simulation.MintDenom,
+		MinDeposit:      sdk.NewInt(5),
+		EstakeParams: types.EstakeParams{
+			EstakeDepositFee:    sdk.MustNewDecFromStr("0.01"),
+			EstakeRestakeFee:    sdk.MustNewDecFromStr("0.02"),
+			EstakeUnstakeFee:    sdk.MustNewDecFromStr("0.03"),
+			EstakeRedemptionFee: sdk.MustNewDecFromStr("0.04"),
+			EstakeFeeAddress:    accounts[0].Address.String(),
+		},
+	})
+
+	var vals []types.AllowListedValidator
+	for _, account := range accounts[:2] {
+		valAddr, err := types.Bech32FromValAddress(sdk.ValAddress(account.Address), types.CosmosValOperPrefix)
+		require.NoError(t, err)
+		vals = append(vals, types.AllowListedValidator{ValidatorAddress: valAddr, TargetWeight: sdk.NewDecWithPrec(5, 1)})
+	}
+	app.LSCosmosKeeper.SetAllowListedValidators(ctx, types.AllowListedValidators{AllowListedValidators: vals})
+
+	delegationAddr, err := bech32.ConvertAndEncode("cosmos", accounts[0].Address)
+	require.NoError(t, err)
+	app.LSCosmosKeeper.SetDelegationState(ctx, types.DelegationState{HostChainDelegationAddress: delegationAddr})
+
+	app.TransferKeeper.SetDenomTrace(ctx, ibctransfertypes.ParseDenomTrace(
+		ibctransfertypes.GetPrefixedDenom(simulation.TransferPort, simulation.TransferChannel, simulation.BaseDenom),
+	))
+	app.LSCosmosKeeper.SetModuleState(ctx, true)
+}
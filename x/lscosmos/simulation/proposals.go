@@ -0,0 +1,110 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/merlin-network/estake-native/v2/app/params"
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/keeper"
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+// Simulation operation weights constants.
+//
+//nolint:gosec
+const (
+	OpWeightSimulateMinDepositAndFeeChangeProposal        = "op_weight_min_deposit_and_fee_change_proposal"
+	OpWeightSimulateEstakeFeeAddressChangeProposal        = "op_weight_estake_fee_address_change_proposal"
+	OpWeightSimulateAllowListedValidatorSetChangeProposal = "op_weight_allow_listed_validator_set_change_proposal"
+)
+
+// ProposalContents defines the module weighted proposals' contents
+func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightSimulateMinDepositAndFeeChangeProposal,
+			params.DefaultWeightMinDepositAndFeeChangeProposal,
+			SimulateMinDepositAndFeeChangeProposal(k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSimulateEstakeFeeAddressChangeProposal,
+			params.DefaultWeightEstakeFeeAddressChangeProposal,
+			SimulateEstakeFeeAddressChangeProposal(k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSimulateAllowListedValidatorSetChangeProposal,
+			params.DefaultWeightAllowListedValidatorSetChangeProposal,
+			SimulateAllowListedValidatorSetChangeProposal(k),
+		),
+	}
+}
+
+// SimulateMinDepositAndFeeChangeProposal generates random min deposit and fee change proposal content.
+func SimulateMinDepositAndFeeChangeProposal(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		if !k.GetModuleState(ctx) {
+			return nil
+		}
+
+		return types.NewMinDepositAndFeeChangeProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			genMinDeposit(r),
+			genFee(r, types.MaxEstakeDepositFee),
+			genFee(r, types.MaxEstakeRestakeFee),
+			genFee(r, types.MaxEstakeUnstakeFee),
+			genFee(r, types.MaxEstakeRedemptionFee),
+		)
+	}
+}
+
+// SimulateEstakeFeeAddressChangeProposal generates random estake fee address change proposal content.
+func SimulateEstakeFeeAddressChangeProposal(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		if !k.GetModuleState(ctx) {
+			return nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		return types.NewEstakeFeeAddressChangeProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			simAccount.Address.String(),
+		)
+	}
+}
+
+// SimulateAllowListedValidatorSetChangeProposal generates allow listed validator set change proposal content
+// reweighting the current allow listed validators, and randomly adding a new or dropping one of them.
+func SimulateAllowListedValidatorSetChangeProposal(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		if !k.GetModuleState(ctx) {
+			return nil
+		}
+
+		var valAddrs []string
+		for _, val := range k.GetAllowListedValidators(ctx).AllowListedValidators {
+			valAddrs = append(valAddrs, val.ValidatorAddress)
+		}
+		switch {
+		case len(valAddrs) < MaxAllowListedVals && r.Intn(2) == 0:
+			valAddrs = append(valAddrs, genHostChainAddress(r, types.CosmosValOperPrefix))
+		case len(valAddrs) > 1 && r.Intn(2) == 0:
+			i := r.Intn(len(valAddrs))
+			valAddrs = append(valAddrs[:i], valAddrs[i+1:]...)
+		}
+
+		allowListedValidators := genAllowListedValidators(r)
+		if len(valAddrs) > 0 {
+			allowListedValidators = genTargetWeights(r, valAddrs)
+		}
+		return types.NewAllowListedValidatorSetChangeProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			allowListedValidators,
+		)
+	}
+}
@@ -0,0 +1,58 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/merlin-network/estake-native/v2/app/params"
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/simulation"
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+func TestProposalContents(t *testing.T) {
+	app, ctx := createTestApp(t, false)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	accounts := getTestingAccounts(t, r, app, ctx, 10)
+
+	// execute ProposalContents function
+	weightedProposalContent := simulation.ProposalContents(app.LSCosmosKeeper)
+	require.Len(t, weightedProposalContent, 3)
+
+	w0 := weightedProposalContent[0]
+	w1 := weightedProposalContent[1]
+	w2 := weightedProposalContent[2]
+
+	// tests w0 interface:
+	require.Equal(t, simulation.OpWeightSimulateMinDepositAndFeeChangeProposal, w0.AppParamsKey())
+	require.Equal(t, params.DefaultWeightMinDepositAndFeeChangeProposal, w0.DefaultWeight())
+
+	// tests w1 interface:
+	require.Equal(t, simulation.OpWeightSimulateEstakeFeeAddressChangeProposal, w1.AppParamsKey())
+	require.Equal(t, params.DefaultWeightEstakeFeeAddressChangeProposal, w1.DefaultWeight())
+
+	// tests w2 interface:
+	require.Equal(t, simulation.OpWeightSimulateAllowListedValidatorSetChangeProposal, w2.AppParamsKey())
+	require.Equal(t, params.DefaultWeightAllowListedValidatorSetChangeProposal, w2.DefaultWeight())
+
+	// no proposals while the module is disabled
+	for _, w := range weightedProposalContent {
+		require.Nil(t, w.ContentSimulatorFn()(r, ctx, accounts))
+	}
+
+	setupHostChain(t, app, ctx, accounts)
+	for _, w := range weightedProposalContent {
+		content := w.ContentSimulatorFn()(r, ctx, accounts)
+		require.NotNil(t, content)
+		require.Equal(t, types.RouterKey, content.ProposalRoute())
+		require.NoError(t, content.ValidateBasic())
+	}
+
+	content := w2.ContentSimulatorFn()(r, ctx, accounts)
+	allowListedValidators := content.(*types.AllowListedValidatorSetChangeProposal).AllowListedValidators
+	require.True(t, allowListedValidators.Valid())
+}
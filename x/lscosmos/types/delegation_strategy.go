@@ -28,6 +28,15 @@ type (
 	ValAddressAmounts      []ValAddressAmount
 )
 
+// DelegationStrategy divides an amount to be delegated or undelegated across the validator set.
+// CONTRACT: valList contains every validator of the delegation state, zero weighted if not allow listed.
+type DelegationStrategy interface {
+	// Delegate returns the amount to be delegated to each validator
+	Delegate(valList AllowListedValidators, delegationState DelegationState, amount sdk.Coin) (ValAddressAmounts, error)
+	// Undelegate returns the amount to be undelegated from each validator
+	Undelegate(valList AllowListedValidators, delegationState DelegationState, amount sdk.Coin) (ValAddressAmounts, error)
+}
+
 // NewWeightedAddressAmount returns WeightedAddressAmount struct populated with given details
 func NewWeightedAddressAmount(address string, weight sdk.Dec, coin sdk.Coin, unbondingTokens sdk.Coin) WeightedAddressAmount {
 	return WeightedAddressAmount{
@@ -39,4 +39,7 @@ var (
 	ErrInvalidMintDenom                      = errorsmod.Register(ModuleName, 89, "InvalidMintDenom, MintDenom should be stk/BaseDenom")
 	ErrModuleNotInitialised                  = errorsmod.Register(ModuleName, 90, "ErrModuleNotInitialised, Module was never initialised")
 	ErrModuleAlreadyInExpectedState          = errorsmod.Register(ModuleName, 91, "ModuleAlreadyInExpectedState, Module is already in expected state")
+	ErrInvalidDelegationStrategy             = errorsmod.Register(ModuleName, 92, "invalid delegation strategy")
+	ErrHostMaxEntriesReached                 = errorsmod.Register(ModuleName, 93, "validators have reached host chain max unbonding entries")
+	ErrInvalidGenesis                        = errorsmod.Register(ModuleName, 94, "invalid genesis state")
 )
@@ -1,5 +1,14 @@
 package types
 
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -18,6 +27,8 @@ func DefaultGenesis() *GenesisState {
 			DelegatorAccountOwnerID: DelegationModuleAccount,
 			RewardsAccountOwnerID:   RewardModuleAccount,
 		},
+		HostMaxEntries: DefaultHostMaxEntries,
+		ICATxSendTimes: nil,
 	}
 }
 
@@ -36,5 +47,146 @@ func (gs GenesisState) Validate() error {
 	if err != nil {
 		return err
 	}
+	if !gs.HostChainParams.IsEmpty() {
+		if gs.HostChainParams.BaseDenom == gs.HostChainParams.MintDenom {
+			return ErrEqualBaseAndMintDenom
+		}
+		err = gs.HostChainParams.EstakeParams.Validate()
+		if err != nil {
+			return err
+		}
+	}
+	err = gs.validateUnbondings()
+	if err != nil {
+		return err
+	}
+	err = gs.validateIBCAmountTransientStore()
+	if err != nil {
+		return err
+	}
+	err = gs.validateICATxSendTimes()
+	if err != nil {
+		return err
+	}
 	return gs.Params.Validate()
 }
+
+// validateUnbondings checks that every delegator unbonding entry references an unbonding epoch, either still
+// pending on the host chain or with a c value, and that the entries of pending epochs add up to their total.
+func (gs GenesisState) validateUnbondings() error {
+	cValueEpochs := make(map[int64]bool)
+	for _, cValue := range gs.UnbondingEpochCValues {
+		if cValueEpochs[cValue.EpochNumber] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate unbonding epoch c value for epoch %d", cValue.EpochNumber)
+		}
+		if cValue.IsMatured && cValue.IsFailed {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "unbonding epoch c value for epoch %d is both matured and failed", cValue.EpochNumber)
+		}
+		cValueEpochs[cValue.EpochNumber] = true
+	}
+
+	pendingUndelegations := make(map[int64]sdk.Coin)
+	undelegationEpochs := make(map[int64]bool)
+	for _, undelegation := range gs.DelegationState.HostAccountUndelegations {
+		if undelegationEpochs[undelegation.EpochNumber] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate host account undelegation for epoch %d", undelegation.EpochNumber)
+		}
+		undelegationEpochs[undelegation.EpochNumber] = true
+		if !cValueEpochs[undelegation.EpochNumber] {
+			pendingUndelegations[undelegation.EpochNumber] = undelegation.TotalUndelegationAmount
+		}
+	}
+
+	entries := make(map[string]bool)
+	pendingEntriesTotal := make(map[int64]sdk.Coin)
+	for _, entry := range gs.DelegatorUnbondingEpochEntries {
+		_, err := sdk.AccAddressFromBech32(entry.DelegatorAddress)
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "invalid delegator unbonding epoch entry address %s: %s", entry.DelegatorAddress, err)
+		}
+		key := fmt.Sprintf("%s/%d", entry.DelegatorAddress, entry.EpochNumber)
+		if entries[key] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate delegator unbonding epoch entry for %s in epoch %d", entry.DelegatorAddress, entry.EpochNumber)
+		}
+		entries[key] = true
+		if !entry.Amount.IsValid() || (!gs.HostChainParams.IsEmpty() && entry.Amount.Denom != gs.HostChainParams.MintDenom) {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "invalid delegator unbonding epoch entry amount %s", entry.Amount)
+		}
+		if !cValueEpochs[entry.EpochNumber] && !undelegationEpochs[entry.EpochNumber] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "delegator unbonding epoch entry references non existent epoch %d", entry.EpochNumber)
+		}
+		if undelegation, ok := pendingUndelegations[entry.EpochNumber]; ok {
+			if entry.Amount.Denom != undelegation.Denom {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "delegator unbonding epoch entry amount %s is not in denom %s", entry.Amount, undelegation.Denom)
+			}
+			total, ok := pendingEntriesTotal[entry.EpochNumber]
+			if !ok {
+				total = sdk.NewCoin(undelegation.Denom, sdk.ZeroInt())
+			}
+			pendingEntriesTotal[entry.EpochNumber] = total.Add(entry.Amount)
+		}
+	}
+
+	for epochNumber, undelegation := range pendingUndelegations {
+		totalAmount := sdk.ZeroInt()
+		if total, ok := pendingEntriesTotal[epochNumber]; ok {
+			totalAmount = total.Amount
+		}
+		if !totalAmount.Equal(undelegation.Amount) {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "delegator unbonding epoch entries of epoch %d do not add up to the host account undelegation %s", epochNumber, undelegation)
+		}
+	}
+	return nil
+}
+
+// validateIBCAmountTransientStore checks the in transit amounts are denominated in the ibc denom of the host chain
+// and that the undelegations transferred back reference an unbonding epoch c value.
+func (gs GenesisState) validateIBCAmountTransientStore() error {
+	transientStore := gs.IBCAmountTransientStore
+	err := transientStore.IBCTransfer.Validate()
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidGenesis, "invalid ibc transfer transient amount: %s", err)
+	}
+	if !gs.HostChainParams.IsEmpty() {
+		ibcDenom := ibctransfertypes.ParseDenomTrace(
+			ibctransfertypes.GetPrefixedDenom(gs.HostChainParams.TransferPort, gs.HostChainParams.TransferChannel, gs.HostChainParams.BaseDenom),
+		).IBCDenom()
+		for _, coin := range transientStore.IBCTransfer {
+			if coin.Denom != ibcDenom {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "ibc transfer transient amount %s is not in denom %s", coin, ibcDenom)
+			}
+		}
+		// an ica delegate amount that was never set is an empty coin
+		if transientStore.ICADelegate.Denom != "" && transientStore.ICADelegate.Denom != gs.HostChainParams.BaseDenom {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "ica delegate transient amount %s is not in denom %s", transientStore.ICADelegate, gs.HostChainParams.BaseDenom)
+		}
+	}
+
+	cValueEpochs := make(map[int64]bool)
+	for _, cValue := range gs.UnbondingEpochCValues {
+		cValueEpochs[cValue.EpochNumber] = true
+	}
+	for _, undelegationTransfer := range transientStore.UndelegatonCompleteIBCTransfer {
+		if !cValueEpochs[undelegationTransfer.EpochNumber] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "undelegation transfer transient amount references epoch %d without c value", undelegationTransfer.EpochNumber)
+		}
+	}
+	return nil
+}
+
+// validateICATxSendTimes checks the in flight ica txs are unique and sent on valid ports
+func (gs GenesisState) validateICATxSendTimes() error {
+	icaTxs := make(map[string]bool)
+	for _, icaTxSendTime := range gs.ICATxSendTimes {
+		err := host.PortIdentifierValidator(icaTxSendTime.PortID)
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "invalid ica tx port id: %s", err)
+		}
+		key := fmt.Sprintf("%s/%d", icaTxSendTime.PortID, icaTxSendTime.Sequence)
+		if icaTxs[key] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate ica tx send time for port %s and sequence %d", icaTxSendTime.PortID, icaTxSendTime.Sequence)
+		}
+		icaTxs[key] = true
+	}
+	return nil
+}
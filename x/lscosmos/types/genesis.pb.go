@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -36,6 +40,8 @@ type GenesisState struct {
 	UnbondingEpochCValues          []UnbondingEpochCValue         `protobuf:"bytes,8,rep,name=unbonding_epoch_c_values,json=unbondingEpochCValues,proto3" json:"unbonding_epoch_c_values"`
 	DelegatorUnbondingEpochEntries []DelegatorUnbondingEpochEntry `protobuf:"bytes,9,rep,name=delegator_unbonding_epoch_entries,json=delegatorUnbondingEpochEntries,proto3" json:"delegator_unbonding_epoch_entries"`
 	HostAccounts                   HostAccounts                   `protobuf:"bytes,10,opt,name=host_accounts,json=hostAccounts,proto3" json:"host_accounts"`
+	HostMaxEntries                 uint32                         `protobuf:"varint,11,opt,name=host_max_entries,json=hostMaxEntries,proto3" json:"host_max_entries,omitempty"`
+	ICATxSendTimes                 []ICATxSendTime                `protobuf:"bytes,12,rep,name=i_c_a_tx_send_times,json=iCATxSendTimes,proto3" json:"i_c_a_tx_send_times"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -141,8 +147,84 @@ func (m *GenesisState) GetHostAccounts() HostAccounts {
 	return HostAccounts{}
 }
 
+func (m *GenesisState) GetHostMaxEntries() uint32 {
+	if m != nil {
+		return m.HostMaxEntries
+	}
+	return 0
+}
+
+func (m *GenesisState) GetICATxSendTimes() []ICATxSendTime {
+	if m != nil {
+		return m.ICATxSendTimes
+	}
+	return nil
+}
+
+// ICATxSendTime defines the send time of an ica tx pending an acknowledgement
+type ICATxSendTime struct {
+	PortID   string    `protobuf:"bytes,1,opt,name=port_i_d,json=portID,proto3" json:"port_i_d,omitempty"`
+	Sequence uint64    `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	SendTime time.Time `protobuf:"bytes,3,opt,name=send_time,json=sendTime,proto3,stdtime" json:"send_time"`
+}
+
+func (m *ICATxSendTime) Reset()         { *m = ICATxSendTime{} }
+func (m *ICATxSendTime) String() string { return proto.CompactTextString(m) }
+func (*ICATxSendTime) ProtoMessage()    {}
+func (*ICATxSendTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e8a773682684460, []int{1}
+}
+func (m *ICATxSendTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ICATxSendTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ICATxSendTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ICATxSendTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICATxSendTime.Merge(m, src)
+}
+func (m *ICATxSendTime) XXX_Size() int {
+	return m.Size()
+}
+func (m *ICATxSendTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICATxSendTime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ICATxSendTime proto.InternalMessageInfo
+
+func (m *ICATxSendTime) GetPortID() string {
+	if m != nil {
+		return m.PortID
+	}
+	return ""
+}
+
+func (m *ICATxSendTime) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ICATxSendTime) GetSendTime() time.Time {
+	if m != nil {
+		return m.SendTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "estake.lscosmos.v1beta1.GenesisState")
+	proto.RegisterType((*ICATxSendTime)(nil), "estake.lscosmos.v1beta1.ICATxSendTime")
 }
 
 func init() {
@@ -150,43 +232,52 @@ func init() {
 }
 
 var fileDescriptor_8e8a773682684460 = []byte{
-	// 577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x4f, 0x6f, 0xd3, 0x4c,
-	0x10, 0x87, 0xe3, 0xb7, 0x7d, 0xdb, 0xb2, 0x6d, 0xf9, 0x63, 0x01, 0x35, 0x15, 0x72, 0x0b, 0x22,
-	0x28, 0x97, 0xda, 0x24, 0x88, 0x13, 0xe2, 0x90, 0x86, 0x08, 0x90, 0x90, 0xa8, 0x52, 0x5a, 0x89,
-	0x5e, 0x56, 0x6b, 0x7b, 0x64, 0xaf, 0x70, 0x76, 0xad, 0x9d, 0x75, 0x4a, 0xbf, 0x00, 0x67, 0x3e,
-	0x56, 0x25, 0x2e, 0x3d, 0x72, 0x42, 0x28, 0xf9, 0x22, 0xc8, 0x6b, 0x27, 0x34, 0x28, 0x26, 0xb7,
-	0x68, 0xe6, 0xf7, 0xf8, 0xf1, 0xcc, 0x44, 0x26, 0xcd, 0x0c, 0x35, 0xfb, 0x0c, 0x7e, 0x8a, 0xa1,
-	0xc4, 0xa1, 0x44, 0x7f, 0xd4, 0x0e, 0x40, 0xb3, 0xb6, 0x1f, 0x83, 0x00, 0xe4, 0xe8, 0x65, 0x4a,
-	0x6a, 0x69, 0xef, 0x94, 0x31, 0x6f, 0x1a, 0xf3, 0xaa, 0xd8, 0xee, 0xdd, 0x58, 0xc6, 0xd2, 0x64,
-	0xfc, 0xe2, 0x57, 0x19, 0xdf, 0x7d, 0x52, 0xf7, 0xd4, 0x8c, 0x29, 0x36, 0xac, 0x1e, 0xba, 0xfb,
-	0xb4, 0x2e, 0x35, 0xb3, 0x94, 0xb9, 0x76, 0xed, 0x3b, 0xca, 0x11, 0x28, 0xc1, 0x44, 0x08, 0x34,
-	0x53, 0x32, 0x93, 0xc8, 0xd2, 0x12, 0x79, 0xfc, 0x7d, 0x9d, 0x6c, 0xbd, 0x29, 0x27, 0x38, 0xd6,
-	0x4c, 0x83, 0xfd, 0x8a, 0xac, 0x95, 0x6e, 0xc7, 0xda, 0xb7, 0x5a, 0x9b, 0x9d, 0x3d, 0xaf, 0x66,
-	0x22, 0xef, 0xc8, 0xc4, 0x0e, 0x57, 0x2f, 0x7f, 0xee, 0x35, 0x06, 0x15, 0x64, 0x37, 0xc9, 0xcd,
-	0xa1, 0x8c, 0xf2, 0x14, 0x28, 0x08, 0x16, 0xa4, 0x10, 0x39, 0xff, 0xed, 0x5b, 0xad, 0x8d, 0xc1,
-	0x76, 0x59, 0xed, 0x97, 0x45, 0xfb, 0x8c, 0xdc, 0x49, 0x24, 0x6a, 0x1a, 0x26, 0x8c, 0x0b, 0x5a,
-	0x09, 0x57, 0x8c, 0xb0, 0x55, 0x2b, 0x7c, 0x2b, 0x51, 0xf7, 0x0a, 0x60, 0xce, 0x7c, 0x2b, 0x99,
-	0x2f, 0xdb, 0x29, 0xd9, 0x61, 0x69, 0x2a, 0xcf, 0x69, 0xca, 0x51, 0x43, 0x44, 0x47, 0x2c, 0xe5,
-	0x11, 0xd3, 0x52, 0xa1, 0xb3, 0x6a, 0x0c, 0x5e, 0xad, 0xa1, 0x5b, 0x70, 0xef, 0x0d, 0x76, 0x3a,
-	0xa3, 0x2a, 0xcf, 0x3d, 0xb6, 0xa8, 0x69, 0x7f, 0x22, 0xb7, 0x23, 0x48, 0x21, 0x66, 0x9a, 0x4b,
-	0x41, 0xb1, 0xd8, 0xa1, 0xf3, 0xff, 0x92, 0x41, 0x5e, 0xcf, 0x00, 0xb3, 0xf3, 0xe9, 0x20, 0xd1,
-	0x7c, 0xd9, 0xce, 0xc8, 0x83, 0x6b, 0x4b, 0x52, 0x70, 0xce, 0x54, 0x44, 0x59, 0x14, 0x29, 0x40,
-	0x74, 0xd6, 0x8c, 0xc3, 0x5f, 0xbe, 0xac, 0x81, 0xe1, 0xba, 0x25, 0x56, 0xa9, 0xee, 0x27, 0x0b,
-	0xbb, 0x76, 0x4e, 0x1e, 0x72, 0x1a, 0xd0, 0x90, 0xb2, 0xa1, 0xcc, 0x85, 0xa6, 0x5a, 0x31, 0x81,
-	0x1c, 0x84, 0xa6, 0xa8, 0xa5, 0x02, 0x67, 0xdd, 0x48, 0x9f, 0xd5, 0x4a, 0xdf, 0x1d, 0xf6, 0xba,
-	0x86, 0xfc, 0x38, 0x05, 0x8f, 0x0b, 0xae, 0xb2, 0xee, 0xf0, 0xc5, 0x6d, 0x3b, 0x25, 0x4e, 0x2e,
-	0x02, 0x29, 0x22, 0x2e, 0x62, 0x0a, 0x99, 0x0c, 0x13, 0x1a, 0x16, 0x67, 0xcb, 0x01, 0x9d, 0x8d,
-	0xfd, 0x95, 0xd6, 0x66, 0xe7, 0xa0, 0x56, 0x79, 0x32, 0x05, 0xfb, 0x05, 0xd7, 0x3b, 0x2d, 0xa8,
-	0xe9, 0xc5, 0xf2, 0x05, 0x3d, 0xb4, 0xbf, 0x5a, 0xe4, 0x51, 0xb5, 0x6a, 0xa9, 0xe8, 0xdf, 0x62,
-	0x10, 0x5a, 0x71, 0x40, 0xe7, 0x86, 0xf1, 0xbe, 0x58, 0x76, 0x43, 0xa9, 0xe6, 0x5f, 0xa0, 0x2f,
-	0xb4, 0xba, 0xa8, 0xfc, 0x6e, 0x54, 0x9f, 0xe1, 0x80, 0xf6, 0x11, 0xd9, 0x36, 0xf7, 0x65, 0x61,
-	0x58, 0x2c, 0x05, 0x1d, 0x62, 0xd6, 0xdb, 0xfc, 0xe7, 0x4d, 0xbb, 0x55, 0xb8, 0x72, 0x6c, 0x25,
-	0xd7, 0x6b, 0x27, 0x97, 0x63, 0xd7, 0xba, 0x1a, 0xbb, 0xd6, 0xaf, 0xb1, 0x6b, 0x7d, 0x9b, 0xb8,
-	0x8d, 0xab, 0x89, 0xdb, 0xf8, 0x31, 0x71, 0x1b, 0x67, 0x2f, 0x63, 0xae, 0x93, 0x3c, 0xf0, 0x42,
-	0x39, 0xf4, 0x33, 0x50, 0x58, 0xfc, 0x8d, 0x45, 0x08, 0x1f, 0x04, 0xf8, 0xa5, 0xed, 0x40, 0x30,
-	0xcd, 0x47, 0xe0, 0x8f, 0x3a, 0xfe, 0x97, 0x3f, 0x1f, 0x10, 0x7d, 0x91, 0x01, 0x06, 0x6b, 0xe6,
-	0x5b, 0xf1, 0xfc, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x55, 0x01, 0x90, 0xb3, 0x04, 0x05, 0x00,
+	// 721 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcf, 0x4f, 0xdb, 0x48,
+	0x14, 0xc7, 0xe3, 0x85, 0xcd, 0x86, 0x81, 0xb0, 0xac, 0xf7, 0x07, 0xde, 0x68, 0x95, 0x64, 0x51,
+	0x41, 0xb9, 0x60, 0x17, 0xaa, 0x9e, 0xaa, 0x1e, 0x42, 0x40, 0x2d, 0x52, 0x2b, 0xa1, 0x10, 0x90,
+	0xe0, 0x32, 0x9a, 0xd8, 0xaf, 0xce, 0x08, 0x7b, 0xc6, 0x9d, 0x19, 0x87, 0xf0, 0x0f, 0x54, 0x3d,
+	0xf2, 0x67, 0x71, 0xe4, 0xd8, 0x53, 0x5b, 0xc1, 0x3f, 0x52, 0xcd, 0xd8, 0x4e, 0x49, 0x15, 0x97,
+	0x9b, 0xfd, 0xde, 0xf7, 0x3b, 0x9f, 0xf7, 0x43, 0x7a, 0x68, 0x13, 0xa4, 0x22, 0x17, 0xe0, 0x45,
+	0xd2, 0xe7, 0x32, 0xe6, 0xd2, 0x1b, 0xef, 0x0c, 0x41, 0x91, 0x1d, 0x2f, 0x04, 0x06, 0x92, 0x4a,
+	0x37, 0x11, 0x5c, 0x71, 0x7b, 0x3d, 0x93, 0xb9, 0x85, 0xcc, 0xcd, 0x65, 0x8d, 0xbf, 0x42, 0x1e,
+	0x72, 0xa3, 0xf1, 0xf4, 0x57, 0x26, 0x6f, 0xb4, 0x42, 0xce, 0xc3, 0x08, 0x3c, 0xf3, 0x37, 0x4c,
+	0xdf, 0x79, 0x8a, 0xc6, 0xfa, 0x85, 0x38, 0xc9, 0x05, 0x4f, 0xca, 0xb0, 0x09, 0x11, 0x24, 0xce,
+	0xa9, 0x8d, 0xad, 0x32, 0xd5, 0xb4, 0x8c, 0x4c, 0xb7, 0x53, 0xda, 0x04, 0x1f, 0x83, 0x60, 0x84,
+	0xf9, 0x80, 0x13, 0xc1, 0x13, 0x2e, 0x49, 0x94, 0x59, 0x36, 0x6e, 0x6b, 0x68, 0xe5, 0x55, 0xd6,
+	0xe2, 0xb1, 0x22, 0x0a, 0xec, 0x97, 0xa8, 0x9a, 0xb1, 0x1d, 0xab, 0x6d, 0x75, 0x96, 0x77, 0x5b,
+	0x6e, 0x49, 0xcb, 0xee, 0x91, 0x91, 0xed, 0x2d, 0xde, 0x7c, 0x6e, 0x55, 0xfa, 0xb9, 0xc9, 0xde,
+	0x44, 0xab, 0x31, 0x0f, 0xd2, 0x08, 0x30, 0x30, 0x32, 0x8c, 0x20, 0x70, 0x7e, 0x69, 0x5b, 0x9d,
+	0x5a, 0xbf, 0x9e, 0x45, 0x0f, 0xb2, 0xa0, 0x7d, 0x8e, 0xfe, 0x18, 0x71, 0xa9, 0xb0, 0x3f, 0x22,
+	0x94, 0xe1, 0x1c, 0xb8, 0x60, 0x80, 0x9d, 0x52, 0xe0, 0x6b, 0x2e, 0x55, 0x4f, 0x1b, 0x66, 0xc8,
+	0xbf, 0x8f, 0x66, 0xc3, 0x76, 0x84, 0xd6, 0x49, 0x14, 0xf1, 0x4b, 0x1c, 0x51, 0xa9, 0x20, 0xc0,
+	0x63, 0x12, 0xd1, 0x80, 0x28, 0x2e, 0xa4, 0xb3, 0x68, 0x08, 0x6e, 0x29, 0xa1, 0xab, 0x7d, 0x6f,
+	0x8c, 0xed, 0x74, 0xea, 0xca, 0x39, 0x7f, 0x93, 0x79, 0x49, 0xfb, 0x0c, 0xad, 0x05, 0x10, 0x41,
+	0x48, 0x14, 0xe5, 0x0c, 0x4b, 0x3d, 0x43, 0xe7, 0xd7, 0x47, 0x1a, 0xd9, 0x9f, 0x1a, 0xcc, 0xcc,
+	0x8b, 0x46, 0x82, 0xd9, 0xb0, 0x9d, 0xa0, 0x7f, 0x1f, 0x0c, 0x49, 0xc0, 0x25, 0x11, 0x01, 0x26,
+	0x41, 0x20, 0x40, 0x4a, 0xa7, 0x6a, 0x18, 0xde, 0xe3, 0xc3, 0xea, 0x1b, 0x5f, 0x37, 0xb3, 0xe5,
+	0xa8, 0x7f, 0x46, 0x73, 0xb3, 0x76, 0x8a, 0xfe, 0xa3, 0x78, 0x88, 0x7d, 0x4c, 0x62, 0x9e, 0x32,
+	0x85, 0x95, 0x20, 0x4c, 0x52, 0x60, 0x0a, 0x4b, 0xc5, 0x05, 0x38, 0xbf, 0x19, 0xe8, 0xd3, 0x52,
+	0xe8, 0xe1, 0x5e, 0xaf, 0x6b, 0x9c, 0x83, 0xc2, 0x78, 0xac, 0x7d, 0x39, 0x75, 0x9d, 0xce, 0x4f,
+	0xdb, 0x11, 0x72, 0x52, 0x36, 0xe4, 0x2c, 0xa0, 0x2c, 0xc4, 0x90, 0x70, 0x7f, 0x84, 0x7d, 0xbd,
+	0xb6, 0x14, 0xa4, 0x53, 0x6b, 0x2f, 0x74, 0x96, 0x77, 0xb7, 0x4b, 0x91, 0x27, 0x85, 0xf1, 0x40,
+	0xfb, 0x7a, 0xa7, 0xda, 0x55, 0x6c, 0x2c, 0x9d, 0x93, 0x93, 0xf6, 0x07, 0x0b, 0xfd, 0x9f, 0x8f,
+	0x9a, 0x0b, 0xfc, 0x23, 0x18, 0x98, 0x12, 0x14, 0xa4, 0xb3, 0x64, 0xb8, 0xcf, 0x1f, 0xdb, 0x21,
+	0x17, 0xb3, 0x05, 0x1c, 0x30, 0x25, 0xae, 0x72, 0x7e, 0x33, 0x28, 0xd7, 0x50, 0x90, 0xf6, 0x11,
+	0xaa, 0x9b, 0xfd, 0x12, 0xdf, 0xd7, 0x43, 0x91, 0x0e, 0x32, 0xe3, 0xdd, 0xfc, 0xe9, 0x4e, 0xbb,
+	0xb9, 0x38, 0x67, 0xac, 0x8c, 0x1e, 0xc4, 0xec, 0x0e, 0x5a, 0x33, 0x2f, 0xc6, 0x64, 0x32, 0x6d,
+	0x64, 0xb9, 0x6d, 0x75, 0xea, 0xfd, 0x55, 0x1d, 0x7f, 0x4b, 0x26, 0x05, 0xfb, 0x0c, 0xfd, 0x49,
+	0xf5, 0x9e, 0xb1, 0x9a, 0x60, 0x09, 0x2c, 0xc0, 0xe6, 0x32, 0x39, 0x2b, 0xa6, 0xeb, 0xad, 0xf2,
+	0x05, 0xf7, 0xba, 0x83, 0xc9, 0x31, 0xb0, 0x60, 0x40, 0xe3, 0x62, 0xcc, 0xab, 0xf4, 0x61, 0x50,
+	0x6e, 0x7c, 0xb4, 0x50, 0x7d, 0x46, 0x67, 0x3b, 0xa8, 0x96, 0x70, 0xa1, 0x30, 0xc5, 0x81, 0xb9,
+	0x2a, 0x4b, 0xfd, 0xaa, 0xfe, 0x3f, 0xdc, 0xb7, 0x1b, 0xa8, 0x26, 0xe1, 0x7d, 0x0a, 0xcc, 0x07,
+	0x73, 0x28, 0x16, 0xfb, 0xd3, 0x7f, 0xbb, 0x8b, 0x96, 0xa6, 0x95, 0xe5, 0xb7, 0xa1, 0xe1, 0x66,
+	0x07, 0xd5, 0x2d, 0x0e, 0xaa, 0x3b, 0x28, 0x0e, 0xea, 0x5e, 0x4d, 0x17, 0x73, 0xfd, 0xa5, 0x65,
+	0xe9, 0x27, 0xf2, 0x02, 0x4f, 0x6e, 0xee, 0x9a, 0xd6, 0xed, 0x5d, 0xd3, 0xfa, 0x7a, 0xd7, 0xb4,
+	0xae, 0xef, 0x9b, 0x95, 0xdb, 0xfb, 0x66, 0xe5, 0xd3, 0x7d, 0xb3, 0x72, 0xfe, 0x22, 0xa4, 0x6a,
+	0x94, 0x0e, 0x5d, 0x9f, 0xc7, 0x5e, 0x0c, 0x22, 0xa2, 0x6c, 0x9b, 0x81, 0xba, 0xe4, 0xe2, 0xc2,
+	0xcb, 0x7a, 0xdf, 0x66, 0x44, 0xd1, 0x31, 0x78, 0xe3, 0x5d, 0x6f, 0xf2, 0xfd, 0xa0, 0xaa, 0xab,
+	0x04, 0xe4, 0xb0, 0x6a, 0xf0, 0xcf, 0xbe, 0x0d, 0x00, 0xbc, 0x89, 0x93, 0xb5, 0x35, 0x06, 0x00,
 	0x00,
 }
 
@@ -210,6 +301,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ICATxSendTimes) > 0 {
+		for iNdEx := len(m.ICATxSendTimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ICATxSendTimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.HostMaxEntries != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HostMaxEntries))
+		i--
+		dAtA[i] = 0x58
+	}
 	{
 		size, err := m.HostAccounts.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -321,6 +431,49 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ICATxSendTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ICATxSendTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICATxSendTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SendTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SendTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintGenesis(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PortID) > 0 {
+		i -= len(m.PortID)
+		copy(dAtA[i:], m.PortID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -367,6 +520,33 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.HostAccounts.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.HostMaxEntries != 0 {
+		n += 1 + sovGenesis(uint64(m.HostMaxEntries))
+	}
+	if len(m.ICATxSendTimes) > 0 {
+		for _, e := range m.ICATxSendTimes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ICATxSendTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SendTime)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -724,6 +904,193 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostMaxEntries", wireType)
+			}
+			m.HostMaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HostMaxEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ICATxSendTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ICATxSendTimes = append(m.ICATxSendTimes, ICATxSendTime{})
+			if err := m.ICATxSendTimes[len(m.ICATxSendTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ICATxSendTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICATxSendTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICATxSendTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SendTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
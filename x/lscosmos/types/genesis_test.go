@@ -2,34 +2,193 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	delegator := sdk.AccAddress("delegator___________").String()
+	hostChainParams := types.HostChainParams{
+		ChainID:         "cosmoshub-4",
+		ConnectionID:    "connection-0",
+		TransferChannel: "channel-0",
+		TransferPort:    ibctransfertypes.PortID,
+		BaseDenom:       "uatom",
+		MintDenom:       "stk/uatom",
+		MinDeposit:      sdk.NewInt(5),
+		EstakeParams: types.EstakeParams{
+			EstakeDepositFee:    sdk.ZeroDec(),
+			EstakeRestakeFee:    sdk.ZeroDec(),
+			EstakeUnstakeFee:    sdk.ZeroDec(),
+			EstakeRedemptionFee: sdk.ZeroDec(),
+			EstakeFeeAddress:    sdk.AccAddress("fee_address_________").String(),
+		},
+	}
+	ibcDenom := ibctransfertypes.ParseDenomTrace(
+		ibctransfertypes.GetPrefixedDenom(hostChainParams.TransferPort, hostChainParams.TransferChannel, hostChainParams.BaseDenom),
+	).IBCDenom()
+
+	// populated returns a valid genesis state with an epoch pending on the host chain and a matured one
+	populated := func() *types.GenesisState {
+		genState := types.DefaultGenesis()
+		genState.ModuleEnabled = true
+		genState.HostChainParams = hostChainParams
+		genState.DelegationState.HostAccountUndelegations = []types.HostAccountUndelegation{
+			{EpochNumber: 8, TotalUndelegationAmount: sdk.NewInt64Coin(hostChainParams.MintDenom, 100)},
+		}
+		genState.UnbondingEpochCValues = []types.UnbondingEpochCValue{
+			{EpochNumber: 4, STKBurn: sdk.NewInt64Coin(hostChainParams.MintDenom, 10), AmountUnbonded: sdk.NewInt64Coin(hostChainParams.BaseDenom, 11), IsMatured: true},
+		}
+		genState.DelegatorUnbondingEpochEntries = []types.DelegatorUnbondingEpochEntry{
+			types.NewDelegatorUnbondingEpochEntry(delegator, 4, sdk.NewInt64Coin(hostChainParams.MintDenom, 10)),
+			types.NewDelegatorUnbondingEpochEntry(delegator, 8, sdk.NewInt64Coin(hostChainParams.MintDenom, 100)),
+		}
+		genState.IBCAmountTransientStore = types.IBCAmountTransientStore{
+			IBCTransfer: sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 10)),
+			ICADelegate: sdk.NewInt64Coin(hostChainParams.BaseDenom, 10),
+		}
+		genState.ICATxSendTimes = []types.ICATxSendTime{
+			{PortID: genState.HostAccounts.DelegatorAccountPortID(), Sequence: 1, SendTime: time.Unix(0, 0).UTC()},
+		}
+		return genState
+	}
+
 	for _, tc := range []struct {
 		desc     string
-		genState *types.GenesisState
+		genState func() *types.GenesisState
 		valid    bool
 	}{
 		{
 			desc:     "default is valid",
-			genState: types.DefaultGenesis(),
+			genState: types.DefaultGenesis,
 			valid:    true,
 		},
 		{
-			desc:     "invalid genesis state, host accounts not set",
-			genState: &types.GenesisState{
-				// this line is used by starport scaffolding # types/genesis/validField
+			desc: "invalid genesis state, host accounts not set",
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					// this line is used by starport scaffolding # types/genesis/validField
+				}
+			},
+			valid: false,
+		},
+		{
+			desc:     "populated is valid",
+			genState: populated,
+			valid:    true,
+		},
+		{
+			desc: "invalid genesis state, allow listed weights do not sum to one",
+			genState: func() *types.GenesisState {
+				genState := populated()
+				genState.AllowListedValidators = types.AllowListedValidators{AllowListedValidators: []types.AllowListedValidator{
+					{ValidatorAddress: "cosmosvaloper10e4vsut6suau8tk9m6dnrm0slgd6npe3jx5xpv", TargetWeight: sdk.NewDecWithPrec(5, 1)},
+				}}
+				return genState
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state, same base and mint denom",
+			genState: func() *types.GenesisState {
+				genState := populated()
+				genState.HostChainParams.MintDenom = genState.HostChainParams.BaseDenom
+				return genState
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state, duplicate unbonding epoch c value",
+			genState: func() *types.GenesisState {
+				genState := populated()
+				genState.UnbondingEpochCValues = append(genState.UnbondingEpochCValues, genState.UnbondingEpochCValues[0])
+				return genState
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state, delegator entry references non existent epoch",
+			genState: func() *types.GenesisState {
+				genState := populated()
+				genState.DelegatorUnbondingEpochEntries[0].EpochNumber = 0
+				return genState
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state, duplicate delegator entry",
+			genState: func() *types.GenesisState {
+				genState := populated()
+				genState.DelegatorUnbondingEpochEntries = append(genState.DelegatorUnbondingEpochEntries, genState.DelegatorUnbondingEpochEntries[0])
+				return genState
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state, delegator entry not in mint denom",
+			genState: func() *types.GenesisState {
+				genState := populated()
+				genState.DelegatorUnbondingEpochEntries[0].Amount.Denom = hostChainParams.BaseDenom
+				return genState
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state, delegator entry with invalid address",
+			genState: func() *types.GenesisState {
+				genState := populated()
+				genState.DelegatorUnbondingEpochEntries[0].DelegatorAddress = "invalid"
+				return genState
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state, pending epoch entries do not add up to the undelegation",
+			genState: func() *types.GenesisState {
+				genState := populated()
+				genState.DelegationState.HostAccountUndelegations[0].TotalUndelegationAmount.Amount = sdk.NewInt(101)
+				return genState
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state, ibc transfer not in ibc denom",
+			genState: func() *types.GenesisState {
+				genState := populated()
+				genState.IBCAmountTransientStore.IBCTransfer = sdk.NewCoins(sdk.NewInt64Coin(hostChainParams.BaseDenom, 10))
+				return genState
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state, undelegation transfer references epoch without c value",
+			genState: func() *types.GenesisState {
+				genState := populated()
+				genState.IBCAmountTransientStore.UndelegatonCompleteIBCTransfer = []types.TransientUndelegationTransfer{
+					{EpochNumber: 8, AmountUnbonded: sdk.NewInt64Coin(hostChainParams.BaseDenom, 10)},
+				}
+				return genState
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state, duplicate ica tx send time",
+			genState: func() *types.GenesisState {
+				genState := populated()
+				genState.ICATxSendTimes = append(genState.ICATxSendTimes, genState.ICATxSendTimes[0])
+				return genState
 			},
 			valid: false,
 		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
-			err := tc.genState.Validate()
+			err := tc.genState().Validate()
 			if tc.valid {
 				require.NoError(t, err)
 			} else {
@@ -0,0 +1,94 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LSCosmosHooks event hooks for lscosmos state transitions, downstream modules
+// register them through Keeper.SetHooks
+type LSCosmosHooks interface {
+	// AfterLiquidStake is called after ibc tokens are deposited and stk tokens are minted to the delegator
+	AfterLiquidStake(ctx sdk.Context, delegator sdk.AccAddress, amount sdk.Coin, received sdk.Coin) error
+	// AfterLiquidUnstake is called after stk tokens are queued for unbonding in the unbonding epoch
+	AfterLiquidUnstake(ctx sdk.Context, delegator sdk.AccAddress, amount sdk.Coin, unbondingEpochNumber int64) error
+	// AfterRedeem is called after stk tokens are instantly redeemed for ibc tokens
+	AfterRedeem(ctx sdk.Context, delegator sdk.AccAddress, amount sdk.Coin, received sdk.Coin) error
+	// AfterClaim is called for every matured or failed unbonding entry claimed by the delegator
+	AfterClaim(ctx sdk.Context, delegator sdk.AccAddress, epochNumber int64, claimed sdk.Coin) error
+	// AfterCValueChange is called after rewards are restaked or slashing is reconciled, an error is logged and
+	// the changes of the hook are dropped
+	AfterCValueChange(ctx sdk.Context, oldCValue, newCValue sdk.Dec) error
+	// AfterSlashingReconciled is called after the delegation state of a slashed validator is updated, an error is
+	// logged and the changes of the hook are dropped
+	AfterSlashingReconciled(ctx sdk.Context, validatorAddress string, slashedAmount sdk.Coin) error
+}
+
+var _ LSCosmosHooks = MultiLSCosmosHooks{}
+
+// MultiLSCosmosHooks combines multiple lscosmos hooks, all hook functions are run in array sequence
+type MultiLSCosmosHooks []LSCosmosHooks
+
+// NewMultiLSCosmosHooks returns a new MultiLSCosmosHooks from the given hooks
+func NewMultiLSCosmosHooks(hooks ...LSCosmosHooks) MultiLSCosmosHooks {
+	return hooks
+}
+
+// AfterLiquidStake runs the AfterLiquidStake hook of all the registered hooks
+func (h MultiLSCosmosHooks) AfterLiquidStake(ctx sdk.Context, delegator sdk.AccAddress, amount sdk.Coin, received sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterLiquidStake(ctx, delegator, amount, received); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterLiquidUnstake runs the AfterLiquidUnstake hook of all the registered hooks
+func (h MultiLSCosmosHooks) AfterLiquidUnstake(ctx sdk.Context, delegator sdk.AccAddress, amount sdk.Coin, unbondingEpochNumber int64) error {
+	for i := range h {
+		if err := h[i].AfterLiquidUnstake(ctx, delegator, amount, unbondingEpochNumber); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterRedeem runs the AfterRedeem hook of all the registered hooks
+func (h MultiLSCosmosHooks) AfterRedeem(ctx sdk.Context, delegator sdk.AccAddress, amount sdk.Coin, received sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterRedeem(ctx, delegator, amount, received); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterClaim runs the AfterClaim hook of all the registered hooks
+func (h MultiLSCosmosHooks) AfterClaim(ctx sdk.Context, delegator sdk.AccAddress, epochNumber int64, claimed sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterClaim(ctx, delegator, epochNumber, claimed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterCValueChange runs the AfterCValueChange hook of all the registered hooks
+func (h MultiLSCosmosHooks) AfterCValueChange(ctx sdk.Context, oldCValue, newCValue sdk.Dec) error {
+	for i := range h {
+		if err := h[i].AfterCValueChange(ctx, oldCValue, newCValue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterSlashingReconciled runs the AfterSlashingReconciled hook of all the registered hooks
+func (h MultiLSCosmosHooks) AfterSlashingReconciled(ctx sdk.Context, validatorAddress string, slashedAmount sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterSlashingReconciled(ctx, validatorAddress, slashedAmount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/merlin-network/estake-native/v2/x/lscosmos/types"
+)
+
+var errHook = errors.New("hook failed")
+
+type mockLSCosmosHooks struct {
+	calls []string
+	err   error
+}
+
+func (h *mockLSCosmosHooks) AfterLiquidStake(_ sdk.Context, _ sdk.AccAddress, _, _ sdk.Coin) error {
+	h.calls = append(h.calls, "AfterLiquidStake")
+	return h.err
+}
+
+func (h *mockLSCosmosHooks) AfterLiquidUnstake(_ sdk.Context, _ sdk.AccAddress, _ sdk.Coin, _ int64) error {
+	h.calls = append(h.calls, "AfterLiquidUnstake")
+	return h.err
+}
+
+func (h *mockLSCosmosHooks) AfterRedeem(_ sdk.Context, _ sdk.AccAddress, _, _ sdk.Coin) error {
+	h.calls = append(h.calls, "AfterRedeem")
+	return h.err
+}
+
+func (h *mockLSCosmosHooks) AfterClaim(_ sdk.Context, _ sdk.AccAddress, _ int64, _ sdk.Coin) error {
+	h.calls = append(h.calls, "AfterClaim")
+	return h.err
+}
+
+func (h *mockLSCosmosHooks) AfterCValueChange(_ sdk.Context, _, _ sdk.Dec) error {
+	h.calls = append(h.calls, "AfterCValueChange")
+	return h.err
+}
+
+func (h *mockLSCosmosHooks) AfterSlashingReconciled(_ sdk.Context, _ string, _ sdk.Coin) error {
+	h.calls = append(h.calls, "AfterSlashingReconciled")
+	return h.err
+}
+
+func TestMultiLSCosmosHooks(t *testing.T) {
+	ctx := sdk.Context{}
+	addr := sdk.AccAddress("delegatorAddress")
+	coin := sdk.NewInt64Coin("stk/uatom", 10)
+
+	first, second := &mockLSCosmosHooks{}, &mockLSCosmosHooks{}
+	hooks := types.NewMultiLSCosmosHooks(first, second)
+
+	require.NoError(t, hooks.AfterLiquidStake(ctx, addr, coin, coin))
+	require.NoError(t, hooks.AfterLiquidUnstake(ctx, addr, coin, 4))
+	require.NoError(t, hooks.AfterRedeem(ctx, addr, coin, coin))
+	require.NoError(t, hooks.AfterClaim(ctx, addr, 4, coin))
+	require.NoError(t, hooks.AfterCValueChange(ctx, sdk.OneDec(), sdk.MustNewDecFromStr("0.99")))
+	require.NoError(t, hooks.AfterSlashingReconciled(ctx, "cosmosvaloper1", coin))
+
+	expected := []string{
+		"AfterLiquidStake", "AfterLiquidUnstake", "AfterRedeem",
+		"AfterClaim", "AfterCValueChange", "AfterSlashingReconciled",
+	}
+	require.Equal(t, expected, first.calls)
+	require.Equal(t, expected, second.calls)
+
+	// an erroring hook stops the fan-out
+	failing, last := &mockLSCosmosHooks{err: errHook}, &mockLSCosmosHooks{}
+	hooks = types.NewMultiLSCosmosHooks(failing, last)
+	require.ErrorIs(t, hooks.AfterLiquidStake(ctx, addr, coin, coin), errHook)
+	require.Len(t, failing.calls, 1)
+	require.Empty(t, last.calls)
+}
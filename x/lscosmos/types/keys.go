@@ -89,6 +89,10 @@ const (
 	CosmosValOperPrefix = "cosmosvaloper"
 
 	LiquidStakedDenomPrefix = "stk"
+
+	// DefaultHostMaxEntries is the default max number of unbonding entries per delegator validator pair on the
+	// host chain, used till the host staking params are queried
+	DefaultHostMaxEntries uint32 = 7
 )
 
 // fee limits
@@ -113,6 +117,8 @@ var (
 	UnbondingEpochCValueKey         = []byte{0x07} // prefix for unbodning epoch c value store
 	DelegatorUnbondingEpochEntryKey = []byte{0x08} // prefix for delegator unbonding epoch entry
 	HostAccountsKey                 = []byte{0x09} // key for host accounts
+	ICATxSendTimeKey                = []byte{0x0A} // prefix for send time of in-flight ica txs
+	HostMaxEntriesKey               = []byte{0x0B} // key for host chain staking max entries
 )
 
 // GetUnbondingEpochCValueKey returns a slice of byte made of UnbondingEpochCValueKey and epoch number
@@ -132,3 +138,16 @@ func GetDelegatorUnbondingEpochEntryKey(delegatorAddress sdk.AccAddress, epochNu
 func GetPartialDelegatorUnbondingEpochEntryKey(delegatorAddress sdk.AccAddress) []byte {
 	return append(DelegatorUnbondingEpochEntryKey, address.MustLengthPrefix(delegatorAddress)...)
 }
+
+// GetICATxSendTimeKey returns a slice of byte made of ICATxSendTimeKey, port id as bytes
+// and the packet sequence converted to bytes
+func GetICATxSendTimeKey(portID string, sequence uint64) []byte {
+	return append(append(ICATxSendTimeKey, address.MustLengthPrefix([]byte(portID))...), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// ParseICATxSendTimeKey returns the port id and the packet sequence of a key made by GetICATxSendTimeKey
+func ParseICATxSendTimeKey(key []byte) (portID string, sequence uint64) {
+	portIDLen := int(key[len(ICATxSendTimeKey)])
+	portIDStart := len(ICATxSendTimeKey) + 1
+	return string(key[portIDStart : portIDStart+portIDLen]), sdk.BigEndianToUint64(key[portIDStart+portIDLen:])
+}
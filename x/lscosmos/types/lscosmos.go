@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -144,6 +145,21 @@ func (ds DelegationState) TotalDelegations(denom string) sdk.Coin {
 	return total
 }
 
+// OpenUndelegationEntries gives the number of host chain unbonding entries per validator which have not
+// matured at blockTime. Entries of undelegations not acknowledged yet have no completion time and are open.
+func (ds DelegationState) OpenUndelegationEntries(blockTime time.Time) map[string]uint32 {
+	openEntries := map[string]uint32{}
+	for _, undelegation := range ds.HostAccountUndelegations {
+		if !undelegation.CompletionTime.Equal(time.Time{}) && !blockTime.Before(undelegation.CompletionTime) {
+			continue
+		}
+		for _, entry := range undelegation.UndelegationEntries {
+			openEntries[entry.ValidatorAddress]++
+		}
+	}
+	return openEntries
+}
+
 // NewDelegatorUnbondingEpochEntry returns new DelegatorUnbondingEpochEntry
 func NewDelegatorUnbondingEpochEntry(delegatorAddress string, epochNumber int64, amount sdk.Coin) DelegatorUnbondingEpochEntry {
 	return DelegatorUnbondingEpochEntry{
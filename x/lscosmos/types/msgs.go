@@ -161,12 +161,13 @@ func (m *MsgRedeem) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{acc}
 }
 
-// NewMsgClaim returns a new MsgClaim
+// NewMsgClaim returns a new MsgClaim, claiming at most maxEntries unbonding entries or all of them if 0
 //
 //nolint:interfacer
-func NewMsgClaim(address sdk.AccAddress) *MsgClaim {
+func NewMsgClaim(address sdk.AccAddress, maxEntries uint32) *MsgClaim {
 	return &MsgClaim{
 		DelegatorAddress: address.String(),
+		MaxEntries:       maxEntries,
 	}
 }
 
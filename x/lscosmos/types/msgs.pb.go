@@ -300,6 +300,9 @@ var xxx_messageInfo_MsgRedeemResponse proto.InternalMessageInfo
 
 type MsgClaim struct {
 	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// max_entries is the maximum number of matured or failed unbonding entries
+	// claimed, all of them if 0
+	MaxEntries uint32 `protobuf:"varint,2,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
 }
 
 func (m *MsgClaim) Reset()         { *m = MsgClaim{} }
@@ -342,7 +345,16 @@ func (m *MsgClaim) GetDelegatorAddress() string {
 	return ""
 }
 
+func (m *MsgClaim) GetMaxEntries() uint32 {
+	if m != nil {
+		return m.MaxEntries
+	}
+	return 0
+}
+
 type MsgClaimResponse struct {
+	// remaining is true if claimable entries are left after max_entries
+	Remaining bool `protobuf:"varint,1,opt,name=remaining,proto3" json:"remaining,omitempty"`
 }
 
 func (m *MsgClaimResponse) Reset()         { *m = MsgClaimResponse{} }
@@ -378,6 +390,13 @@ func (m *MsgClaimResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimResponse proto.InternalMessageInfo
 
+func (m *MsgClaimResponse) GetRemaining() bool {
+	if m != nil {
+		return m.Remaining
+	}
+	return false
+}
+
 type MsgRecreateICA struct {
 	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
 }
@@ -811,75 +830,77 @@ func init() {
 }
 
 var fileDescriptor_2c178418d9a52b7e = []byte{
-	// 1081 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0xdc, 0xc4,
-	0x17, 0x8f, 0xdb, 0x26, 0xcd, 0xce, 0x26, 0xf9, 0x26, 0x6e, 0xbe, 0x89, 0x63, 0xca, 0x26, 0x31,
-	0x69, 0x7e, 0xd1, 0xd8, 0x4a, 0x10, 0x42, 0x6a, 0x0f, 0x28, 0x3f, 0x90, 0x08, 0xea, 0xaa, 0xd1,
-	0x46, 0xe5, 0xc0, 0xc5, 0x4c, 0xec, 0x89, 0xd7, 0xaa, 0x3d, 0x63, 0x3c, 0xb3, 0x0b, 0x3d, 0x70,
-	0xa0, 0x77, 0x04, 0x12, 0x02, 0x4e, 0x1c, 0x10, 0x17, 0x84, 0x84, 0xc4, 0x81, 0x23, 0x7f, 0x40,
-	0x8f, 0x15, 0x5c, 0x10, 0x87, 0x0a, 0x25, 0x48, 0xfc, 0x1b, 0x68, 0xc6, 0xe3, 0x59, 0x27, 0xbb,
-	0xde, 0x5d, 0x54, 0x0e, 0x3d, 0x25, 0xfb, 0xde, 0xe7, 0xbd, 0xcf, 0x67, 0x3c, 0x1f, 0xbf, 0xb7,
-	0x0b, 0xac, 0x84, 0x32, 0xf8, 0x10, 0x39, 0x11, 0xf5, 0x08, 0x8d, 0x09, 0x75, 0xda, 0xdb, 0x27,
-	0x88, 0xc1, 0x6d, 0x27, 0xa6, 0x01, 0xb5, 0x93, 0x94, 0x30, 0xa2, 0xcf, 0x67, 0x18, 0x3b, 0xc7,
-	0xd8, 0x12, 0x63, 0xce, 0x06, 0x24, 0x20, 0x02, 0xe3, 0xf0, 0xff, 0x32, 0xb8, 0x79, 0x33, 0x20,
-	0x24, 0x88, 0x90, 0x03, 0x93, 0xd0, 0x81, 0x18, 0x13, 0x06, 0x59, 0x48, 0xb0, 0x6c, 0x66, 0x2e,
-	0xc8, 0xac, 0xf8, 0x74, 0xd2, 0x3a, 0x75, 0x20, 0x7e, 0x24, 0x53, 0x35, 0x29, 0xe1, 0x04, 0x52,
-	0xa4, 0x74, 0x78, 0x24, 0xc4, 0x79, 0x69, 0x96, 0x77, 0x33, 0x46, 0xa9, 0x25, 0x4b, 0xcd, 0xcb,
-	0xd2, 0x98, 0x06, 0x4e, 0x5b, 0x88, 0x97, 0x89, 0xd5, 0xb2, 0xf3, 0xa9, 0xc3, 0x08, 0x9c, 0xf5,
-	0xbd, 0x06, 0xa6, 0xea, 0x34, 0xb8, 0x17, 0x7e, 0xd0, 0x0a, 0xfd, 0x63, 0x5e, 0xa2, 0xbf, 0x05,
-	0x66, 0x7c, 0x14, 0xa1, 0x00, 0x32, 0x92, 0xba, 0xd0, 0xf7, 0x53, 0x44, 0xa9, 0xa1, 0x2d, 0x69,
-	0xeb, 0x95, 0x3d, 0xe3, 0xd7, 0x9f, 0xb7, 0x66, 0x65, 0xfd, 0x6e, 0x96, 0x39, 0x66, 0x69, 0x88,
-	0x83, 0xc6, 0xb4, 0x2a, 0x91, 0x71, 0xfd, 0x0d, 0x30, 0x06, 0x63, 0xd2, 0xc2, 0xcc, 0xb8, 0xb2,
-	0xa4, 0xad, 0x57, 0x77, 0x16, 0x6c, 0x59, 0xc8, 0x8f, 0x99, 0x3f, 0x4a, 0x7b, 0x9f, 0x84, 0x78,
-	0xef, 0xda, 0x93, 0x67, 0x8b, 0x23, 0x0d, 0x09, 0xbf, 0x33, 0xf7, 0xf8, 0xef, 0x9f, 0x36, 0xbb,
-	0x25, 0x58, 0x06, 0x98, 0xbb, 0xa8, 0xb4, 0x81, 0x68, 0x42, 0x30, 0x45, 0xd6, 0x0f, 0x1a, 0x98,
-	0x56, 0xa9, 0x07, 0x98, 0xbe, 0xd0, 0xc7, 0x30, 0x81, 0x71, 0x59, 0xab, 0x3a, 0xc8, 0x77, 0x1a,
-	0xa8, 0xd4, 0x69, 0xd0, 0x40, 0x3e, 0x42, 0xf1, 0x0b, 0x7b, 0x82, 0x1b, 0x60, 0x46, 0x89, 0x54,
-	0xd2, 0x43, 0x30, 0x5e, 0xa7, 0xc1, 0x7e, 0x04, 0xc3, 0xff, 0x4a, 0x78, 0x29, 0xbf, 0x2e, 0x6e,
-	0x5b, 0x50, 0x29, 0xfa, 0xf7, 0x85, 0x8d, 0x1b, 0xc8, 0x4b, 0x11, 0x64, 0xe8, 0x70, 0x7f, 0x57,
-	0xbf, 0x0b, 0x26, 0x4e, 0x53, 0x12, 0x0f, 0xcd, 0x5f, 0xe5, 0xe8, 0x9c, 0x7a, 0x86, 0x53, 0x5f,
-	0xa8, 0x97, 0xf6, 0x2b, 0x30, 0x28, 0xee, 0xaf, 0x47, 0xc1, 0x44, 0x9d, 0x06, 0xef, 0xb4, 0xe2,
-	0xe4, 0x98, 0xc1, 0x94, 0xe9, 0x6f, 0x82, 0xa9, 0xec, 0xf5, 0x1b, 0x9a, 0x7c, 0x32, 0xc3, 0xe7,
-	0x57, 0x66, 0x82, 0x8a, 0xd7, 0x84, 0x21, 0x76, 0x43, 0xd7, 0x17, 0xb7, 0x56, 0x69, 0x5c, 0x17,
-	0x81, 0xc3, 0x03, 0x7d, 0x05, 0x4c, 0x79, 0x04, 0x63, 0xe4, 0xf1, 0xe9, 0x22, 0x00, 0x57, 0x05,
-	0x60, 0xa2, 0x13, 0x3d, 0x3c, 0xd0, 0x37, 0xc0, 0x34, 0x4b, 0x21, 0xa6, 0xa7, 0x28, 0x75, 0xbd,
-	0x26, 0xc4, 0x18, 0x45, 0xc6, 0x35, 0x81, 0xfb, 0x5f, 0x1e, 0xdf, 0xcf, 0xc2, 0xfa, 0x2b, 0x60,
-	0x52, 0x41, 0x13, 0x92, 0x32, 0x63, 0x34, 0xeb, 0x97, 0x07, 0x8f, 0x48, 0xca, 0xf4, 0x97, 0x01,
-	0xe0, 0x76, 0x71, 0x7d, 0x84, 0x49, 0x6c, 0x8c, 0x09, 0x44, 0x85, 0x47, 0x0e, 0x78, 0x80, 0xa7,
-	0xe3, 0x10, 0x33, 0x99, 0xbe, 0x9e, 0xa5, 0x79, 0x24, 0x4b, 0xdf, 0x07, 0xd5, 0x38, 0xc4, 0xae,
-	0x8f, 0x12, 0x42, 0x43, 0x66, 0x8c, 0x8b, 0xa7, 0x61, 0x73, 0xb3, 0xfd, 0xf1, 0x6c, 0x71, 0x35,
-	0x08, 0x59, 0xb3, 0x75, 0x62, 0x7b, 0x24, 0x96, 0xc3, 0x4d, 0xfe, 0xd9, 0xa2, 0xfe, 0x43, 0x87,
-	0x3d, 0x4a, 0x10, 0xb5, 0x0f, 0x31, 0x6b, 0x70, 0x86, 0x83, 0xac, 0x83, 0x1e, 0x81, 0x79, 0x18,
-	0x45, 0xe4, 0x43, 0x37, 0x0a, 0x29, 0x43, 0xbe, 0xdb, 0x86, 0x51, 0xe8, 0x73, 0x93, 0x50, 0xa3,
-	0x22, 0x4c, 0x6e, 0xdb, 0x25, 0xc3, 0xdb, 0xde, 0xe5, 0x75, 0xf7, 0x44, 0xd9, 0xbb, 0xaa, 0x4a,
-	0x3a, 0xff, 0xff, 0xb0, 0x57, 0x52, 0x3f, 0x02, 0xf2, 0x7e, 0xdc, 0x04, 0xa6, 0x30, 0xa6, 0x06,
-	0x10, 0x1c, 0xb7, 0x4a, 0x39, 0x8e, 0x44, 0xfc, 0x48, 0x80, 0x65, 0xeb, 0x89, 0xa4, 0x10, 0xe3,
-	0x1d, 0x9b, 0x84, 0x32, 0x17, 0x7a, 0x1e, 0x7f, 0xd5, 0xa8, 0x51, 0x1d, 0xd0, 0xf1, 0x6d, 0x42,
-	0xd9, 0xae, 0x04, 0xe7, 0x1d, 0x9b, 0x85, 0xd8, 0x9d, 0x1b, 0xdc, 0xb1, 0x97, 0x6c, 0x67, 0xcd,
-	0x81, 0xd9, 0xa2, 0x31, 0x95, 0x63, 0x3f, 0xd3, 0x44, 0x82, 0x3b, 0x20, 0x40, 0x75, 0xe2, 0xb7,
-	0x22, 0x74, 0xcc, 0x20, 0x43, 0xcf, 0xef, 0xdc, 0x65, 0x30, 0x11, 0x8b, 0x7e, 0x2e, 0xe5, 0x0d,
-	0x85, 0x79, 0xc7, 0x1b, 0xd5, 0xb8, 0xc3, 0xd1, 0x5b, 0x69, 0x0d, 0xdc, 0xec, 0x25, 0x48, 0x29,
-	0xfe, 0x4a, 0x93, 0x43, 0x87, 0x3b, 0xf4, 0x38, 0x82, 0xb4, 0x19, 0xe2, 0xe0, 0xf9, 0xe5, 0xbe,
-	0x0a, 0x66, 0x94, 0x75, 0x54, 0x8f, 0xec, 0x85, 0x9b, 0x56, 0x89, 0x7c, 0x28, 0xf4, 0x14, 0xfe,
-	0x12, 0x58, 0xe8, 0xd2, 0x95, 0xab, 0xde, 0xf9, 0xa5, 0x02, 0xae, 0xd6, 0x69, 0xa0, 0x7f, 0xa9,
-	0x81, 0x6a, 0x71, 0xc5, 0xae, 0x95, 0xde, 0xf3, 0xc5, 0x0d, 0x67, 0x3a, 0x43, 0x02, 0xd5, 0x73,
-	0xba, 0xfd, 0xf8, 0xb7, 0xbf, 0xbe, 0xb8, 0xb2, 0x6a, 0xad, 0x38, 0x65, 0x5f, 0x00, 0x8a, 0x3a,
-	0xbe, 0xd1, 0xc0, 0xe4, 0xc5, 0xad, 0xb9, 0x31, 0x98, 0x50, 0x42, 0xcd, 0xed, 0xa1, 0xa1, 0x4a,
-	0x9d, 0x2d, 0xd4, 0xad, 0x5b, 0xab, 0x03, 0xd4, 0xe5, 0x6a, 0x3e, 0xd1, 0xc0, 0x98, 0x5c, 0x86,
-	0x56, 0x3f, 0xb6, 0x0c, 0x63, 0x6e, 0x0e, 0xc6, 0x28, 0x29, 0x6b, 0x42, 0xca, 0xb2, 0xb5, 0x58,
-	0x2a, 0x45, 0x12, 0x7f, 0x0c, 0x46, 0xb3, 0xad, 0xb6, 0xdc, 0xaf, 0xbb, 0x80, 0x98, 0x1b, 0x03,
-	0x21, 0x8a, 0x7f, 0x55, 0xf0, 0x2f, 0x59, 0xb5, 0x52, 0xfe, 0x8c, 0x95, 0x5b, 0xa7, 0xb8, 0xd6,
-	0xd6, 0xfa, 0x9f, 0x51, 0x01, 0xfb, 0x5b, 0xa7, 0xd7, 0x1a, 0x1b, 0x6c, 0x9d, 0xa2, 0x8e, 0x4f,
-	0x35, 0x50, 0xe9, 0x6c, 0xbc, 0x5b, 0xfd, 0xc8, 0x14, 0xcc, 0xdc, 0x1a, 0x0a, 0xa6, 0x14, 0x6d,
-	0x0a, 0x45, 0x2b, 0x96, 0x55, 0xaa, 0xa8, 0xa3, 0xe0, 0x47, 0x0d, 0xcc, 0x74, 0xcf, 0xb3, 0xbe,
-	0x84, 0x5d, 0x70, 0xf3, 0xf5, 0x7f, 0x05, 0x57, 0x3a, 0x77, 0x84, 0xce, 0xdb, 0xd6, 0x66, 0xf9,
-	0x5d, 0x76, 0x29, 0xfb, 0x56, 0x03, 0x53, 0x97, 0xa6, 0xd9, 0x00, 0xfb, 0x16, 0xb1, 0xe6, 0xce,
-	0xf0, 0x58, 0x25, 0xd3, 0x11, 0x32, 0x37, 0xac, 0xb5, 0x3e, 0x17, 0x5c, 0x2c, 0xdc, 0x7b, 0xf0,
-	0xe4, 0xac, 0xa6, 0x3d, 0x3d, 0xab, 0x69, 0x7f, 0x9e, 0xd5, 0xb4, 0xcf, 0xcf, 0x6b, 0x23, 0x4f,
-	0xcf, 0x6b, 0x23, 0xbf, 0x9f, 0xd7, 0x46, 0xde, 0xbb, 0x5b, 0xd8, 0xd9, 0x09, 0x4a, 0x29, 0xdf,
-	0x98, 0xd8, 0x43, 0xf7, 0x31, 0x92, 0xbd, 0xb7, 0x30, 0x64, 0x61, 0x1b, 0x39, 0xed, 0x1d, 0xe7,
-	0xa3, 0x0e, 0x8f, 0x58, 0xe6, 0x27, 0x63, 0xe2, 0xa7, 0xc7, 0x6b, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0x7b, 0x90, 0x5c, 0x56, 0x84, 0x0d, 0x00, 0x00,
+	// 1116 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcf, 0x4f, 0x24, 0x45,
+	0x14, 0xc7, 0xe9, 0xdd, 0x85, 0x65, 0x6a, 0x00, 0xa1, 0x17, 0xa1, 0x69, 0x71, 0x80, 0x96, 0xe5,
+	0x97, 0x4b, 0xb7, 0x60, 0x8c, 0x09, 0x7b, 0x30, 0xfc, 0x4a, 0xc4, 0xec, 0x44, 0x32, 0x64, 0x3d,
+	0x78, 0x69, 0x8b, 0xe9, 0xa2, 0xa7, 0x42, 0x77, 0xd5, 0xd8, 0x55, 0xc3, 0xb2, 0x07, 0x0f, 0xee,
+	0xd1, 0xc4, 0x68, 0x62, 0xd4, 0x93, 0x07, 0xe3, 0xc5, 0x98, 0x98, 0x78, 0xf0, 0xe8, 0x1f, 0xb0,
+	0xc7, 0x8d, 0x5e, 0x8c, 0x87, 0x8d, 0x01, 0x13, 0xff, 0x0d, 0x53, 0xd5, 0xd5, 0x35, 0x0d, 0xc3,
+	0xfc, 0xd8, 0xac, 0x87, 0x3d, 0xc1, 0xbc, 0xf7, 0x7d, 0xef, 0x7d, 0xaa, 0xea, 0x55, 0xbd, 0x19,
+	0xe0, 0x20, 0xc6, 0xe1, 0x31, 0xf2, 0x22, 0x56, 0xa5, 0x2c, 0xa6, 0xcc, 0x3b, 0x59, 0x3b, 0x44,
+	0x1c, 0xae, 0x79, 0x31, 0x0b, 0x99, 0x5b, 0x4f, 0x28, 0xa7, 0xe6, 0x64, 0xaa, 0x71, 0x33, 0x8d,
+	0xab, 0x34, 0xf6, 0x78, 0x48, 0x43, 0x2a, 0x35, 0x9e, 0xf8, 0x2f, 0x95, 0xdb, 0xd3, 0x21, 0xa5,
+	0x61, 0x84, 0x3c, 0x58, 0xc7, 0x1e, 0x24, 0x84, 0x72, 0xc8, 0x31, 0x25, 0x2a, 0x99, 0x3d, 0xa5,
+	0xbc, 0xf2, 0xd3, 0x61, 0xe3, 0xc8, 0x83, 0xe4, 0xa1, 0x72, 0x95, 0x14, 0xc2, 0x21, 0x64, 0x48,
+	0x73, 0x54, 0x29, 0x26, 0x59, 0x68, 0xea, 0xf7, 0xd3, 0x8a, 0x8a, 0x25, 0x75, 0x4d, 0xaa, 0xd0,
+	0x98, 0x85, 0xde, 0x89, 0x84, 0x57, 0x8e, 0x85, 0x76, 0xeb, 0xd3, 0x8b, 0x91, 0x3a, 0xe7, 0x47,
+	0x03, 0x8c, 0x94, 0x59, 0x78, 0x0f, 0x7f, 0xdc, 0xc0, 0xc1, 0x81, 0x08, 0x31, 0x77, 0xc1, 0x58,
+	0x80, 0x22, 0x14, 0x42, 0x4e, 0x13, 0x1f, 0x06, 0x41, 0x82, 0x18, 0xb3, 0x8c, 0x59, 0x63, 0xa9,
+	0xb0, 0x65, 0xfd, 0xfe, 0xeb, 0xea, 0xb8, 0x8a, 0xdf, 0x4c, 0x3d, 0x07, 0x3c, 0xc1, 0x24, 0xac,
+	0x8c, 0xea, 0x10, 0x65, 0x37, 0xdf, 0x06, 0x03, 0x30, 0xa6, 0x0d, 0xc2, 0xad, 0x6b, 0xb3, 0xc6,
+	0x52, 0x71, 0x7d, 0xca, 0x55, 0x81, 0x62, 0x99, 0xd9, 0x56, 0xba, 0xdb, 0x14, 0x93, 0xad, 0x1b,
+	0x8f, 0x9f, 0xce, 0xf4, 0x55, 0x94, 0x7c, 0x63, 0xe2, 0xd1, 0xbf, 0xbf, 0xac, 0xb4, 0x22, 0x38,
+	0x16, 0x98, 0xb8, 0x48, 0x5a, 0x41, 0xac, 0x4e, 0x09, 0x43, 0xce, 0x4f, 0x06, 0x18, 0xd5, 0xae,
+	0xfb, 0x84, 0xbd, 0xd0, 0xcb, 0xb0, 0x81, 0x75, 0x99, 0x55, 0x2f, 0xe4, 0x07, 0x03, 0x14, 0xca,
+	0x2c, 0xac, 0xa0, 0x00, 0xa1, 0xf8, 0x85, 0x5d, 0xc1, 0x2d, 0x30, 0xa6, 0x21, 0x35, 0xfa, 0x67,
+	0x06, 0x18, 0x2c, 0xb3, 0x70, 0x3b, 0x82, 0xf8, 0x7f, 0x23, 0x9f, 0x01, 0xc5, 0x18, 0x9e, 0xfa,
+	0x88, 0xf0, 0x04, 0x23, 0x26, 0xf1, 0x87, 0x2b, 0x20, 0x86, 0xa7, 0xbb, 0xa9, 0xa5, 0x2d, 0xe1,
+	0x1b, 0x60, 0x34, 0x63, 0xc9, 0x00, 0xcd, 0x69, 0x50, 0x48, 0x50, 0x0c, 0x31, 0xc1, 0x24, 0x94,
+	0x2c, 0x83, 0x95, 0xa6, 0xc1, 0xf9, 0x48, 0x5e, 0x83, 0x0a, 0xaa, 0x26, 0x08, 0x72, 0xb4, 0xb7,
+	0xbd, 0x69, 0xde, 0x05, 0x43, 0x47, 0x09, 0x8d, 0x7b, 0xc6, 0x2f, 0x0a, 0xb5, 0x32, 0x6d, 0x8c,
+	0x09, 0xb0, 0x0b, 0xf1, 0xaa, 0x7d, 0x73, 0x15, 0xf4, 0xd6, 0x7d, 0xdb, 0x0f, 0x86, 0xca, 0x2c,
+	0x7c, 0xaf, 0x11, 0xd7, 0x0f, 0x38, 0x4c, 0xb8, 0xf9, 0x0e, 0x18, 0x49, 0xaf, 0x6f, 0xcf, 0xc5,
+	0x87, 0x53, 0x7d, 0xb6, 0x71, 0x36, 0x28, 0x54, 0x6b, 0x10, 0x13, 0x1f, 0xfb, 0x81, 0xdc, 0xb6,
+	0x42, 0xe5, 0xa6, 0x34, 0xec, 0xed, 0x98, 0xf3, 0x60, 0xa4, 0x4a, 0x09, 0x41, 0x55, 0xf1, 0x3a,
+	0x49, 0xc1, 0x75, 0x29, 0x18, 0x6a, 0x5a, 0xf7, 0x76, 0xcc, 0x65, 0x30, 0xca, 0x13, 0x48, 0xd8,
+	0x11, 0x4a, 0xfc, 0x6a, 0x0d, 0x12, 0x82, 0x22, 0xeb, 0x86, 0xd4, 0xbd, 0x94, 0xd9, 0xb7, 0x53,
+	0xb3, 0xf9, 0x1a, 0x18, 0xd6, 0xd2, 0x3a, 0x4d, 0xb8, 0xd5, 0x9f, 0xe6, 0xcb, 0x8c, 0xfb, 0x34,
+	0xe1, 0xe6, 0xab, 0x00, 0x88, 0x76, 0xf3, 0x03, 0x44, 0x68, 0x6c, 0x0d, 0x48, 0x45, 0x41, 0x58,
+	0x76, 0x84, 0x41, 0xb8, 0x63, 0x4c, 0xb8, 0x72, 0xdf, 0x4c, 0xdd, 0xc2, 0x92, 0xba, 0xdf, 0x07,
+	0xc5, 0x18, 0x13, 0x3f, 0x40, 0x75, 0xca, 0x30, 0xb7, 0x06, 0xe5, 0x6e, 0xb8, 0xa2, 0x59, 0xff,
+	0x7a, 0x3a, 0xb3, 0x10, 0x62, 0x5e, 0x6b, 0x1c, 0xba, 0x55, 0x1a, 0xab, 0xc7, 0x51, 0xfd, 0x59,
+	0x65, 0xc1, 0xb1, 0xc7, 0x1f, 0xd6, 0x11, 0x73, 0xf7, 0x08, 0xaf, 0x88, 0x0a, 0x3b, 0x69, 0x06,
+	0x33, 0x02, 0x93, 0x30, 0x8a, 0xe8, 0x03, 0x3f, 0xc2, 0x8c, 0xa3, 0xc0, 0x3f, 0x81, 0x11, 0x0e,
+	0x44, 0x0b, 0x31, 0xab, 0x20, 0x2f, 0x89, 0xeb, 0xb6, 0x79, 0xfc, 0xdd, 0x4d, 0x11, 0x77, 0x4f,
+	0x86, 0x7d, 0xa0, 0xa3, 0xd4, 0xcd, 0x79, 0x19, 0x5e, 0xe5, 0x34, 0xf7, 0x81, 0x3a, 0x1f, 0xbf,
+	0x0e, 0x13, 0x18, 0x33, 0x0b, 0xc8, 0x1a, 0xb7, 0xdb, 0xd6, 0xd8, 0x95, 0xf6, 0x7d, 0x29, 0x56,
+	0xa9, 0x87, 0x50, 0xce, 0x26, 0x32, 0xd6, 0x28, 0xe3, 0x3e, 0xac, 0x56, 0xc5, 0x55, 0x65, 0x56,
+	0xb1, 0x4b, 0xc6, 0x77, 0x29, 0xe3, 0x9b, 0x4a, 0x9c, 0x65, 0xac, 0xe5, 0x6c, 0x1b, 0xb7, 0x44,
+	0xc7, 0x5e, 0x6a, 0x3b, 0x67, 0x02, 0x8c, 0xe7, 0x1b, 0x53, 0x77, 0xec, 0x17, 0x86, 0x74, 0x88,
+	0x0e, 0x08, 0x51, 0x99, 0x06, 0x8d, 0x08, 0x1d, 0x70, 0xc8, 0xd1, 0xf3, 0x77, 0xee, 0x1c, 0x18,
+	0x8a, 0x65, 0x3e, 0x9f, 0x89, 0x84, 0xb2, 0x79, 0x07, 0x2b, 0xc5, 0xb8, 0x59, 0xe3, 0x6a, 0xd2,
+	0x12, 0x98, 0xbe, 0x0a, 0x48, 0x13, 0x7f, 0x63, 0xa8, 0x47, 0x4b, 0x74, 0xe8, 0x41, 0x04, 0x59,
+	0x0d, 0x93, 0xf0, 0xf9, 0x71, 0x5f, 0x07, 0x63, 0xba, 0x75, 0x74, 0x8e, 0xf4, 0xc2, 0x8d, 0x6a,
+	0x47, 0xf6, 0x28, 0x5c, 0x09, 0xfe, 0x0a, 0x98, 0x6a, 0xe1, 0xca, 0xa8, 0xd7, 0x7f, 0x2b, 0x80,
+	0xeb, 0x65, 0x16, 0x9a, 0x5f, 0x1b, 0xa0, 0x98, 0x1f, 0xd1, 0x8b, 0x6d, 0xcf, 0xf9, 0xe2, 0x84,
+	0xb4, 0xbd, 0x1e, 0x85, 0x7a, 0x9f, 0xee, 0x3c, 0xfa, 0xe3, 0x9f, 0xaf, 0xae, 0x2d, 0x38, 0xf3,
+	0x5e, 0xbb, 0x2f, 0x10, 0x79, 0x8e, 0xef, 0x0c, 0x30, 0x7c, 0x71, 0xea, 0x2e, 0x77, 0x2f, 0xa8,
+	0xa4, 0xf6, 0x5a, 0xcf, 0x52, 0x4d, 0xe7, 0x4a, 0xba, 0x25, 0x67, 0xa1, 0x0b, 0x5d, 0x46, 0xf3,
+	0xa9, 0x01, 0x06, 0xd4, 0x30, 0x75, 0x3a, 0x55, 0x4b, 0x35, 0xf6, 0x4a, 0x77, 0x8d, 0x46, 0x59,
+	0x94, 0x28, 0x73, 0xce, 0x4c, 0x5b, 0x14, 0x55, 0xf8, 0x13, 0xd0, 0x9f, 0x0e, 0xc5, 0xb9, 0x4e,
+	0xd9, 0xa5, 0xc4, 0x5e, 0xee, 0x2a, 0xd1, 0xf5, 0x17, 0x64, 0xfd, 0x59, 0xa7, 0xd4, 0xb6, 0x7e,
+	0x5a, 0x55, 0xb4, 0x4e, 0x7e, 0xac, 0x2d, 0x76, 0x5e, 0xa3, 0x16, 0xda, 0x5e, 0x8f, 0xc2, 0x67,
+	0x68, 0x9d, 0x3c, 0xc7, 0xe7, 0x06, 0x28, 0x34, 0x27, 0xde, 0xed, 0x4e, 0xc5, 0xb4, 0xcc, 0x5e,
+	0xed, 0x49, 0xa6, 0x89, 0x56, 0x24, 0xd1, 0xbc, 0xe3, 0xb4, 0x25, 0x6a, 0x12, 0xfc, 0x6c, 0x80,
+	0xb1, 0xd6, 0xf7, 0xac, 0x63, 0xc1, 0x16, 0xb9, 0xfd, 0xd6, 0x33, 0xc9, 0x35, 0xe7, 0xba, 0xe4,
+	0xbc, 0xe3, 0xac, 0xb4, 0x3f, 0xcb, 0x16, 0xb2, 0xef, 0x0d, 0x30, 0x72, 0xe9, 0x35, 0xeb, 0xd2,
+	0xbe, 0x79, 0xad, 0xbd, 0xde, 0xbb, 0x56, 0x63, 0x7a, 0x12, 0x73, 0xd9, 0x59, 0xec, 0x70, 0xc0,
+	0xf9, 0xc0, 0xad, 0xfb, 0x8f, 0xcf, 0x4a, 0xc6, 0x93, 0xb3, 0x92, 0xf1, 0xf7, 0x59, 0xc9, 0xf8,
+	0xf2, 0xbc, 0xd4, 0xf7, 0xe4, 0xbc, 0xd4, 0xf7, 0xe7, 0x79, 0xa9, 0xef, 0xc3, 0xbb, 0xb9, 0x99,
+	0x1d, 0xa3, 0x24, 0xc2, 0x64, 0x95, 0x20, 0xfe, 0x80, 0x26, 0xc7, 0x2a, 0xf7, 0x2a, 0x81, 0x1c,
+	0x9f, 0x20, 0xef, 0x64, 0xdd, 0x3b, 0x6d, 0xd6, 0x91, 0xc3, 0xfc, 0x70, 0x40, 0xfe, 0x74, 0x79,
+	0xf3, 0xbf, 0x01, 0x00, 0xf7, 0x8d, 0x00, 0xe1, 0xc4, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1423,6 +1444,11 @@ func (m *MsgClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxEntries != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.MaxEntries))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.DelegatorAddress) > 0 {
 		i -= len(m.DelegatorAddress)
 		copy(dAtA[i:], m.DelegatorAddress)
@@ -1453,6 +1479,16 @@ func (m *MsgClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Remaining {
+		i--
+		if m.Remaining {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1860,6 +1896,9 @@ func (m *MsgClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	if m.MaxEntries != 0 {
+		n += 1 + sovMsgs(uint64(m.MaxEntries))
+	}
 	return n
 }
 
@@ -1869,6 +1908,9 @@ func (m *MsgClaimResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Remaining {
+		n += 2
+	}
 	return n
 }
 
@@ -2561,6 +2603,25 @@ func (m *MsgClaim) Unmarshal(dAtA []byte) error {
 			}
 			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			}
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -2611,6 +2672,26 @@ func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remaining = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -1,35 +1,69 @@
 package types
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+// Parameter store keys
+var (
+	KeyDelegationStrategy = []byte("DelegationStrategy")
+	KeyBaseMsgFee         = []byte("BaseMsgFee")
+	KeyMsgFeeMultipliers  = []byte("MsgFeeMultipliers")
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(delegationStrategy DelegationStrategyType, baseMsgFee sdk.Int, msgFeeMultipliers []MsgFeeMultiplier) Params {
+	return Params{
+		DelegationStrategy: delegationStrategy,
+		BaseMsgFee:         baseMsgFee,
+		MsgFeeMultipliers:  msgFeeMultipliers,
+	}
 }
 
-// DefaultParams returns a default set of parameters
+// DefaultParams returns a default set of parameters, no minimum fee is required per message
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DelegationStrategyWeightedDiff, sdk.ZeroInt(), nil)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDelegationStrategy, &p.DelegationStrategy, validateDelegationStrategy),
+		paramtypes.NewParamSetPair(KeyBaseMsgFee, &p.BaseMsgFee, validateBaseMsgFee),
+		paramtypes.NewParamSetPair(KeyMsgFeeMultipliers, &p.MsgFeeMultipliers, validateMsgFeeMultipliers),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	if err := validateDelegationStrategy(p.DelegationStrategy); err != nil {
+		return err
+	}
+	if err := validateBaseMsgFee(p.BaseMsgFee); err != nil {
+		return err
+	}
+	return validateMsgFeeMultipliers(p.MsgFeeMultipliers)
+}
+
+// MsgFeeMultiplier returns the minimum fee multiplier of the msg type url, zero if it has none
+func (p Params) MsgFeeMultiplier(msgTypeURL string) sdk.Dec {
+	for _, msgFeeMultiplier := range p.MsgFeeMultipliers {
+		if msgFeeMultiplier.MsgTypeUrl == msgTypeURL {
+			return msgFeeMultiplier.Multiplier
+		}
+	}
+	return sdk.ZeroDec()
 }
 
 // String implements the Stringer interface.
@@ -37,3 +71,47 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateDelegationStrategy(i interface{}) error {
+	v, ok := i.(DelegationStrategyType)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, ok := DelegationStrategyType_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid delegation strategy: %d", v)
+	}
+	return nil
+}
+
+func validateBaseMsgFee(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// genesis files exported before the base msg fee was introduced leave it unset
+	if !v.IsNil() && v.IsNegative() {
+		return fmt.Errorf("base msg fee must be non negative: %s", v)
+	}
+	return nil
+}
+
+func validateMsgFeeMultipliers(i interface{}) error {
+	v, ok := i.([]MsgFeeMultiplier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	msgTypeURLs := make(map[string]bool, len(v))
+	for _, msgFeeMultiplier := range v {
+		if msgFeeMultiplier.MsgTypeUrl == "" {
+			return fmt.Errorf("msg fee multiplier msg type url cannot be empty")
+		}
+		if msgTypeURLs[msgFeeMultiplier.MsgTypeUrl] {
+			return fmt.Errorf("duplicate msg fee multiplier for %s", msgFeeMultiplier.MsgTypeUrl)
+		}
+		msgTypeURLs[msgFeeMultiplier.MsgTypeUrl] = true
+		if msgFeeMultiplier.Multiplier.IsNil() || msgFeeMultiplier.Multiplier.IsNegative() {
+			return fmt.Errorf("msg fee multiplier for %s must be non negative", msgFeeMultiplier.MsgTypeUrl)
+		}
+	}
+	return nil
+}
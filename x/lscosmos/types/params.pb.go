@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -23,14 +25,61 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DelegationStrategyType defines how delegations and undelegations are divided
+// across the allow listed validator set.
+type DelegationStrategyType int32
+
+const (
+	// DELEGATION_STRATEGY_WEIGHTED_DIFF moves every validator towards its target
+	// weight, delegating to the most under-delegated validators first.
+	DelegationStrategyWeightedDiff DelegationStrategyType = 0
+	// DELEGATION_STRATEGY_PRO_RATA divides the amount by target weight,
+	// regardless of the current delegations.
+	DelegationStrategyProRata DelegationStrategyType = 1
+	// DELEGATION_STRATEGY_MIN_MESSAGES touches the fewest validators per epoch.
+	DelegationStrategyMinMessages DelegationStrategyType = 2
+	// DELEGATION_STRATEGY_UNBONDING_AWARE is the weighted diff strategy which
+	// undelegates from the validators having the fewest open unbonding entries.
+	DelegationStrategyUnbondingAware DelegationStrategyType = 3
+)
+
+var DelegationStrategyType_name = map[int32]string{
+	0: "DELEGATION_STRATEGY_WEIGHTED_DIFF",
+	1: "DELEGATION_STRATEGY_PRO_RATA",
+	2: "DELEGATION_STRATEGY_MIN_MESSAGES",
+	3: "DELEGATION_STRATEGY_UNBONDING_AWARE",
+}
+
+var DelegationStrategyType_value = map[string]int32{
+	"DELEGATION_STRATEGY_WEIGHTED_DIFF":   0,
+	"DELEGATION_STRATEGY_PRO_RATA":        1,
+	"DELEGATION_STRATEGY_MIN_MESSAGES":    2,
+	"DELEGATION_STRATEGY_UNBONDING_AWARE": 3,
+}
+
+func (x DelegationStrategyType) String() string {
+	return proto.EnumName(DelegationStrategyType_name, int32(x))
+}
+
+func (DelegationStrategyType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_203d5c3c13ab4b4d, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
+	DelegationStrategy DelegationStrategyType `protobuf:"varint,1,opt,name=delegation_strategy,json=delegationStrategy,proto3,enum=estake.lscosmos.v1beta1.DelegationStrategyType" json:"delegation_strategy,omitempty" yaml:"delegation_strategy"`
+	// base_msg_fee is the minimum fee in the native denom of a message with a
+	// multiplier of one
+	BaseMsgFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=base_msg_fee,json=baseMsgFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_msg_fee" yaml:"base_msg_fee"`
+	// msg_fee_multipliers scale the base_msg_fee per message type, message
+	// types without a multiplier do not require a minimum fee
+	MsgFeeMultipliers []MsgFeeMultiplier `protobuf:"bytes,3,rep,name=msg_fee_multipliers,json=msgFeeMultipliers,proto3" json:"msg_fee_multipliers" yaml:"msg_fee_multipliers"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_079f228748144235, []int{0}
+	return fileDescriptor_203d5c3c13ab4b4d, []int{0}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -59,28 +108,117 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetDelegationStrategy() DelegationStrategyType {
+	if m != nil {
+		return m.DelegationStrategy
+	}
+	return DelegationStrategyWeightedDiff
+}
+
+func (m *Params) GetMsgFeeMultipliers() []MsgFeeMultiplier {
+	if m != nil {
+		return m.MsgFeeMultipliers
+	}
+	return nil
+}
+
+// MsgFeeMultiplier is the minimum fee multiplier of a message type
+type MsgFeeMultiplier struct {
+	MsgTypeUrl string                                 `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier"`
+}
+
+func (m *MsgFeeMultiplier) Reset()         { *m = MsgFeeMultiplier{} }
+func (m *MsgFeeMultiplier) String() string { return proto.CompactTextString(m) }
+func (*MsgFeeMultiplier) ProtoMessage()    {}
+func (*MsgFeeMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_203d5c3c13ab4b4d, []int{1}
+}
+func (m *MsgFeeMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFeeMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFeeMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFeeMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeeMultiplier.Merge(m, src)
+}
+func (m *MsgFeeMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFeeMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeeMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFeeMultiplier proto.InternalMessageInfo
+
+func (m *MsgFeeMultiplier) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("estake.lscosmos.v1beta1.DelegationStrategyType", DelegationStrategyType_name, DelegationStrategyType_value)
 	proto.RegisterType((*Params)(nil), "estake.lscosmos.v1beta1.Params")
+	proto.RegisterType((*MsgFeeMultiplier)(nil), "estake.lscosmos.v1beta1.MsgFeeMultiplier")
 }
 
 func init() {
-	proto.RegisterFile("estake/lscosmos/v1beta1/params.proto", fileDescriptor_079f228748144235)
+	proto.RegisterFile("estake/lscosmos/v1beta1/params.proto", fileDescriptor_203d5c3c13ab4b4d)
 }
 
-var fileDescriptor_079f228748144235 = []byte{
-	// 180 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0x28, 0x2e, 0x49,
-	0xcc, 0x4e, 0xd5, 0xcf, 0x29, 0x4e, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x87, 0xa8, 0xd2, 0x83, 0xa9, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0xab, 0xd1, 0x07, 0xb1, 0x20, 0xca, 0x95, 0xf8, 0xb8, 0xd8, 0x02, 0xc0, 0xda, 0xad, 0x58,
-	0x66, 0x2c, 0x90, 0x67, 0x70, 0x0a, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07,
-	0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86,
-	0x28, 0xeb, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0x82, 0xd4, 0xa2,
-	0xe2, 0xcc, 0xe2, 0x92, 0xd4, 0xbc, 0xe4, 0x54, 0xff, 0xbc, 0x54, 0x7d, 0x88, 0x95, 0xba, 0x79,
-	0x89, 0x25, 0x99, 0x65, 0xa9, 0xfa, 0x65, 0x46, 0xfa, 0x15, 0x08, 0x47, 0x96, 0x54, 0x16, 0xa4,
-	0x16, 0x27, 0xb1, 0x81, 0x6d, 0x33, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x9f, 0xb8, 0x80,
-	0xc4, 0x00, 0x00, 0x00,
+var fileDescriptor_203d5c3c13ab4b4d = []byte{
+	// 620 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x3f, 0x6b, 0xdb, 0x5c,
+	0x14, 0xc6, 0xa5, 0x38, 0x04, 0x72, 0xdf, 0xf0, 0xe2, 0x2a, 0xa5, 0x49, 0x44, 0x23, 0x2b, 0x6a,
+	0x28, 0x69, 0xc1, 0x12, 0x49, 0xa7, 0xa6, 0x85, 0x22, 0x23, 0xc5, 0x15, 0x54, 0x4e, 0x90, 0x6d,
+	0x42, 0x4b, 0xe1, 0x72, 0x6d, 0x9f, 0x28, 0x22, 0xfa, 0x63, 0x74, 0xaf, 0x93, 0x7a, 0x28, 0x74,
+	0x6b, 0xf1, 0xd4, 0xb1, 0x8b, 0xa1, 0xd0, 0xb1, 0x6b, 0x3f, 0x41, 0xa7, 0x8c, 0xa1, 0x53, 0xe9,
+	0x60, 0x4a, 0xf2, 0x0d, 0xf2, 0x09, 0x8a, 0x2d, 0x35, 0x36, 0xb1, 0x32, 0x74, 0x92, 0xc4, 0x79,
+	0x9e, 0xdf, 0xb9, 0xcf, 0x39, 0x92, 0xd0, 0x3a, 0x50, 0x46, 0x8e, 0x40, 0xf3, 0x69, 0x33, 0xa2,
+	0x41, 0x44, 0xb5, 0xe3, 0xcd, 0x06, 0x30, 0xb2, 0xa9, 0xb5, 0x49, 0x4c, 0x02, 0xaa, 0xb6, 0xe3,
+	0x88, 0x45, 0xc2, 0x52, 0xa2, 0x52, 0xff, 0xaa, 0xd4, 0x54, 0x25, 0xde, 0x76, 0x23, 0x37, 0x1a,
+	0x69, 0xb4, 0xe1, 0x5d, 0x22, 0x17, 0x57, 0x12, 0x15, 0x4e, 0x0a, 0xa9, 0x65, 0xf4, 0xa0, 0xbc,
+	0xcf, 0xa1, 0xb9, 0xbd, 0x11, 0x5a, 0x78, 0xc7, 0xa3, 0xc5, 0x16, 0xf8, 0xe0, 0x12, 0xe6, 0x45,
+	0x21, 0xa6, 0x2c, 0x26, 0x0c, 0xdc, 0xee, 0x32, 0x2f, 0xf3, 0x1b, 0xff, 0x6f, 0x69, 0xea, 0x0d,
+	0x3d, 0x55, 0xe3, 0xca, 0x53, 0x4d, 0x2d, 0xb5, 0x6e, 0x1b, 0x4a, 0xd2, 0xe5, 0xa0, 0x20, 0x76,
+	0x49, 0xe0, 0x6f, 0x2b, 0x19, 0x54, 0xc5, 0x11, 0x5a, 0x53, 0x3e, 0xa1, 0x83, 0x16, 0x1a, 0x84,
+	0x02, 0x0e, 0xa8, 0x8b, 0x0f, 0x00, 0x96, 0x67, 0x64, 0x7e, 0x63, 0xbe, 0x54, 0x3d, 0x1d, 0x14,
+	0xb8, 0x5f, 0x83, 0xc2, 0x7d, 0xd7, 0x63, 0x87, 0x9d, 0x86, 0xda, 0x8c, 0x82, 0x34, 0x44, 0x7a,
+	0x29, 0xd2, 0xd6, 0x91, 0xc6, 0xba, 0x6d, 0xa0, 0xaa, 0x15, 0xb2, 0xcb, 0x41, 0x61, 0x31, 0xe9,
+	0x3b, 0xc9, 0x52, 0x7e, 0x7c, 0x2b, 0xa2, 0xf4, 0xe4, 0x56, 0xc8, 0x1c, 0x34, 0x2c, 0xda, 0xd4,
+	0xdd, 0x01, 0x10, 0xde, 0xa2, 0xc5, 0x54, 0x85, 0x83, 0x8e, 0xcf, 0xbc, 0xb6, 0xef, 0x41, 0x4c,
+	0x97, 0x73, 0x72, 0x6e, 0xe3, 0xbf, 0xad, 0x07, 0x37, 0x06, 0x4f, 0xdc, 0xf6, 0x95, 0xa3, 0xa4,
+	0x0c, 0x0f, 0x3a, 0x8e, 0x9d, 0xc1, 0x54, 0x9c, 0x5b, 0xc1, 0x35, 0x17, 0xdd, 0x9e, 0xfd, 0xf4,
+	0xb9, 0xc0, 0x29, 0x5f, 0x79, 0x94, 0xbf, 0x4e, 0x14, 0x1e, 0xa3, 0x85, 0x21, 0x65, 0x98, 0x0d,
+	0x77, 0x62, 0x7f, 0xb4, 0x8b, 0xf9, 0xd2, 0xd2, 0x38, 0xe2, 0x64, 0x55, 0x71, 0x50, 0x40, 0xdd,
+	0xe1, 0xf0, 0xeb, 0xb1, 0x2f, 0xbc, 0x46, 0x68, 0xdc, 0x38, 0x9d, 0xe4, 0xd3, 0x7f, 0x98, 0xa4,
+	0x01, 0xcd, 0x89, 0x91, 0x19, 0xd0, 0x74, 0x26, 0x78, 0x0f, 0xbf, 0xcf, 0xa0, 0x3b, 0xd9, 0x8b,
+	0x17, 0x2c, 0xb4, 0x66, 0x98, 0x2f, 0xcc, 0xb2, 0x5e, 0xb3, 0x76, 0x2b, 0xb8, 0x5a, 0x73, 0xf4,
+	0x9a, 0x59, 0x7e, 0x89, 0xf7, 0x4d, 0xab, 0xfc, 0xbc, 0x66, 0x1a, 0xd8, 0xb0, 0x76, 0x76, 0xf2,
+	0x9c, 0xa8, 0xf4, 0xfa, 0xb2, 0x34, 0x8d, 0xd8, 0x07, 0xcf, 0x3d, 0x64, 0xd0, 0x32, 0xbc, 0x83,
+	0x03, 0xe1, 0x19, 0xba, 0x9b, 0x85, 0xda, 0x73, 0x76, 0xb1, 0xa3, 0xd7, 0xf4, 0x3c, 0x2f, 0xae,
+	0xf6, 0xfa, 0xf2, 0xca, 0x34, 0x65, 0x2f, 0x8e, 0x1c, 0xc2, 0x88, 0x50, 0x46, 0x72, 0x16, 0xc0,
+	0xb6, 0x2a, 0xd8, 0x36, 0xab, 0x55, 0xbd, 0x6c, 0x56, 0xf3, 0x33, 0xe2, 0x5a, 0xaf, 0x2f, 0xaf,
+	0x4e, 0x43, 0x6c, 0x2f, 0xb4, 0x81, 0x52, 0xe2, 0x02, 0x15, 0x6c, 0x74, 0x2f, 0x0b, 0x54, 0xaf,
+	0x94, 0x76, 0x2b, 0x86, 0x55, 0x29, 0x63, 0x7d, 0x5f, 0x77, 0xcc, 0x7c, 0x4e, 0x5c, 0xef, 0xf5,
+	0x65, 0x79, 0x9a, 0x55, 0x0f, 0x1b, 0x51, 0xd8, 0xf2, 0x42, 0x57, 0x3f, 0x21, 0x31, 0x88, 0xb3,
+	0x1f, 0xbe, 0x48, 0x5c, 0xa9, 0x7e, 0x7a, 0x2e, 0xf1, 0x67, 0xe7, 0x12, 0xff, 0xfb, 0x5c, 0xe2,
+	0x3f, 0x5e, 0x48, 0xdc, 0xd9, 0x85, 0xc4, 0xfd, 0xbc, 0x90, 0xb8, 0x57, 0x4f, 0x26, 0x16, 0x14,
+	0x40, 0xec, 0x7b, 0x61, 0x31, 0x04, 0x76, 0x12, 0xc5, 0x47, 0x5a, 0xf2, 0x36, 0x16, 0x43, 0xc2,
+	0xbc, 0x63, 0xd0, 0x8e, 0xb7, 0xb4, 0x37, 0xe3, 0x9f, 0xc5, 0x68, 0x73, 0x8d, 0xb9, 0xd1, 0xa7,
+	0xfd, 0xe8, 0xcf, 0x00, 0x1a, 0x44, 0x5f, 0x13, 0x4c, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -103,6 +241,75 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgFeeMultipliers) > 0 {
+		for iNdEx := len(m.MsgFeeMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFeeMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.BaseMsgFee.Size()
+		i -= size
+		if _, err := m.BaseMsgFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.DelegationStrategy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DelegationStrategy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFeeMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeeMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeeMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -123,6 +330,32 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.DelegationStrategy != 0 {
+		n += 1 + sovParams(uint64(m.DelegationStrategy))
+	}
+	l = m.BaseMsgFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.MsgFeeMultipliers) > 0 {
+		for _, e := range m.MsgFeeMultipliers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFeeMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -161,6 +394,209 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationStrategy", wireType)
+			}
+			m.DelegationStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationStrategy |= DelegationStrategyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseMsgFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseMsgFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFeeMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFeeMultipliers = append(m.MsgFeeMultipliers, MsgFeeMultiplier{})
+			if err := m.MsgFeeMultipliers[len(m.MsgFeeMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFeeMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFeeMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFeeMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
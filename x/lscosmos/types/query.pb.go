@@ -8,7 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -1437,6 +1437,206 @@ func (m *QueryAllDelegatorUnbondingEpochEntriesResponse) GetDelegatorUnbondingEp
 	return nil
 }
 
+// QueryUnbondingEpochEntriesRequest is a request for the
+// Query/UnbondingEpochEntries methods.
+type QueryUnbondingEpochEntriesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUnbondingEpochEntriesRequest) Reset()         { *m = QueryUnbondingEpochEntriesRequest{} }
+func (m *QueryUnbondingEpochEntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingEpochEntriesRequest) ProtoMessage()    {}
+func (*QueryUnbondingEpochEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{32}
+}
+func (m *QueryUnbondingEpochEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingEpochEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingEpochEntriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingEpochEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingEpochEntriesRequest.Merge(m, src)
+}
+func (m *QueryUnbondingEpochEntriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingEpochEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingEpochEntriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingEpochEntriesRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondingEpochEntriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryUnbondingEpochEntriesResponse is a response for the
+// Query/UnbondingEpochEntries methods.
+type QueryUnbondingEpochEntriesResponse struct {
+	DelegatorUnbondingEpochEntries []DelegatorUnbondingEpochEntry `protobuf:"bytes,1,rep,name=delegator_unbonding_epoch_entries,json=delegatorUnbondingEpochEntries,proto3" json:"delegator_unbonding_epoch_entries"`
+	Pagination                     *query.PageResponse            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUnbondingEpochEntriesResponse) Reset()         { *m = QueryUnbondingEpochEntriesResponse{} }
+func (m *QueryUnbondingEpochEntriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingEpochEntriesResponse) ProtoMessage()    {}
+func (*QueryUnbondingEpochEntriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{33}
+}
+func (m *QueryUnbondingEpochEntriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingEpochEntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingEpochEntriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingEpochEntriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingEpochEntriesResponse.Merge(m, src)
+}
+func (m *QueryUnbondingEpochEntriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingEpochEntriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingEpochEntriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingEpochEntriesResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingEpochEntriesResponse) GetDelegatorUnbondingEpochEntries() []DelegatorUnbondingEpochEntry {
+	if m != nil {
+		return m.DelegatorUnbondingEpochEntries
+	}
+	return nil
+}
+
+func (m *QueryUnbondingEpochEntriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryUnbondingEpochCValuesRequest is a request for the
+// Query/UnbondingEpochCValues methods.
+type QueryUnbondingEpochCValuesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUnbondingEpochCValuesRequest) Reset()         { *m = QueryUnbondingEpochCValuesRequest{} }
+func (m *QueryUnbondingEpochCValuesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingEpochCValuesRequest) ProtoMessage()    {}
+func (*QueryUnbondingEpochCValuesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{34}
+}
+func (m *QueryUnbondingEpochCValuesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingEpochCValuesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingEpochCValuesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingEpochCValuesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingEpochCValuesRequest.Merge(m, src)
+}
+func (m *QueryUnbondingEpochCValuesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingEpochCValuesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingEpochCValuesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingEpochCValuesRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondingEpochCValuesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryUnbondingEpochCValuesResponse is a response for the
+// Query/UnbondingEpochCValues methods.
+type QueryUnbondingEpochCValuesResponse struct {
+	UnbondingEpochCValues []UnbondingEpochCValue `protobuf:"bytes,1,rep,name=unbonding_epoch_c_values,json=unbondingEpochCValues,proto3" json:"unbonding_epoch_c_values"`
+	Pagination            *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUnbondingEpochCValuesResponse) Reset()         { *m = QueryUnbondingEpochCValuesResponse{} }
+func (m *QueryUnbondingEpochCValuesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingEpochCValuesResponse) ProtoMessage()    {}
+func (*QueryUnbondingEpochCValuesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_799833dfb4f243e6, []int{35}
+}
+func (m *QueryUnbondingEpochCValuesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingEpochCValuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingEpochCValuesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingEpochCValuesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingEpochCValuesResponse.Merge(m, src)
+}
+func (m *QueryUnbondingEpochCValuesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingEpochCValuesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingEpochCValuesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingEpochCValuesResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingEpochCValuesResponse) GetUnbondingEpochCValues() []UnbondingEpochCValue {
+	if m != nil {
+		return m.UnbondingEpochCValues
+	}
+	return nil
+}
+
+func (m *QueryUnbondingEpochCValuesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "estake.lscosmos.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "estake.lscosmos.v1beta1.QueryParamsResponse")
@@ -1470,6 +1670,10 @@ func init() {
 	proto.RegisterType((*QueryDepositModuleAccountResponse)(nil), "estake.lscosmos.v1beta1.QueryDepositModuleAccountResponse")
 	proto.RegisterType((*QueryAllDelegatorUnbondingEpochEntriesRequest)(nil), "estake.lscosmos.v1beta1.QueryAllDelegatorUnbondingEpochEntriesRequest")
 	proto.RegisterType((*QueryAllDelegatorUnbondingEpochEntriesResponse)(nil), "estake.lscosmos.v1beta1.QueryAllDelegatorUnbondingEpochEntriesResponse")
+	proto.RegisterType((*QueryUnbondingEpochEntriesRequest)(nil), "estake.lscosmos.v1beta1.QueryUnbondingEpochEntriesRequest")
+	proto.RegisterType((*QueryUnbondingEpochEntriesResponse)(nil), "estake.lscosmos.v1beta1.QueryUnbondingEpochEntriesResponse")
+	proto.RegisterType((*QueryUnbondingEpochCValuesRequest)(nil), "estake.lscosmos.v1beta1.QueryUnbondingEpochCValuesRequest")
+	proto.RegisterType((*QueryUnbondingEpochCValuesResponse)(nil), "estake.lscosmos.v1beta1.QueryUnbondingEpochCValuesResponse")
 }
 
 func init() {
@@ -1477,107 +1681,114 @@ func init() {
 }
 
 var fileDescriptor_799833dfb4f243e6 = []byte{
-	// 1587 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5b, 0x6f, 0xdc, 0xc4,
-	0x17, 0xcf, 0xa4, 0xff, 0x7f, 0x4a, 0x26, 0x41, 0x4d, 0x86, 0x84, 0x24, 0xdb, 0x76, 0x93, 0xb8,
-	0xb7, 0xf4, 0x12, 0xbb, 0x09, 0xbd, 0x97, 0x16, 0x72, 0xe9, 0x4d, 0x94, 0x92, 0xa6, 0x4d, 0x25,
-	0xca, 0xc5, 0x78, 0xbd, 0xd3, 0x8d, 0xa9, 0xd7, 0xe3, 0xda, 0xde, 0x40, 0x5a, 0x55, 0x02, 0x84,
-	0x90, 0xa8, 0x40, 0x20, 0x78, 0xe3, 0x8d, 0x07, 0xde, 0x10, 0x12, 0xbc, 0xc1, 0x07, 0x40, 0x05,
-	0x09, 0x51, 0x09, 0x09, 0x21, 0x1e, 0x2a, 0x68, 0xf9, 0x20, 0xc8, 0xe3, 0x63, 0xc7, 0x6b, 0x7b,
-	0xec, 0xcd, 0x86, 0xa7, 0x36, 0x73, 0x6e, 0xbf, 0xdf, 0x99, 0xe3, 0x39, 0xe7, 0x2c, 0xde, 0x61,
-	0xbb, 0x9e, 0x76, 0x93, 0x2a, 0xa6, 0xab, 0x33, 0xb7, 0xce, 0x5c, 0x65, 0x65, 0xaa, 0x42, 0x3d,
-	0x6d, 0x4a, 0xb9, 0xd5, 0xa0, 0xce, 0xaa, 0x6c, 0x3b, 0xcc, 0x63, 0x64, 0x28, 0x50, 0x92, 0x43,
-	0x25, 0x19, 0x94, 0x4a, 0x03, 0x35, 0x56, 0x63, 0x5c, 0x47, 0xf1, 0xff, 0x17, 0xa8, 0x97, 0xb6,
-	0xd5, 0x18, 0xab, 0x99, 0x54, 0xd1, 0x6c, 0x43, 0xd1, 0x2c, 0x8b, 0x79, 0x9a, 0x67, 0x30, 0xcb,
-	0x05, 0xe9, 0x3e, 0x08, 0x54, 0xd1, 0x5c, 0x1a, 0x44, 0x89, 0x62, 0xda, 0x5a, 0xcd, 0xb0, 0xb8,
-	0x32, 0xe8, 0xee, 0x14, 0xa1, 0xb3, 0x35, 0x47, 0xab, 0x87, 0x1e, 0xa7, 0x44, 0x5a, 0x35, 0xb6,
-	0x42, 0x1d, 0x4b, 0xb3, 0x74, 0xaa, 0xda, 0x0e, 0xb3, 0x99, 0xab, 0x99, 0x60, 0xb2, 0x5b, 0x64,
-	0x12, 0x51, 0x0c, 0xf4, 0xca, 0x71, 0xb0, 0xa1, 0x8e, 0xce, 0x8c, 0x10, 0xe0, 0x28, 0x50, 0xe5,
-	0x7f, 0x55, 0x1a, 0x37, 0x14, 0xcf, 0xa8, 0x53, 0xd7, 0xd3, 0xea, 0x76, 0xa0, 0x20, 0x0d, 0x60,
-	0x72, 0xd9, 0xe7, 0xb8, 0xc0, 0x01, 0x2f, 0xd2, 0x5b, 0x0d, 0xea, 0x7a, 0xd2, 0x55, 0xfc, 0x54,
-	0xd3, 0xa9, 0x6b, 0x33, 0xcb, 0xa5, 0xe4, 0x14, 0xee, 0x0a, 0x88, 0x0d, 0xa3, 0x31, 0x34, 0xd1,
-	0x33, 0x3d, 0x2a, 0x0b, 0x12, 0x2f, 0x07, 0x86, 0xb3, 0xff, 0xbb, 0xff, 0x70, 0xb4, 0x63, 0x11,
-	0x8c, 0xa4, 0xed, 0x78, 0x2b, 0xf7, 0x7a, 0x9e, 0xb9, 0xde, 0xdc, 0xb2, 0x66, 0x58, 0xcd, 0x41,
-	0x6f, 0xe3, 0x6d, 0xd9, 0x62, 0x88, 0x7e, 0x1d, 0xf7, 0x2f, 0x33, 0xd7, 0x53, 0x75, 0x5f, 0xa6,
-	0x36, 0x01, 0x99, 0x10, 0x02, 0x49, 0x38, 0x03, 0x44, 0x5b, 0x96, 0x9b, 0x8f, 0x23, 0x68, 0xf3,
-	0xd4, 0xa4, 0x35, 0x7e, 0xc3, 0x57, 0x3c, 0xcd, 0xa3, 0x21, 0xb4, 0x55, 0x80, 0x96, 0x12, 0x03,
-	0xb4, 0x97, 0x71, 0x5f, 0x35, 0x12, 0xa9, 0xae, 0x2f, 0x2b, 0x44, 0x96, 0xf0, 0x15, 0x22, 0xab,
-	0x36, 0x1f, 0x4b, 0x3b, 0xf0, 0x38, 0x0f, 0x3d, 0x63, 0x9a, 0xec, 0xad, 0x8b, 0x86, 0xeb, 0xd1,
-	0xea, 0x35, 0xcd, 0x34, 0xaa, 0x9a, 0xc7, 0x9c, 0x28, 0x75, 0x9f, 0x21, 0x2c, 0xe5, 0x69, 0x01,
-	0x4c, 0x13, 0x0f, 0x69, 0xbe, 0x82, 0x6a, 0x72, 0x0d, 0x75, 0x25, 0x52, 0x01, 0xb4, 0xb2, 0x10,
-	0x6d, 0xa6, 0x63, 0xc0, 0x3c, 0xa8, 0x65, 0x09, 0xa3, 0xd2, 0x9a, 0xbb, 0xa6, 0x99, 0x8d, 0x28,
-	0x95, 0xaf, 0x43, 0x69, 0x85, 0xa7, 0x00, 0xed, 0x1c, 0xde, 0xac, 0xfb, 0x78, 0x1a, 0x41, 0xe2,
-	0xba, 0x67, 0x65, 0xdf, 0xf5, 0x9f, 0x0f, 0x47, 0x77, 0xd7, 0x0c, 0x6f, 0xb9, 0x51, 0x91, 0x75,
-	0x56, 0x57, 0xa0, 0xd8, 0x83, 0x7f, 0x26, 0xdd, 0xea, 0x4d, 0xc5, 0x5b, 0xb5, 0xa9, 0x2b, 0xcf,
-	0x53, 0x7d, 0xb1, 0x4b, 0xe7, 0x0e, 0xa5, 0x11, 0x3c, 0xc4, 0xfd, 0xbf, 0xc8, 0xaa, 0x0d, 0x93,
-	0x36, 0xdd, 0xe2, 0x29, 0x3c, 0x9c, 0x16, 0x41, 0xfc, 0x71, 0xdc, 0x5b, 0xe7, 0xc7, 0xb1, 0xdb,
-	0x7b, 0x62, 0xb1, 0xa7, 0xbe, 0xa6, 0x2a, 0x8d, 0xe2, 0xed, 0xdc, 0xfc, 0xc2, 0xec, 0xdc, 0x55,
-	0x47, 0xb3, 0x5c, 0x83, 0x5a, 0xde, 0x15, 0x8f, 0x39, 0x91, 0xff, 0x7b, 0x08, 0x97, 0x45, 0x1a,
-	0x10, 0x66, 0x19, 0x0f, 0x1a, 0x6a, 0x45, 0xd5, 0x55, 0x2f, 0x94, 0xab, 0xae, 0xaf, 0x00, 0xf9,
-	0x3f, 0x28, 0xcc, 0xff, 0x85, 0xd9, 0xb9, 0x99, 0x3a, 0x6b, 0x58, 0x5e, 0xb3, 0x63, 0xb8, 0x81,
-	0x7e, 0x23, 0x19, 0x51, 0x9a, 0xc7, 0x83, 0x1c, 0xcb, 0x92, 0xa5, 0x9b, 0x9a, 0x51, 0xa7, 0x55,
-	0x40, 0x49, 0xf6, 0xe3, 0x7e, 0xa8, 0x31, 0xe6, 0xa8, 0x5a, 0xb5, 0xea, 0x50, 0x37, 0xb8, 0xfe,
-	0xee, 0xc5, 0xbe, 0x48, 0x30, 0x13, 0x9c, 0x4b, 0x37, 0xf1, 0xd3, 0x49, 0x2f, 0xc0, 0xe4, 0x32,
-	0xee, 0x6e, 0x84, 0x87, 0xc3, 0x68, 0x6c, 0xd3, 0x44, 0xcf, 0xf4, 0xa4, 0x10, 0xfd, 0x92, 0x55,
-	0x61, 0x56, 0xd5, 0xb0, 0x6a, 0x67, 0x6c, 0xa6, 0x2f, 0x07, 0x57, 0x0f, 0xd0, 0xd7, 0xbc, 0x48,
-	0x2f, 0xc0, 0x57, 0x76, 0x56, 0x33, 0x4c, 0x5a, 0x8d, 0x6c, 0xdc, 0xb6, 0x90, 0xbf, 0x8b, 0xe0,
-	0xba, 0xd2, 0xde, 0x80, 0xc1, 0x1b, 0xb8, 0xff, 0x06, 0x97, 0xa9, 0x8d, 0x48, 0xb8, 0x11, 0x26,
-	0x7d, 0x37, 0x12, 0x91, 0xa4, 0x8b, 0x00, 0x61, 0x81, 0xf2, 0x83, 0x0d, 0x32, 0x7a, 0x3f, 0x2c,
-	0xaf, 0x0c, 0x77, 0x40, 0xa9, 0x82, 0x89, 0x1d, 0x08, 0xff, 0x23, 0x4e, 0xfd, 0x76, 0x32, 0x96,
-	0x74, 0x06, 0x8f, 0x41, 0x49, 0xa4, 0xad, 0x42, 0x5e, 0xe3, 0xb8, 0x97, 0xfa, 0xa7, 0xaa, 0xd5,
-	0xa8, 0x57, 0xa8, 0xc3, 0x29, 0x6d, 0x5a, 0xec, 0xe1, 0x67, 0x97, 0xf8, 0x91, 0xf4, 0x09, 0x82,
-	0x87, 0x2d, 0xdb, 0x0f, 0x10, 0x7a, 0x13, 0x0f, 0x45, 0x44, 0xd4, 0xc0, 0x65, 0xfc, 0x99, 0x68,
-	0x93, 0xd5, 0x40, 0x23, 0x43, 0x26, 0x9d, 0xc7, 0x3b, 0xa2, 0xfe, 0x33, 0xa3, 0xeb, 0xfe, 0xc7,
-	0xb6, 0x64, 0xad, 0x3d, 0xc7, 0xeb, 0xe0, 0xf6, 0x05, 0xc2, 0x3b, 0xf3, 0x5d, 0x01, 0x3d, 0x07,
-	0x8f, 0xf0, 0x96, 0xa6, 0x05, 0x3a, 0x6a, 0x23, 0xa6, 0x54, 0xf8, 0x24, 0x08, 0x9c, 0x03, 0xc7,
-	0xa1, 0xe5, 0x6c, 0xb1, 0x74, 0x1b, 0x4f, 0xc4, 0x7b, 0x19, 0x73, 0x9a, 0x13, 0x75, 0xc6, 0xf2,
-	0x9c, 0xd5, 0x76, 0xea, 0x33, 0x95, 0x98, 0xce, 0x74, 0x62, 0xbe, 0x41, 0x78, 0x6f, 0x0b, 0xc1,
-	0x21, 0x3b, 0xef, 0x20, 0x5c, 0x5e, 0x0b, 0xef, 0xdf, 0x59, 0xac, 0x0c, 0xa8, 0xaf, 0x0a, 0x39,
-	0x3a, 0x5c, 0xd4, 0x64, 0x33, 0xe3, 0x40, 0xa2, 0xb6, 0x56, 0xe3, 0x3a, 0xcd, 0x2a, 0x52, 0x09,
-	0x5a, 0x46, 0x2c, 0xd7, 0x51, 0xd3, 0xad, 0xe3, 0x91, 0x0c, 0x19, 0x60, 0x5f, 0xc0, 0x4f, 0xc6,
-	0x6f, 0x36, 0x6c, 0xb0, 0xbb, 0x5a, 0xb9, 0xcd, 0xb0, 0xaf, 0xf6, 0xc6, 0xae, 0xd0, 0x95, 0x24,
-	0xf8, 0xee, 0xe6, 0xa9, 0xcd, 0x5c, 0xc3, 0x0b, 0x9a, 0x18, 0x48, 0xd7, 0x9a, 0xeb, 0x78, 0x8e,
-	0x0e, 0x40, 0x3b, 0x8e, 0x37, 0x57, 0x34, 0xd3, 0x9f, 0x3a, 0x01, 0xd4, 0x88, 0x0c, 0x58, 0xfc,
-	0x29, 0x32, 0x02, 0x34, 0xc7, 0x8c, 0xb0, 0x96, 0x42, 0x7d, 0xe9, 0x55, 0x3c, 0x19, 0x8e, 0x19,
-	0x39, 0x99, 0x35, 0x68, 0x7b, 0x0f, 0xdc, 0xf7, 0x08, 0xcb, 0xad, 0xba, 0x07, 0x2e, 0x1f, 0x20,
-	0x3c, 0xde, 0x5c, 0x22, 0x56, 0xa2, 0x46, 0x0c, 0x1a, 0x3e, 0x80, 0x1b, 0xaa, 0x92, 0x72, 0x35,
-	0x17, 0xd0, 0xf4, 0xaf, 0xc3, 0xf8, 0xff, 0x1c, 0x3b, 0xf9, 0x08, 0xe1, 0xae, 0x60, 0xaa, 0x24,
-	0xfb, 0x85, 0x11, 0xd3, 0x33, 0x77, 0xe9, 0x40, 0x6b, 0xca, 0x01, 0x71, 0x69, 0xcf, 0x7b, 0xbf,
-	0xfd, 0xf3, 0x79, 0xe7, 0x38, 0x19, 0x55, 0xf2, 0x57, 0x10, 0xf2, 0x1d, 0xc2, 0x5b, 0x12, 0x43,
-	0x30, 0x39, 0x94, 0x1f, 0x2a, 0x7b, 0x3e, 0x2f, 0x1d, 0x5e, 0xa7, 0x15, 0x20, 0x9d, 0xe6, 0x48,
-	0x0f, 0x90, 0x7d, 0x42, 0xa4, 0xa9, 0xa9, 0x9e, 0x7c, 0x8b, 0xf0, 0x96, 0xc4, 0x7c, 0x5c, 0x04,
-	0x3a, 0x7b, 0x72, 0x2f, 0x02, 0x2d, 0x18, 0xe8, 0xa5, 0x29, 0x0e, 0x7a, 0x3f, 0xd9, 0x2b, 0x04,
-	0x9d, 0x9c, 0xf7, 0xc9, 0xcf, 0x08, 0x0f, 0x66, 0x4e, 0xc9, 0xe4, 0x44, 0x3e, 0x86, 0xbc, 0xc9,
-	0xbe, 0x74, 0xb2, 0x2d, 0x5b, 0x60, 0x71, 0x8c, 0xb3, 0x98, 0x26, 0x07, 0x85, 0x2c, 0x04, 0xeb,
-	0x00, 0xf9, 0x18, 0xe1, 0xae, 0xa0, 0x2d, 0x16, 0x15, 0x71, 0x53, 0xe3, 0x2f, 0x2a, 0xe2, 0xe6,
-	0xee, 0x2e, 0x4d, 0x70, 0x7c, 0x12, 0x19, 0x13, 0xe2, 0x83, 0x66, 0x4f, 0xbe, 0x44, 0xb8, 0x27,
-	0x36, 0xb6, 0x93, 0x83, 0xf9, 0x71, 0xd2, 0xc3, 0x7f, 0x69, 0x6a, 0x1d, 0x16, 0x00, 0x6f, 0x92,
-	0xc3, 0xdb, 0x43, 0x76, 0x09, 0xe1, 0xc5, 0x57, 0x06, 0xf2, 0x03, 0xc2, 0xfd, 0xa9, 0xc9, 0x9f,
-	0x1c, 0xc9, 0x8f, 0x2b, 0x5a, 0x26, 0x4a, 0x47, 0xd7, 0x6d, 0x07, 0xa8, 0x0f, 0x71, 0xd4, 0x32,
-	0x39, 0x20, 0x44, 0x6d, 0x54, 0x52, 0xfb, 0x07, 0xf9, 0x1a, 0xe1, 0xee, 0x68, 0xc8, 0x27, 0x72,
-	0x7e, 0xf0, 0xe4, 0x4e, 0x51, 0x52, 0x5a, 0xd6, 0x07, 0x90, 0xa7, 0x39, 0xc8, 0x63, 0xe4, 0x88,
-	0x10, 0x64, 0xb4, 0x16, 0x28, 0x77, 0x52, 0x1d, 0xe4, 0x2e, 0xf9, 0x09, 0xe1, 0xbe, 0xe4, 0x60,
-	0x4f, 0x0a, 0xbe, 0x75, 0xc1, 0x5a, 0x51, 0x3a, 0xb2, 0x5e, 0x33, 0xe0, 0x70, 0x96, 0x73, 0x78,
-	0x9e, 0x9c, 0x16, 0x72, 0x48, 0xad, 0x17, 0x99, 0x5c, 0x7e, 0x41, 0xb8, 0x3f, 0x35, 0xd2, 0x17,
-	0xd5, 0x8d, 0x68, 0xa5, 0x28, 0xaa, 0x1b, 0xe1, 0xee, 0x20, 0x9d, 0xe3, 0x74, 0x66, 0xc8, 0x73,
-	0xe2, 0x8e, 0x92, 0x5a, 0x2d, 0x32, 0xf9, 0xfc, 0x8e, 0xf0, 0x40, 0xd6, 0xf0, 0x4d, 0x8e, 0x17,
-	0x55, 0x89, 0x70, 0xa1, 0x28, 0x9d, 0x68, 0xc7, 0xb4, 0x65, 0x62, 0x82, 0x15, 0x43, 0xb9, 0x13,
-	0x9f, 0x67, 0xef, 0x92, 0xbf, 0x11, 0x1e, 0x12, 0x0c, 0xdd, 0xe4, 0xd9, 0xe2, 0xe6, 0x28, 0xde,
-	0x29, 0x4a, 0xa7, 0xda, 0xb4, 0x06, 0x86, 0x17, 0x38, 0xc3, 0x39, 0x32, 0x93, 0xdf, 0x62, 0xb3,
-	0xb6, 0x8c, 0x24, 0xc7, 0x7b, 0x9d, 0x78, 0x5b, 0xde, 0x38, 0x44, 0x66, 0x5a, 0x6a, 0xa8, 0x79,
-	0x5b, 0x45, 0x69, 0x76, 0x23, 0x2e, 0x80, 0xb2, 0xce, 0x29, 0xbf, 0x46, 0x5e, 0x29, 0x6a, 0xd0,
-	0x82, 0xb1, 0x70, 0x35, 0xab, 0x74, 0x93, 0xc9, 0xf8, 0x0a, 0xe1, 0xde, 0xf8, 0x5c, 0x4e, 0xa6,
-	0x5a, 0xbe, 0xa7, 0xe8, 0x7b, 0x9c, 0x5e, 0x8f, 0x09, 0x90, 0x93, 0x39, 0xb9, 0x09, 0xb2, 0xbb,
-	0xa5, 0xfb, 0x74, 0xc9, 0x8f, 0x08, 0x0f, 0x64, 0x8d, 0xfc, 0x45, 0x5f, 0x5c, 0xce, 0x2a, 0x51,
-	0xf4, 0xc5, 0xe5, 0x6d, 0x18, 0xd2, 0x51, 0x8e, 0x7f, 0x8a, 0x28, 0x39, 0x97, 0xc3, 0xcd, 0x55,
-	0x68, 0xa0, 0xc0, 0x84, 0x7c, 0xd8, 0x89, 0xcb, 0xf9, 0x93, 0x3f, 0x39, 0x5b, 0x38, 0x10, 0xb5,
-	0xb4, 0x99, 0x94, 0xce, 0x6d, 0xd8, 0x0f, 0x90, 0xbd, 0xc6, 0xc9, 0x2e, 0x90, 0x4b, 0x6d, 0x56,
-	0xa2, 0x41, 0x33, 0x9f, 0xd1, 0xd9, 0xa5, 0xfb, 0x8f, 0xca, 0xe8, 0xc1, 0xa3, 0x32, 0xfa, 0xeb,
-	0x51, 0x19, 0x7d, 0xfa, 0xb8, 0xdc, 0xf1, 0xe0, 0x71, 0xb9, 0xe3, 0x8f, 0xc7, 0xe5, 0x8e, 0xeb,
-	0x27, 0x63, 0x3f, 0x89, 0xda, 0xd4, 0x71, 0xfd, 0xe9, 0xcd, 0xd2, 0xe9, 0x4b, 0x16, 0x05, 0x08,
-	0x93, 0x96, 0xe6, 0x19, 0x2b, 0x54, 0x59, 0x99, 0x56, 0xde, 0x5e, 0x83, 0xc3, 0x7f, 0x2b, 0xad,
-	0x74, 0xf1, 0xdf, 0xfd, 0x9f, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x70, 0x4e, 0x98, 0x59,
-	0x19, 0x00, 0x00,
+	// 1708 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xdb, 0x6f, 0x14, 0x55,
+	0x18, 0xef, 0x14, 0x2d, 0xf6, 0x6b, 0x0d, 0xed, 0xa1, 0xb5, 0xed, 0x50, 0xb6, 0xed, 0x00, 0xa5,
+	0x40, 0xbb, 0xc3, 0x96, 0x3b, 0x08, 0xda, 0x0b, 0x2d, 0x44, 0x24, 0xa5, 0x50, 0x12, 0xf1, 0x32,
+	0xce, 0xee, 0x1e, 0xb6, 0x63, 0x77, 0xe7, 0x2c, 0x33, 0xb3, 0xc5, 0x42, 0x48, 0xd4, 0x18, 0x13,
+	0x89, 0x46, 0xa3, 0x6f, 0xbe, 0xf9, 0xe0, 0x9b, 0x31, 0xd1, 0x37, 0xfd, 0x03, 0x0c, 0x6a, 0x4c,
+	0x48, 0x4c, 0x8c, 0xd1, 0x84, 0x28, 0xf8, 0xe4, 0x1f, 0xe0, 0xb3, 0xd9, 0x33, 0xdf, 0xcc, 0xce,
+	0xce, 0xce, 0x99, 0xbd, 0x94, 0x17, 0x9f, 0xa0, 0xe7, 0xbb, 0xfd, 0x7e, 0xdf, 0x39, 0x73, 0xbe,
+	0xf3, 0xcb, 0xc2, 0x2e, 0x6a, 0x3b, 0xfa, 0x1a, 0x55, 0xf3, 0x76, 0x86, 0xd9, 0x05, 0x66, 0xab,
+	0xeb, 0xa9, 0x34, 0x75, 0xf4, 0x94, 0x7a, 0xa3, 0x44, 0xad, 0x8d, 0x64, 0xd1, 0x62, 0x0e, 0x23,
+	0x03, 0xae, 0x53, 0xd2, 0x73, 0x4a, 0xa2, 0x93, 0xdc, 0x97, 0x63, 0x39, 0xc6, 0x7d, 0xd4, 0xf2,
+	0xff, 0x5c, 0x77, 0x79, 0x38, 0xc7, 0x58, 0x2e, 0x4f, 0x55, 0xbd, 0x68, 0xa8, 0xba, 0x69, 0x32,
+	0x47, 0x77, 0x0c, 0x66, 0xda, 0x68, 0xdd, 0x8f, 0x85, 0xd2, 0xba, 0x4d, 0xdd, 0x2a, 0x7e, 0xcd,
+	0xa2, 0x9e, 0x33, 0x4c, 0xee, 0x8c, 0xbe, 0xbb, 0x45, 0xe8, 0x8a, 0xba, 0xa5, 0x17, 0xbc, 0x8c,
+	0x29, 0x91, 0x57, 0x8e, 0xad, 0x53, 0xcb, 0xd4, 0xcd, 0x0c, 0xd5, 0x8a, 0x16, 0x2b, 0x32, 0x5b,
+	0xcf, 0x63, 0xc8, 0xb8, 0x28, 0xc4, 0xa7, 0xe8, 0xfa, 0x25, 0x82, 0x60, 0x3d, 0x9f, 0x0c, 0x33,
+	0x3c, 0x80, 0x23, 0x48, 0x95, 0xff, 0x95, 0x2e, 0x5d, 0x57, 0x1d, 0xa3, 0x50, 0x4e, 0x5d, 0x28,
+	0xba, 0x0e, 0x4a, 0x1f, 0x90, 0x4b, 0x65, 0x8e, 0x4b, 0x1c, 0xf0, 0x32, 0xbd, 0x51, 0xa2, 0xb6,
+	0xa3, 0x5c, 0x81, 0xed, 0x55, 0xab, 0x76, 0x91, 0x99, 0x36, 0x25, 0xa7, 0xa1, 0xc3, 0x25, 0x36,
+	0x28, 0x8d, 0x4a, 0x13, 0x5d, 0xd3, 0x23, 0x49, 0x41, 0xe3, 0x93, 0x6e, 0xe0, 0xec, 0x13, 0xf7,
+	0x1e, 0x8c, 0xb4, 0x2d, 0x63, 0x90, 0xb2, 0x13, 0x76, 0xf0, 0xac, 0xe7, 0x98, 0xed, 0xcc, 0xad,
+	0xea, 0x86, 0x59, 0x5d, 0xf4, 0x16, 0x0c, 0x47, 0x9b, 0xb1, 0xfa, 0x35, 0xe8, 0x5d, 0x65, 0xb6,
+	0xa3, 0x65, 0xca, 0x36, 0xad, 0x0a, 0xc8, 0x84, 0x10, 0x48, 0x28, 0x19, 0x22, 0xda, 0xb6, 0x5a,
+	0xbd, 0xec, 0x43, 0x9b, 0xa7, 0x79, 0x9a, 0xe3, 0x3b, 0x7c, 0xd9, 0xd1, 0x1d, 0xea, 0x41, 0xdb,
+	0x80, 0xe1, 0x68, 0x33, 0x42, 0x7b, 0x09, 0x7a, 0xb2, 0xbe, 0x49, 0xb3, 0xcb, 0xb6, 0xba, 0xc8,
+	0x42, 0xb9, 0x3c, 0x64, 0xd9, 0xea, 0x65, 0x65, 0x17, 0x8c, 0xf1, 0xd2, 0x33, 0xf9, 0x3c, 0xbb,
+	0x79, 0xc1, 0xb0, 0x1d, 0x9a, 0xbd, 0xaa, 0xe7, 0x8d, 0xac, 0xee, 0x30, 0xcb, 0x6f, 0xdd, 0x27,
+	0x12, 0x28, 0x71, 0x5e, 0x08, 0x33, 0x0f, 0x03, 0x7a, 0xd9, 0x41, 0xcb, 0x73, 0x0f, 0x6d, 0xdd,
+	0x77, 0x41, 0xb4, 0x49, 0x21, 0xda, 0xc8, 0xc4, 0x88, 0xb9, 0x5f, 0x8f, 0x32, 0xfa, 0x47, 0x6b,
+	0xee, 0xaa, 0x9e, 0x2f, 0xf9, 0xad, 0x7c, 0x0d, 0xb6, 0x57, 0xad, 0x22, 0xb4, 0x45, 0xd8, 0x9a,
+	0x29, 0xe3, 0x29, 0xb9, 0x8d, 0xeb, 0x9c, 0x4d, 0x96, 0x53, 0xff, 0xfe, 0x60, 0x64, 0x3c, 0x67,
+	0x38, 0xab, 0xa5, 0x74, 0x32, 0xc3, 0x0a, 0x2a, 0x1e, 0x76, 0xf7, 0x9f, 0x29, 0x3b, 0xbb, 0xa6,
+	0x3a, 0x1b, 0x45, 0x6a, 0x27, 0xe7, 0x69, 0x66, 0xb9, 0x23, 0xc3, 0x13, 0x2a, 0x43, 0x30, 0xc0,
+	0xf3, 0xbf, 0xc8, 0xb2, 0xa5, 0x3c, 0xad, 0xda, 0xc5, 0xd3, 0x30, 0x58, 0x6b, 0xc2, 0xfa, 0x63,
+	0xd0, 0x5d, 0xe0, 0xcb, 0x81, 0xdd, 0x7b, 0x6a, 0xb9, 0xab, 0x50, 0x71, 0x55, 0x46, 0x60, 0x27,
+	0x0f, 0x3f, 0x3f, 0x3b, 0x77, 0xc5, 0xd2, 0x4d, 0xdb, 0xa0, 0xa6, 0x73, 0xd9, 0x61, 0x96, 0x9f,
+	0xff, 0xae, 0x04, 0x09, 0x91, 0x07, 0x96, 0x59, 0x85, 0x7e, 0x43, 0x4b, 0x6b, 0x19, 0xcd, 0xf1,
+	0xec, 0x9a, 0x5d, 0x76, 0xc0, 0xfe, 0x1f, 0x14, 0xf6, 0xff, 0xfc, 0xec, 0xdc, 0x4c, 0x81, 0x95,
+	0x4c, 0xa7, 0x3a, 0x31, 0xee, 0x40, 0xaf, 0x11, 0xae, 0xa8, 0xcc, 0x43, 0x3f, 0xc7, 0xb2, 0x62,
+	0x66, 0xf2, 0xba, 0x51, 0xa0, 0x59, 0x44, 0x49, 0x0e, 0x40, 0x2f, 0x9e, 0x31, 0x66, 0x69, 0x7a,
+	0x36, 0x6b, 0x51, 0xdb, 0xdd, 0xfe, 0xce, 0xe5, 0x1e, 0xdf, 0x30, 0xe3, 0xae, 0x2b, 0x6b, 0xf0,
+	0x4c, 0x38, 0x0b, 0x32, 0xb9, 0x04, 0x9d, 0x25, 0x6f, 0x71, 0x50, 0x1a, 0xdd, 0x32, 0xd1, 0x35,
+	0x3d, 0x25, 0x44, 0xbf, 0x62, 0xa6, 0x99, 0x99, 0x35, 0xcc, 0xdc, 0xd9, 0x22, 0xcb, 0xac, 0xba,
+	0x5b, 0x8f, 0xd0, 0x2b, 0x59, 0x94, 0x17, 0xf0, 0x2b, 0x5b, 0xd0, 0x8d, 0x3c, 0xcd, 0xfa, 0x31,
+	0x76, 0x4b, 0xc8, 0xdf, 0x96, 0x60, 0xa7, 0x20, 0x1b, 0x32, 0x78, 0x1d, 0x7a, 0xaf, 0x73, 0x9b,
+	0x56, 0xf2, 0x8d, 0x9b, 0x61, 0xd2, 0x73, 0x3d, 0x54, 0x49, 0xb9, 0x80, 0x10, 0x96, 0x28, 0x5f,
+	0xd8, 0x24, 0xa3, 0x77, 0xbd, 0xe3, 0x15, 0x91, 0x0e, 0x29, 0xa5, 0x81, 0x14, 0x5d, 0xe3, 0x63,
+	0xe2, 0xd4, 0x5b, 0x0c, 0xd7, 0x52, 0xce, 0xc2, 0x28, 0x1e, 0x89, 0xda, 0x28, 0x8f, 0xd7, 0x18,
+	0x74, 0xd3, 0xf2, 0xaa, 0x66, 0x96, 0x0a, 0x69, 0x6a, 0x71, 0x4a, 0x5b, 0x96, 0xbb, 0xf8, 0xda,
+	0x45, 0xbe, 0xa4, 0x7c, 0x24, 0xc1, 0x58, 0x4c, 0x1e, 0x24, 0xf4, 0x06, 0x0c, 0xf8, 0x44, 0x34,
+	0x37, 0x65, 0xf0, 0x9a, 0x68, 0x91, 0x55, 0x5f, 0x29, 0xc2, 0xa6, 0x9c, 0x83, 0x5d, 0xfe, 0xfc,
+	0x99, 0xc9, 0x64, 0xca, 0x1f, 0xdb, 0x8a, 0x59, 0xb9, 0x8e, 0x9b, 0xe0, 0xf6, 0x99, 0x04, 0xbb,
+	0xe3, 0x53, 0x21, 0x3d, 0x0b, 0x86, 0xf8, 0x48, 0xd3, 0x5d, 0x1f, 0xad, 0x14, 0x70, 0xaa, 0x7b,
+	0x25, 0x08, 0x92, 0x23, 0xc7, 0x81, 0xd5, 0x68, 0xb3, 0x72, 0x0b, 0x26, 0x82, 0xb3, 0x8c, 0x59,
+	0xd5, 0x8d, 0x3a, 0x6b, 0x3a, 0xd6, 0x46, 0x2b, 0xe7, 0xb3, 0xa6, 0x31, 0xed, 0xb5, 0x8d, 0xf9,
+	0x4a, 0x82, 0x7d, 0x0d, 0x14, 0xc7, 0xee, 0xbc, 0x25, 0x41, 0xa2, 0x52, 0xbe, 0xbc, 0x67, 0x81,
+	0x63, 0x40, 0xcb, 0xae, 0xd8, 0xa3, 0x23, 0xf5, 0x86, 0x6c, 0x64, 0x1d, 0x6c, 0xd4, 0x8e, 0x6c,
+	0xd0, 0xa7, 0xda, 0x45, 0x91, 0x71, 0x64, 0x04, 0x7a, 0xed, 0x0f, 0xdd, 0x02, 0x0c, 0x45, 0xd8,
+	0x10, 0xfb, 0x12, 0x3c, 0x1d, 0xdc, 0x59, 0x6f, 0xc0, 0xee, 0x69, 0x64, 0x37, 0xbd, 0xb9, 0xda,
+	0x1d, 0xd8, 0x42, 0x5b, 0x51, 0xf0, 0xbb, 0x9b, 0xa7, 0x45, 0x66, 0x1b, 0x8e, 0x3b, 0xc4, 0xd0,
+	0x5a, 0x19, 0xae, 0x63, 0x31, 0x3e, 0x08, 0xed, 0x04, 0x6c, 0x4d, 0xeb, 0x79, 0xdd, 0xcc, 0x78,
+	0xdf, 0xd0, 0x50, 0x12, 0xb1, 0xa4, 0x75, 0x9b, 0xfa, 0x80, 0xe6, 0x98, 0xe1, 0x9d, 0x25, 0xcf,
+	0x5f, 0x79, 0x05, 0xa6, 0xbc, 0x67, 0x46, 0x4c, 0x67, 0x0d, 0xda, 0xda, 0x05, 0xf7, 0xad, 0x04,
+	0xc9, 0x46, 0xd3, 0x23, 0x97, 0xf7, 0x24, 0x18, 0xab, 0x3e, 0x22, 0x66, 0xe8, 0x8c, 0x18, 0xd4,
+	0xbb, 0x00, 0x37, 0x75, 0x4a, 0x12, 0xd9, 0x58, 0x40, 0xca, 0x5a, 0xe4, 0x6d, 0x16, 0xea, 0xc6,
+	0x02, 0x40, 0x45, 0x42, 0x60, 0xf3, 0xc7, 0xab, 0x9a, 0xef, 0xaa, 0x9a, 0xca, 0x2b, 0x3a, 0xe7,
+	0x5d, 0xa9, 0xcb, 0x81, 0x48, 0xe5, 0x5f, 0xef, 0xb9, 0xf7, 0xff, 0x68, 0x0e, 0x59, 0xac, 0xe2,
+	0xdd, 0xce, 0x79, 0xef, 0xad, 0xcb, 0xdb, 0x65, 0x51, 0x45, 0x7c, 0x2d, 0x66, 0x66, 0x3c, 0xf6,
+	0x2e, 0xff, 0x11, 0xdd, 0x65, 0xbf, 0x9a, 0xff, 0xa8, 0x1e, 0x14, 0x8c, 0xa8, 0x4d, 0x4d, 0xde,
+	0xfe, 0xa8, 0x19, 0xf5, 0xf8, 0x5a, 0x39, 0xfd, 0xcf, 0x0e, 0x78, 0x92, 0xb3, 0x23, 0x1f, 0x48,
+	0xd0, 0xe1, 0xca, 0x20, 0x72, 0x40, 0x88, 0xb4, 0x56, 0x24, 0xca, 0x93, 0x8d, 0x39, 0xbb, 0xb5,
+	0x95, 0xbd, 0xef, 0xfc, 0xf2, 0xf7, 0xa7, 0xed, 0x63, 0x64, 0x44, 0x8d, 0xd7, 0xcc, 0xe4, 0x1b,
+	0x09, 0xb6, 0x85, 0x54, 0x1b, 0x39, 0x1c, 0x5f, 0x2a, 0x5a, 0x50, 0xca, 0x47, 0x9a, 0x8c, 0x42,
+	0xa4, 0xd3, 0x1c, 0xe9, 0x24, 0xd9, 0x2f, 0x44, 0x5a, 0x23, 0x43, 0xc9, 0xd7, 0x12, 0x6c, 0x0b,
+	0x09, 0xba, 0x7a, 0xa0, 0xa3, 0xa5, 0xa6, 0x7c, 0xa4, 0xc9, 0x28, 0x04, 0x9d, 0xe2, 0xa0, 0x0f,
+	0x90, 0x7d, 0x42, 0xd0, 0x61, 0x81, 0x4a, 0x7e, 0x94, 0xa0, 0x3f, 0x52, 0xd6, 0x91, 0x93, 0xf1,
+	0x18, 0xe2, 0xa4, 0xa8, 0x7c, 0xaa, 0xa5, 0x58, 0x64, 0x71, 0x9c, 0xb3, 0x98, 0x26, 0x07, 0x85,
+	0x2c, 0x04, 0xfa, 0x95, 0x7c, 0x28, 0x41, 0x87, 0xfb, 0x8d, 0xd4, 0x3b, 0xc4, 0x55, 0x2f, 0x55,
+	0x79, 0xb2, 0x31, 0x67, 0xc4, 0x37, 0xc1, 0xf1, 0x29, 0x64, 0x54, 0x88, 0x0f, 0x3f, 0x7d, 0xf2,
+	0xb9, 0x04, 0x5d, 0x01, 0x9d, 0x49, 0x0e, 0xc6, 0xd7, 0xa9, 0x55, 0xab, 0x72, 0xaa, 0x89, 0x08,
+	0x84, 0x37, 0xc5, 0xe1, 0xed, 0x25, 0x7b, 0x84, 0xf0, 0x82, 0x1a, 0x97, 0x7c, 0x27, 0x41, 0x6f,
+	0x8d, 0x54, 0x25, 0x47, 0xe3, 0xeb, 0x8a, 0xd4, 0xaf, 0x7c, 0xac, 0xe9, 0x38, 0x44, 0x7d, 0x98,
+	0xa3, 0x4e, 0x92, 0x49, 0x21, 0x6a, 0x23, 0x5d, 0x23, 0x98, 0xc9, 0x97, 0x12, 0x74, 0xfa, 0xaa,
+	0x94, 0x24, 0xe3, 0x8b, 0x87, 0x45, 0xb0, 0xac, 0x36, 0xec, 0x8f, 0x20, 0xcf, 0x70, 0x90, 0xc7,
+	0xc9, 0x51, 0x21, 0x48, 0x5f, 0xc7, 0xaa, 0xb7, 0x6b, 0x9e, 0x3c, 0x77, 0xc8, 0x0f, 0x12, 0xf4,
+	0x84, 0x95, 0x28, 0xa9, 0xf3, 0xad, 0x0b, 0x74, 0xb0, 0x7c, 0xb4, 0xd9, 0x30, 0xe4, 0xb0, 0xc0,
+	0x39, 0x3c, 0x4f, 0xce, 0x08, 0x39, 0xd4, 0xe8, 0xe1, 0x48, 0x2e, 0x3f, 0x4b, 0xd0, 0x5b, 0xa3,
+	0x41, 0xeb, 0x9d, 0x1b, 0x91, 0x06, 0x96, 0x8f, 0x35, 0x1d, 0x87, 0x74, 0x16, 0x39, 0x9d, 0x19,
+	0xf2, 0x9c, 0x78, 0xa2, 0xd4, 0x68, 0xe1, 0x48, 0x3e, 0xbf, 0x4a, 0xd0, 0x17, 0x35, 0x89, 0xc9,
+	0x89, 0x7a, 0xa7, 0x44, 0xa8, 0x80, 0xe5, 0x93, 0xad, 0x84, 0x36, 0x4c, 0x4c, 0xf0, 0xe0, 0x50,
+	0x6f, 0x07, 0x05, 0xd8, 0x1d, 0xf2, 0x97, 0x04, 0x03, 0x02, 0x95, 0x48, 0x9e, 0xad, 0x3f, 0x1c,
+	0xc5, 0x22, 0x58, 0x3e, 0xdd, 0x62, 0x34, 0x32, 0x3c, 0xcf, 0x19, 0xce, 0x91, 0x99, 0xf8, 0x11,
+	0x1b, 0x25, 0x8b, 0xc3, 0x1c, 0xef, 0xb6, 0xc3, 0x70, 0xdc, 0x13, 0x95, 0xcc, 0x34, 0x34, 0x50,
+	0xe3, 0x64, 0xb0, 0x3c, 0xbb, 0x99, 0x14, 0x48, 0x39, 0xc3, 0x29, 0xbf, 0x4a, 0x5e, 0xae, 0x37,
+	0xa0, 0x05, 0x4f, 0xf5, 0x8d, 0xa8, 0xa3, 0x1b, 0x6e, 0xc6, 0x17, 0x12, 0x74, 0x07, 0x85, 0x24,
+	0x49, 0x35, 0xbc, 0x4f, 0xfe, 0xf7, 0x38, 0xdd, 0x4c, 0x08, 0x92, 0x4b, 0x72, 0x72, 0x13, 0x64,
+	0xbc, 0xa1, 0xfd, 0xb4, 0xc9, 0xf7, 0x12, 0xf4, 0x45, 0x69, 0xd4, 0x7a, 0x5f, 0x5c, 0x8c, 0xf6,
+	0x95, 0x4f, 0xb6, 0x12, 0x8a, 0xf8, 0x8f, 0x71, 0xfc, 0x29, 0xa2, 0xc6, 0x6c, 0x0e, 0x0f, 0xd7,
+	0x70, 0x80, 0x22, 0x13, 0xf2, 0x7e, 0x3b, 0x24, 0xe2, 0xa5, 0x2a, 0x59, 0xa8, 0xfb, 0x20, 0x6a,
+	0x48, 0x4a, 0xcb, 0x8b, 0x9b, 0xce, 0x83, 0x64, 0xaf, 0x72, 0xb2, 0x4b, 0xe4, 0x62, 0x8b, 0x27,
+	0xd1, 0xa0, 0xd1, 0xd7, 0x68, 0xf9, 0x3d, 0x19, 0xdd, 0x82, 0xa6, 0x2e, 0xc3, 0x10, 0xed, 0x53,
+	0x2d, 0xc5, 0x36, 0xfc, 0x9e, 0x14, 0x10, 0x24, 0x3f, 0xd5, 0x90, 0xf1, 0x14, 0x58, 0x0b, 0x37,
+	0x7b, 0x6b, 0x64, 0x42, 0x42, 0x53, 0x39, 0xc1, 0xc9, 0x1c, 0x22, 0xa9, 0x66, 0xc7, 0x82, 0x3d,
+	0xbb, 0x72, 0xef, 0x61, 0x42, 0xba, 0xff, 0x30, 0x21, 0xfd, 0xf9, 0x30, 0x21, 0x7d, 0xfc, 0x28,
+	0xd1, 0x76, 0xff, 0x51, 0xa2, 0xed, 0xb7, 0x47, 0x89, 0xb6, 0x6b, 0xa7, 0x02, 0x3f, 0xaf, 0x14,
+	0xa8, 0x95, 0x37, 0xcc, 0x29, 0x93, 0x3a, 0x37, 0x99, 0xb5, 0x86, 0x55, 0xa6, 0x4c, 0xdd, 0x31,
+	0xd6, 0xa9, 0xba, 0x3e, 0xad, 0xbe, 0x59, 0xa9, 0xc8, 0x7f, 0x77, 0x49, 0x77, 0xf0, 0xdf, 0x10,
+	0x0f, 0xfd, 0x37, 0x00, 0xe0, 0x29, 0xa4, 0x0d, 0xa5, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1609,6 +1820,8 @@ type QueryClient interface {
 	HostAccounts(ctx context.Context, in *QueryHostAccountsRequest, opts ...grpc.CallOption) (*QueryHostAccountsResponse, error)
 	DepositModuleAccount(ctx context.Context, in *QueryDepositModuleAccountRequest, opts ...grpc.CallOption) (*QueryDepositModuleAccountResponse, error)
 	DelegatorUnbondingEpochEntries(ctx context.Context, in *QueryAllDelegatorUnbondingEpochEntriesRequest, opts ...grpc.CallOption) (*QueryAllDelegatorUnbondingEpochEntriesResponse, error)
+	UnbondingEpochEntries(ctx context.Context, in *QueryUnbondingEpochEntriesRequest, opts ...grpc.CallOption) (*QueryUnbondingEpochEntriesResponse, error)
+	UnbondingEpochCValues(ctx context.Context, in *QueryUnbondingEpochCValuesRequest, opts ...grpc.CallOption) (*QueryUnbondingEpochCValuesResponse, error)
 }
 
 type queryClient struct {
@@ -1763,6 +1976,24 @@ func (c *queryClient) DelegatorUnbondingEpochEntries(ctx context.Context, in *Qu
 	return out, nil
 }
 
+func (c *queryClient) UnbondingEpochEntries(ctx context.Context, in *QueryUnbondingEpochEntriesRequest, opts ...grpc.CallOption) (*QueryUnbondingEpochEntriesResponse, error) {
+	out := new(QueryUnbondingEpochEntriesResponse)
+	err := c.cc.Invoke(ctx, "/estake.lscosmos.v1beta1.Query/UnbondingEpochEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingEpochCValues(ctx context.Context, in *QueryUnbondingEpochCValuesRequest, opts ...grpc.CallOption) (*QueryUnbondingEpochCValuesResponse, error) {
+	out := new(QueryUnbondingEpochCValuesResponse)
+	err := c.cc.Invoke(ctx, "/estake.lscosmos.v1beta1.Query/UnbondingEpochCValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -1782,6 +2013,8 @@ type QueryServer interface {
 	HostAccounts(context.Context, *QueryHostAccountsRequest) (*QueryHostAccountsResponse, error)
 	DepositModuleAccount(context.Context, *QueryDepositModuleAccountRequest) (*QueryDepositModuleAccountResponse, error)
 	DelegatorUnbondingEpochEntries(context.Context, *QueryAllDelegatorUnbondingEpochEntriesRequest) (*QueryAllDelegatorUnbondingEpochEntriesResponse, error)
+	UnbondingEpochEntries(context.Context, *QueryUnbondingEpochEntriesRequest) (*QueryUnbondingEpochEntriesResponse, error)
+	UnbondingEpochCValues(context.Context, *QueryUnbondingEpochCValuesRequest) (*QueryUnbondingEpochCValuesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1836,6 +2069,12 @@ func (*UnimplementedQueryServer) DepositModuleAccount(ctx context.Context, req *
 func (*UnimplementedQueryServer) DelegatorUnbondingEpochEntries(ctx context.Context, req *QueryAllDelegatorUnbondingEpochEntriesRequest) (*QueryAllDelegatorUnbondingEpochEntriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorUnbondingEpochEntries not implemented")
 }
+func (*UnimplementedQueryServer) UnbondingEpochEntries(ctx context.Context, req *QueryUnbondingEpochEntriesRequest) (*QueryUnbondingEpochEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingEpochEntries not implemented")
+}
+func (*UnimplementedQueryServer) UnbondingEpochCValues(ctx context.Context, req *QueryUnbondingEpochCValuesRequest) (*QueryUnbondingEpochCValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingEpochCValues not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2129,6 +2368,42 @@ func _Query_DelegatorUnbondingEpochEntries_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UnbondingEpochEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingEpochEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingEpochEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/estake.lscosmos.v1beta1.Query/UnbondingEpochEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingEpochEntries(ctx, req.(*QueryUnbondingEpochEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingEpochCValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingEpochCValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingEpochCValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/estake.lscosmos.v1beta1.Query/UnbondingEpochCValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingEpochCValues(ctx, req.(*QueryUnbondingEpochCValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "estake.lscosmos.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2197,6 +2472,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorUnbondingEpochEntries",
 			Handler:    _Query_DelegatorUnbondingEpochEntries_Handler,
 		},
+		{
+			MethodName: "UnbondingEpochEntries",
+			Handler:    _Query_UnbondingEpochEntries_Handler,
+		},
+		{
+			MethodName: "UnbondingEpochCValues",
+			Handler:    _Query_UnbondingEpochCValues_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "estake/lscosmos/v1beta1/query.proto",
@@ -3164,90 +3447,258 @@ func (m *QueryAllDelegatorUnbondingEpochEntriesResponse) MarshalToSizedBuffer(dA
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryUnbondingEpochEntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryHostChainParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryUnbondingEpochEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHostChainParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryUnbondingEpochEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.HostChainParams.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryDelegationStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	var l int
-	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegationStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryUnbondingEpochEntriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.DelegationState.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllowListedValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryUnbondingEpochEntriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllowListedValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryUnbondingEpochEntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorUnbondingEpochEntries) > 0 {
+		for iNdEx := len(m.DelegatorUnbondingEpochEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorUnbondingEpochEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingEpochCValuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingEpochCValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingEpochCValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingEpochCValuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingEpochCValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingEpochCValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UnbondingEpochCValues) > 0 {
+		for iNdEx := len(m.UnbondingEpochCValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingEpochCValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHostChainParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHostChainParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HostChainParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegationStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDelegationStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DelegationState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllowListedValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllowListedValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = m.AllowListedValidators.Size()
@@ -3541,6 +3992,70 @@ func (m *QueryAllDelegatorUnbondingEpochEntriesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryUnbondingEpochEntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingEpochEntriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DelegatorUnbondingEpochEntries) > 0 {
+		for _, e := range m.DelegatorUnbondingEpochEntries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingEpochCValuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingEpochCValuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnbondingEpochCValues) > 0 {
+		for _, e := range m.UnbondingEpochCValues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5884,6 +6399,418 @@ func (m *QueryAllDelegatorUnbondingEpochEntriesResponse) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
+func (m *QueryUnbondingEpochEntriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingEpochEntriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingEpochEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingEpochEntriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingEpochEntriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingEpochEntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingEpochEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorUnbondingEpochEntries = append(m.DelegatorUnbondingEpochEntries, DelegatorUnbondingEpochEntry{})
+			if err := m.DelegatorUnbondingEpochEntries[len(m.DelegatorUnbondingEpochEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingEpochCValuesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingEpochCValuesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingEpochCValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingEpochCValuesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingEpochCValuesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingEpochCValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEpochCValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingEpochCValues = append(m.UnbondingEpochCValues, UnbondingEpochCValue{})
+			if err := m.UnbondingEpochCValues[len(m.UnbondingEpochCValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,25 @@
+package types
+
+// telemetry metric keys, exported under the lscosmos module prefix
+const (
+	MetricKeyCValue                 = "c_value"
+	MetricKeyMintRate               = "mint_rate"
+	MetricKeyTVL                    = "tvl"
+	MetricKeyStkSupply              = "stk_supply"
+	MetricKeyInFlightICATxs         = "in_flight_ica_txs"
+	MetricKeyOldestICATxAge         = "oldest_ica_tx_age_seconds"
+	MetricKeyPendingUndelegations   = "pending_undelegation_epochs"
+	MetricKeyUnclaimedBalance       = "unclaimed_balance"
+	MetricKeyModuleEnabled          = "module_enabled"
+	MetricKeyFailedICATxs           = "failed_ica_txs"
+	MetricKeyTimedOutICATxs         = "timed_out_ica_txs"
+	MetricKeySlashingDetected       = "slashing_detected"
+	MetricLabelTVLComponent         = "component"
+	MetricLabelValidator            = "validator"
+	MetricValueTVLDeposit           = "deposit_account"
+	MetricValueTVLIBCTransfer       = "ibc_transfer_transient"
+	MetricValueTVLDelegateTransient = "delegation_transient"
+	MetricValueTVLStaked            = "staked"
+	MetricValueTVLHostAccount       = "host_delegation_account"
+	MetricValueNoValidator          = "none"
+)
@@ -10,8 +10,17 @@ import (
 	"github.com/merlin-network/estake-native/v2/x/lselysium/types"
 )
 
-// BeginBlocker updates liquid validator set changes for the current block
+// BeginBlocker settles the pooled unbondings maturing in the current block, updates liquid validator set changes and
+// emits the telemetry gauges every UpdateFrequency blocks, and votes with the liquid staking proxy account on the
+// proposals ending in the current block
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
-	k.UpdateLiquidValidatorSet(ctx)
+	k.SettleMaturedPooledUnbondings(ctx)
+	if ctx.BlockHeight()%int64(k.GetParams(ctx).UpdateFrequency) == 0 {
+		k.UpdateLiquidValidatorSet(ctx)
+		if telemetry.IsTelemetryEnabled() {
+			k.EmitTelemetry(ctx)
+		}
+	}
+	k.LiquidGovVote(ctx)
 }
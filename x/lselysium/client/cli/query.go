@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/merlin-network/estake-native/v2/x/lselysium/types"
@@ -27,6 +28,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryLiquidValidators(),
 		GetCmdQueryStates(),
+		GetCmdQueryVotingPower(),
+		GetCmdQueryUnbondingRequests(),
 	)
 
 	return liquidValidatorQueryCmd
@@ -153,3 +156,100 @@ $ %s query %s states
 
 	return cmd
 }
+
+// GetCmdQueryVotingPower implements the query voting power command.
+func GetCmdQueryVotingPower() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voting-power [voter]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the voting power of the voter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the staking, liquid staking and validator voting power of the voter.
+
+Example:
+$ %s query %s voting-power %s1mzgucqnfr2l8cj5apvdpllhzt4zeuh2cshz5xu
+`,
+				version.AppName, types.ModuleName, sdk.GetConfig().GetBech32AccountAddrPrefix(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			voter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VotingPower(
+				cmd.Context(),
+				&types.QueryVotingPowerRequest{Voter: voter.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.VotingPower)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUnbondingRequests implements the query unbonding requests command.
+func GetCmdQueryUnbondingRequests() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-requests [staker]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the unbonding requests of the liquid staker",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the unbonding requests created by the liquid unstakings of the liquid staker, the matured ones are pruned
+by its next liquid unstaking.
+
+Example:
+$ %s query %s unbonding-requests %s1mzgucqnfr2l8cj5apvdpllhzt4zeuh2cshz5xu
+`,
+				version.AppName, types.ModuleName, sdk.GetConfig().GetBech32AccountAddrPrefix(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			staker, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UnbondingRequests(
+				cmd.Context(),
+				&types.QueryUnbondingRequestsRequest{Staker: staker.String(), Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbonding-requests")
+
+	return cmd
+}
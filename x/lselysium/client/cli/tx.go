@@ -31,6 +31,10 @@ func GetTxCmd() *cobra.Command {
 	liquidstakingTxCmd.AddCommand(
 		NewLiquidStakeCmd(),
 		NewLiquidUnstakeCmd(),
+		NewStakeToLiquidCmd(),
+		NewPooledLiquidUnstakeCmd(),
+		NewClaimUnbondedCmd(),
+		NewInstantLiquidUnstakeCmd(),
 	)
 
 	return liquidstakingTxCmd
@@ -113,3 +117,157 @@ $ %s tx %s liquid-unstake 500stake --from mykey
 
 	return cmd
 }
+
+// NewStakeToLiquidCmd implements the stake to liquid command handler.
+func NewStakeToLiquidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stake-to-liquid [validator-addr] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Convert a delegation to an active liquid validator into bToken",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Convert a delegation to an active liquid validator into bToken without unbonding.
+
+Example:
+$ %s tx %s stake-to-liquid %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 1000stake --from mykey
+`,
+				version.AppName, types.ModuleName, sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delegator := clientCtx.GetFromAddress()
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			stakingCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgStakeToLiquid(delegator, valAddr, stakingCoin)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewPooledLiquidUnstakeCmd implements the pooled liquid unstake coin command handler.
+func NewPooledLiquidUnstakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pooled-liquid-unstake [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Liquid-unstake bToken into the unbonding pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Liquid-unstake bToken into the unbonding pool, the unbonded coin is claimed with claim-unbonded once matured.
+
+Example:
+$ %s tx %s pooled-liquid-unstake 500bstake --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			liquidStaker := clientCtx.GetFromAddress()
+
+			unstakingCoin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPooledLiquidUnstake(liquidStaker, unstakingCoin)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewInstantLiquidUnstakeCmd implements the instant liquid unstake coin command handler.
+func NewInstantLiquidUnstakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instant-liquid-unstake [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Liquid-unstake bToken paid out immediately from the reserve of the proxy account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Liquid-unstake bToken paid out immediately from the reserve of the proxy account balance, charged with the instant unstake fee rate instead of waiting for the unbonding period.
+
+Example:
+$ %s tx %s instant-liquid-unstake 500bstake --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			liquidStaker := clientCtx.GetFromAddress()
+
+			unstakingCoin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgInstantLiquidUnstake(liquidStaker, unstakingCoin)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewClaimUnbondedCmd implements the claim unbonded command handler.
+func NewClaimUnbondedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-unbonded",
+		Args:  cobra.NoArgs,
+		Short: "Claim the matured pooled liquid unstakings",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim the unbonded coin of the matured pooled liquid unstakings.
+
+Example:
+$ %s tx %s claim-unbonded --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimUnbonded(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
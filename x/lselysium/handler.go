@@ -4,6 +4,11 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
 	"github.com/merlin-network/estake-native/v2/x/lselysium/keeper"
 	"github.com/merlin-network/estake-native/v2/x/lselysium/types"
@@ -23,9 +28,60 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgLiquidUnstake:
 			res, err := msgServer.LiquidUnstake(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgStakeToLiquid:
+			res, err := msgServer.StakeToLiquid(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgPooledLiquidUnstake:
+			res, err := msgServer.PooledLiquidUnstake(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgClaimUnbonded:
+			res, err := msgServer.ClaimUnbonded(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgInstantLiquidUnstake:
+			res, err := msgServer.InstantLiquidUnstake(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAddWhitelistedValidator:
+			res, err := msgServer.AddWhitelistedValidator(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRemoveWhitelistedValidator:
+			res, err := msgServer.RemoveWhitelistedValidator(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateValidatorWeight:
+			res, err := msgServer.UpdateValidatorWeight(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		default:
 			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
 	}
 }
+
+// NewParamChangeProposalHandler returns the x/params proposal handler which validates the liquidstaking params as a
+// whole once a proposal changed them, the subspace only validates every changed param on its own. The gov module
+// discards the changes of a failing proposal.
+func NewParamChangeProposalHandler(paramsKeeper paramskeeper.Keeper, paramSpace paramstypes.Subspace) govv1beta1.Handler {
+	paramsHandler := params.NewParamChangeProposalHandler(paramsKeeper)
+
+	return func(ctx sdk.Context, content govv1beta1.Content) error {
+		if err := paramsHandler(ctx, content); err != nil {
+			return err
+		}
+
+		proposal, ok := content.(*paramproposal.ParameterChangeProposal)
+		if !ok {
+			return nil
+		}
+		for _, change := range proposal.Changes {
+			if change.Subspace != types.ModuleName {
+				continue
+			}
+			var p types.Params
+			paramSpace.GetParamSet(ctx, &p)
+			if err := p.Validate(); err != nil {
+				return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			}
+			return nil
+		}
+		return nil
+	}
+}
@@ -0,0 +1,62 @@
+package lselysium_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/merlin-network/estake-native/v2/app/helpers"
+	"github.com/merlin-network/estake-native/v2/x/lselysium"
+	"github.com/merlin-network/estake-native/v2/x/lselysium/types"
+)
+
+func TestParamChangeProposalHandler(t *testing.T) {
+	_, estakeApp, ctx := helpers.CreateTestApp(t)
+	k := estakeApp.LSElysiumKeeper
+	handler := lselysium.NewParamChangeProposalHandler(estakeApp.ParamsKeeper, estakeApp.GetSubspace(types.ModuleName))
+
+	params := k.GetParams(ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: sdk.ValAddress("validator1__________").String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: sdk.ValAddress("validator2__________").String(), TargetWeight: sdk.NewInt(20)},
+	}
+	k.SetParams(ctx, params)
+
+	for _, tc := range []struct {
+		desc    string
+		changes []paramproposal.ParamChange
+		expErr  bool
+	}{
+		{
+			desc:    "max target weight below a whitelisted target weight",
+			changes: []paramproposal.ParamChange{{Subspace: types.ModuleName, Key: string(types.KeyMaxTargetWeight), Value: `"15"`}},
+			expErr:  true,
+		},
+		{
+			desc:    "max whitelisted validators below the whitelist size",
+			changes: []paramproposal.ParamChange{{Subspace: types.ModuleName, Key: string(types.KeyMaxWhitelistedValidators), Value: `1`}},
+			expErr:  true,
+		},
+		{
+			desc:    "max target weight covering the whitelist",
+			changes: []paramproposal.ParamChange{{Subspace: types.ModuleName, Key: string(types.KeyMaxTargetWeight), Value: `"20"`}},
+		},
+		{
+			desc:    "other subspace",
+			changes: []paramproposal.ParamChange{{Subspace: distrtypes.ModuleName, Key: string(distrtypes.ParamStoreKeyWithdrawAddrEnabled), Value: `false`}},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			cachedCtx, _ := ctx.CacheContext()
+			err := handler(cachedCtx, paramproposal.NewParameterChangeProposal("title", "description", tc.changes))
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -22,6 +22,21 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, lv := range genState.LiquidValidators {
 		k.SetLiquidValidator(ctx, lv)
 	}
+	for _, req := range genState.UnbondingRequests {
+		k.SetUnbondingRequest(ctx, req)
+	}
+	k.SetLastUnbondingRequestID(ctx, genState.LastUnbondingRequestId)
+	if genState.UnbondCursor != "" {
+		valAddr, _ := sdk.ValAddressFromBech32(genState.UnbondCursor)
+		k.SetUnbondCursor(ctx, valAddr)
+	}
+	for _, pu := range genState.PooledUnbondings {
+		k.SetPooledUnbonding(ctx, pu)
+		// the matured pooled unbondings have already been settled
+		if pu.CompletionTime.After(ctx.BlockTime()) {
+			k.InsertPooledUnbondingQueue(ctx, pu)
+		}
+	}
 
 	moduleAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	if moduleAcc == nil {
@@ -38,5 +53,16 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 
 	liquidValidators := k.GetAllLiquidValidators(ctx)
-	return types.NewGenesisState(params, liquidValidators)
+	unbondCursor := ""
+	if cursor, found := k.GetUnbondCursor(ctx); found {
+		unbondCursor = cursor.String()
+	}
+	return types.NewGenesisState(
+		params,
+		liquidValidators,
+		k.GetAllUnbondingRequests(ctx),
+		k.GetLastUnbondingRequestID(ctx),
+		unbondCursor,
+		k.GetAllPooledUnbondings(ctx),
+	)
 }
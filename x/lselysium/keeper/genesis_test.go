@@ -32,8 +32,20 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 	lvs := k.GetAllLiquidValidators(ctx)
 	s.Require().Len(lvs, 2)
 
+	unstakingBtoken := sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(1000000))
+	_, _, _, _, err := k.LiquidUnstake(ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], unstakingBtoken)
+	s.Require().NoError(err)
+	_, _, _, _, err = k.PooledLiquidUnstake(ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], unstakingBtoken)
+	s.Require().NoError(err)
+	k.SetUnbondCursor(ctx, valOpers[1])
+
 	lvStates := k.GetAllLiquidValidatorStates(ctx)
 	genState := k.ExportGenesis(ctx)
+	s.Require().Len(genState.UnbondingRequests, 2)
+	s.Require().Equal(uint64(2), genState.LastUnbondingRequestId)
+	s.Require().Equal(valOpers[1].String(), genState.UnbondCursor)
+	s.Require().Len(genState.PooledUnbondings, 2)
+	s.Require().NoError(types.ValidateGenesis(*genState))
 
 	bz := s.app.AppCodec().MustMarshalJSON(genState)
 
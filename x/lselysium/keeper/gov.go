@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"sort"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/merlin-network/estake-native/v2/x/lselysium/types"
+)
+
+// voteOptions is the fixed order in which the liquid gov tally results are iterated
+var voteOptions = []govv1.VoteOption{
+	govv1.OptionYes,
+	govv1.OptionAbstain,
+	govv1.OptionNo,
+	govv1.OptionNoWithVeto,
+}
+
+// GetProxyBondedTokens returns the tokens of the delegations of types.LiquidStakingProxyAcc to bonded validators,
+// by validator operator address, and their sum. Only these tokens count in the governance tally.
+func (k Keeper) GetProxyBondedTokens(ctx sdk.Context) (map[string]sdk.Dec, sdk.Dec) {
+	bondedTokens := map[string]sdk.Dec{}
+	totalBondedTokens := sdk.ZeroDec()
+	k.stakingKeeper.IterateDelegations(ctx, types.LiquidStakingProxyAcc, func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+		val, found := k.stakingKeeper.GetValidator(ctx, del.GetValidatorAddr())
+		if !found || !val.IsBonded() {
+			return false
+		}
+		tokens := val.TokensFromShares(del.GetShares())
+		bondedTokens[val.OperatorAddress] = tokens
+		totalBondedTokens = totalBondedTokens.Add(tokens)
+		return false
+	})
+	return bondedTokens, totalBondedTokens
+}
+
+// CalcStakingVotingPower returns the voting power of the delegations of the voter to bonded validators.
+func (k Keeper) CalcStakingVotingPower(ctx sdk.Context, voter sdk.AccAddress) math.Int {
+	votingPower := sdk.ZeroDec()
+	k.stakingKeeper.IterateDelegations(ctx, voter, func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+		val, found := k.stakingKeeper.GetValidator(ctx, del.GetValidatorAddr())
+		if found && val.IsBonded() {
+			votingPower = votingPower.Add(val.TokensFromShares(del.GetShares()))
+		}
+		return false
+	})
+	return votingPower.TruncateInt()
+}
+
+// CalcLiquidStakingVotingPower returns the share of the bonded tokens of types.LiquidStakingProxyAcc the voter
+// votes with, proportional to the bToken balance of the voter.
+func (k Keeper) CalcLiquidStakingVotingPower(ctx sdk.Context, voter sdk.AccAddress) math.Int {
+	bTokenTotalSupply := k.bankKeeper.GetSupply(ctx, k.LiquidBondDenom(ctx)).Amount
+	if !bTokenTotalSupply.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	bTokenBalance := k.bankKeeper.GetBalance(ctx, voter, k.LiquidBondDenom(ctx)).Amount
+	_, totalBondedTokens := k.GetProxyBondedTokens(ctx)
+	return types.BTokenToNativeToken(bTokenBalance, bTokenTotalSupply, totalBondedTokens).TruncateInt()
+}
+
+// GetVotingPower returns the staking, liquid staking and validator voting power of the voter.
+func (k Keeper) GetVotingPower(ctx sdk.Context, voter sdk.AccAddress) types.VotingPower {
+	validatorVotingPower := sdk.ZeroInt()
+	val, found := k.stakingKeeper.GetValidator(ctx, voter.Bytes())
+	if found && val.IsBonded() {
+		validatorVotingPower = val.BondedTokens()
+	}
+	return types.VotingPower{
+		Voter:                    voter.String(),
+		StakingVotingPower:       k.CalcStakingVotingPower(ctx, voter),
+		LiquidStakingVotingPower: k.CalcLiquidStakingVotingPower(ctx, voter),
+		ValidatorVotingPower:     validatorVotingPower,
+	}
+}
+
+// TallyLiquidGov returns the weighted vote of types.LiquidStakingProxyAcc for the proposal. Every bToken holder
+// who voted is credited with the share of the proxy bonded tokens matching its share of the bToken supply.
+// The rest of the proxy bonded tokens follows the votes of the validators they are delegated to. The gov tally
+// applies the weights to the whole proxy delegation, so the weights are taken over all the proxy bonded tokens
+// and the part neither credited nor followed is put on abstain, it counts for the quorum only. Returns false if
+// no bToken holder voted, in which case the validators keep voting with the whole proxy delegation.
+func (k Keeper) TallyLiquidGov(ctx sdk.Context, proposalID uint64) (govv1.WeightedVoteOptions, bool) {
+	liquidBondDenom := k.LiquidBondDenom(ctx)
+	bTokenTotalSupply := k.bankKeeper.GetSupply(ctx, liquidBondDenom).Amount
+	if !bTokenTotalSupply.IsPositive() {
+		return nil, false
+	}
+	bondedTokens, totalBondedTokens := k.GetProxyBondedTokens(ctx)
+	if !totalBondedTokens.IsPositive() {
+		return nil, false
+	}
+
+	results := map[govv1.VoteOption]sdk.Dec{}
+	for _, option := range voteOptions {
+		results[option] = sdk.ZeroDec()
+	}
+	addVote := func(power sdk.Dec, options []*govv1.WeightedVoteOption) {
+		for _, option := range options {
+			weight, err := sdk.NewDecFromStr(option.Weight)
+			if err != nil {
+				continue
+			}
+			results[option.Option] = results[option.Option].Add(power.Mul(weight))
+		}
+	}
+
+	creditedTokens := sdk.ZeroDec()
+	validatorVotes := map[string][]*govv1.WeightedVoteOption{}
+	k.govKeeper.IterateVotes(ctx, proposalID, func(vote govv1.Vote) (stop bool) {
+		voter, err := sdk.AccAddressFromBech32(vote.Voter)
+		if err != nil || voter.Equals(types.LiquidStakingProxyAcc) {
+			return false
+		}
+		if _, ok := bondedTokens[sdk.ValAddress(voter).String()]; ok {
+			validatorVotes[sdk.ValAddress(voter).String()] = vote.Options
+		}
+
+		bTokenBalance := k.bankKeeper.GetBalance(ctx, voter, liquidBondDenom).Amount
+		if !bTokenBalance.IsPositive() {
+			return false
+		}
+		power := types.BTokenToNativeToken(bTokenBalance, bTokenTotalSupply, totalBondedTokens)
+		addVote(power, vote.Options)
+		creditedTokens = creditedTokens.Add(power)
+		return false
+	})
+	if !creditedTokens.IsPositive() {
+		return nil, false
+	}
+
+	// the tokens not credited to bToken holders follow the vote of the validator they are delegated to
+	remainingRatio := sdk.OneDec().Sub(sdk.MinDec(creditedTokens.Quo(totalBondedTokens), sdk.OneDec()))
+	validators := make([]string, 0, len(validatorVotes))
+	for val := range validatorVotes {
+		validators = append(validators, val)
+	}
+	sort.Strings(validators)
+	for _, val := range validators {
+		addVote(bondedTokens[val].Mul(remainingRatio), validatorVotes[val])
+	}
+
+	totalPower := sdk.ZeroDec()
+	for _, option := range voteOptions {
+		totalPower = totalPower.Add(results[option])
+	}
+	if !totalPower.IsPositive() {
+		return nil, false
+	}
+	// the proxy bonded tokens neither credited nor followed are not cast for any side
+	if notVoted := totalBondedTokens.Sub(totalPower); notVoted.IsPositive() {
+		results[govv1.OptionAbstain] = results[govv1.OptionAbstain].Add(notVoted)
+		totalPower = totalBondedTokens
+	}
+
+	// weights must sum up to one, the truncation remainder goes to the option with the highest weight
+	var options govv1.WeightedVoteOptions
+	remainder := sdk.OneDec()
+	highest := -1
+	for _, option := range voteOptions {
+		weight := results[option].Quo(totalPower)
+		if !weight.IsPositive() {
+			continue
+		}
+		remainder = remainder.Sub(weight)
+		options = append(options, &govv1.WeightedVoteOption{Option: option, Weight: weight.String()})
+		if highest == -1 || results[option].GT(results[options[highest].Option]) {
+			highest = len(options) - 1
+		}
+	}
+	if !remainder.IsZero() {
+		options[highest].Weight = sdk.MustNewDecFromStr(options[highest].Weight).Add(remainder).String()
+	}
+	return options, true
+}
+
+// LiquidGovVote votes with types.LiquidStakingProxyAcc on the proposals whose voting period ends in the
+// current block, so that the gov EndBlocker tallies the votes of the bToken holders.
+func (k Keeper) LiquidGovVote(ctx sdk.Context) {
+	var proposalIDs []uint64
+	k.govKeeper.IterateActiveProposalsQueue(ctx, ctx.BlockTime(), func(proposal govv1.Proposal) (stop bool) {
+		proposalIDs = append(proposalIDs, proposal.Id)
+		return false
+	})
+
+	for _, proposalID := range proposalIDs {
+		options, ok := k.TallyLiquidGov(ctx, proposalID)
+		if !ok {
+			continue
+		}
+		if err := k.govKeeper.AddVote(ctx, proposalID, types.LiquidStakingProxyAcc, options, ""); err != nil {
+			k.Logger(ctx).Error("failed to vote with liquid staking proxy account", "proposal", proposalID, "error", err)
+		}
+	}
+}
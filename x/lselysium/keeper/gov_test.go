@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/merlin-network/estake-native/v2/x/lselysium/types"
+)
+
+func (s *KeeperTestSuite) TestLiquidGov() {
+	valAddrs, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.MinLiquidStakingAmount = sdk.NewInt(50000)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(300000)))
+	s.Require().NoError(s.liquidStaking(s.delAddrs[1], sdk.NewInt(100000)))
+
+	_, totalBondedTokens := s.keeper.GetProxyBondedTokens(s.ctx)
+	s.Require().Equal(sdk.NewDec(400000), totalBondedTokens)
+
+	// voting power
+	votingPower := s.keeper.GetVotingPower(s.ctx, s.delAddrs[0])
+	s.Require().Equal(s.delAddrs[0].String(), votingPower.Voter)
+	s.Require().Equal(sdk.ZeroInt(), votingPower.StakingVotingPower)
+	s.Require().Equal(sdk.NewInt(300000), votingPower.LiquidStakingVotingPower)
+	s.Require().Equal(sdk.ZeroInt(), votingPower.ValidatorVotingPower)
+
+	votingPower = s.keeper.GetVotingPower(s.ctx, valAddrs[0])
+	s.Require().Equal(sdk.NewInt(1000000), votingPower.StakingVotingPower)
+	s.Require().Equal(sdk.ZeroInt(), votingPower.LiquidStakingVotingPower)
+	s.Require().True(votingPower.ValidatorVotingPower.GT(sdk.NewInt(1000000)))
+
+	resp, err := s.querier.VotingPower(sdk.WrapSDKContext(s.ctx), &types.QueryVotingPowerRequest{Voter: s.delAddrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(s.keeper.GetVotingPower(s.ctx, s.delAddrs[0]), resp.VotingPower)
+	_, err = s.querier.VotingPower(sdk.WrapSDKContext(s.ctx), &types.QueryVotingPowerRequest{Voter: "invalid"})
+	s.Require().Error(err)
+	_, err = s.querier.VotingPower(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Error(err)
+
+	proposal, err := s.app.GovKeeper.SubmitProposal(s.ctx, []sdk.Msg{}, "")
+	s.Require().NoError(err)
+	s.app.GovKeeper.ActivateVotingPeriod(s.ctx, proposal)
+
+	// no bToken holder voted, validators keep voting with the proxy delegations
+	_, ok := s.keeper.TallyLiquidGov(s.ctx, proposal.Id)
+	s.Require().False(ok)
+
+	s.Require().NoError(s.app.GovKeeper.AddVote(s.ctx, proposal.Id, s.delAddrs[0], govv1.NewNonSplitVoteOption(govv1.OptionYes), ""))
+	s.Require().NoError(s.app.GovKeeper.AddVote(s.ctx, proposal.Id, valAddrs[0], govv1.NewNonSplitVoteOption(govv1.OptionNo), ""))
+
+	// 300000 yes of the bToken holder, 1/4 of the proxy delegation to the first validator follows its no vote,
+	// the proxy delegations to the validators who did not vote are not cast for any side
+	options, ok := s.keeper.TallyLiquidGov(s.ctx, proposal.Id)
+	s.Require().True(ok)
+	s.Require().Len(options, 3)
+	totalWeight := sdk.ZeroDec()
+	for _, option := range options {
+		weight := sdk.MustNewDecFromStr(option.Weight)
+		totalWeight = totalWeight.Add(weight)
+		switch option.Option {
+		case govv1.OptionYes:
+			s.Require().True(weight.Sub(sdk.MustNewDecFromStr("0.75")).Abs().LT(sdk.MustNewDecFromStr("0.0001")))
+		case govv1.OptionNo:
+			s.Require().True(weight.Sub(sdk.MustNewDecFromStr("0.083333")).Abs().LT(sdk.MustNewDecFromStr("0.0001")))
+		case govv1.OptionAbstain:
+			s.Require().True(weight.Sub(sdk.MustNewDecFromStr("0.166667")).Abs().LT(sdk.MustNewDecFromStr("0.0001")))
+		default:
+			s.Fail("unexpected vote option", option.Option)
+		}
+	}
+	s.Require().Equal(sdk.OneDec(), totalWeight)
+
+	// proxy account only votes on the proposals ending in the current block
+	s.keeper.LiquidGovVote(s.ctx)
+	_, found := s.app.GovKeeper.GetVote(s.ctx, proposal.Id, types.LiquidStakingProxyAcc)
+	s.Require().False(found)
+
+	proposal, found = s.app.GovKeeper.GetProposal(s.ctx, proposal.Id)
+	s.Require().True(found)
+	s.ctx = s.ctx.WithBlockTime(*proposal.VotingEndTime)
+	s.keeper.LiquidGovVote(s.ctx)
+	vote, found := s.app.GovKeeper.GetVote(s.ctx, proposal.Id, types.LiquidStakingProxyAcc)
+	s.Require().True(found)
+	s.Require().Equal(options, govv1.WeightedVoteOptions(vote.Options))
+
+	// only the credited and followed tokens of the proxy delegation are cast for yes or no
+	_, _, tallyResults := s.app.GovKeeper.Tally(s.ctx, proposal)
+	yes, _ := sdk.NewIntFromString(tallyResults.YesCount)
+	s.Require().True(yes.Sub(sdk.NewInt(300000)).Abs().LTE(sdk.NewInt(10)))
+}
@@ -6,7 +6,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/merlin-network/estake-native/v2/x/lselysium/types"
 )
@@ -45,3 +47,51 @@ func (k Querier) States(c context.Context, req *types.QueryStatesRequest) (*type
 
 	return &types.QueryStatesResponse{NetAmountState: k.GetNetAmountState(ctx)}, nil
 }
+
+// VotingPower queries the voting power of the voter.
+func (k Querier) VotingPower(c context.Context, req *types.QueryVotingPowerRequest) (*types.QueryVotingPowerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryVotingPowerResponse{VotingPower: k.GetVotingPower(ctx, voter)}, nil
+}
+
+// UnbondingRequests queries the unbonding requests of the liquid staker. The matured non-pooled ones are left out,
+// the staking module has already paid them out but they are only pruned on the next liquid unstaking of the staker.
+func (k Querier) UnbondingRequests(c context.Context, req *types.QueryUnbondingRequestsRequest) (*types.QueryUnbondingRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	staker, err := sdk.AccAddressFromBech32(req.Staker)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetUnbondingRequestsByStakerKey(staker))
+	var unbondingRequests []types.UnbondingRequest
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var unbondingRequest types.UnbondingRequest
+		if err := k.cdc.Unmarshal(value, &unbondingRequest); err != nil {
+			return false, err
+		}
+		if !unbondingRequest.Pooled && !unbondingRequest.CompletionTime.After(ctx.BlockTime()) {
+			return false, nil
+		}
+		if accumulate {
+			unbondingRequests = append(unbondingRequests, unbondingRequest)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnbondingRequestsResponse{UnbondingRequests: unbondingRequests, Pagination: pageRes}, nil
+}
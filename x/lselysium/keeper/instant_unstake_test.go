@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/merlin-network/estake-native/v2/x/lselysium/keeper"
+	"github.com/merlin-network/estake-native/v2/x/lselysium/types"
+)
+
+func (s *KeeperTestSuite) TestInstantLiquidUnstake() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	staker := s.delAddrs[0]
+	s.Require().NoError(s.liquidStaking(staker, sdk.NewInt(10000000)))
+
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	unstakingBtoken := sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(1000000))
+
+	// disabled by the default zero reserve rate
+	_, err := msgServer.InstantLiquidUnstake(goCtx, types.NewMsgInstantLiquidUnstake(staker, unstakingBtoken))
+	s.Require().ErrorIs(err, types.ErrInstantUnstakeDisabled)
+
+	params = s.keeper.GetParams(s.ctx)
+	params.InstantUnstakeFeeRate = sdk.NewDecWithPrec(1, 2)
+	params.InstantReserveRate = sdk.NewDecWithPrec(1, 1)
+	s.keeper.SetParams(s.ctx, params)
+
+	// no idle balance on the proxy account
+	_, err = msgServer.InstantLiquidUnstake(goCtx, types.NewMsgInstantLiquidUnstake(staker, unstakingBtoken))
+	s.Require().ErrorIs(err, types.ErrInsufficientInstantReserve)
+	s.Require().ErrorContains(err, "for 1000000bstake, available reserve 0stake")
+
+	// idle balance of the proxy account, only the reserve rate of the NetAmount is available as the reserve
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, s.delAddrs[1], types.LiquidStakingProxyAcc, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(3000000)))))
+	nas := s.keeper.GetNetAmountState(s.ctx)
+	s.Require().Equal(sdk.NewDec(13000000), nas.NetAmount)
+	s.Require().Equal(sdk.NewInt(1300000), s.keeper.InstantUnstakeReserve(s.ctx, types.LiquidStakingProxyAcc, nas.NetAmount))
+
+	_, err = msgServer.InstantLiquidUnstake(goCtx, types.NewMsgInstantLiquidUnstake(staker, sdk.NewCoin("invalidDenom", sdk.NewInt(1000000))))
+	s.Require().ErrorIs(err, types.ErrInvalidLiquidBondDenom)
+	_, err = msgServer.InstantLiquidUnstake(goCtx, types.NewMsgInstantLiquidUnstake(staker, sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(5000000))))
+	s.Require().ErrorIs(err, types.ErrInsufficientInstantReserve)
+
+	expectedAmt := types.DeductFeeRate(
+		types.BTokenToNativeToken(unstakingBtoken.Amount, nas.BtokenTotalSupply, nas.NetAmount), params.InstantUnstakeFeeRate,
+	).TruncateInt()
+	balanceBefore := s.app.BankKeeper.GetBalance(s.ctx, staker, sdk.DefaultBondDenom)
+	btokenBefore := s.app.BankKeeper.GetBalance(s.ctx, staker, params.LiquidBondDenom)
+
+	resp, err := msgServer.InstantLiquidUnstake(goCtx, types.NewMsgInstantLiquidUnstake(staker, unstakingBtoken))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, expectedAmt), resp.Amount)
+
+	// paid out immediately without any unbonding delegation, the fee remains in the NetAmount
+	balanceAfter := s.app.BankKeeper.GetBalance(s.ctx, staker, sdk.DefaultBondDenom)
+	s.Require().Equal(expectedAmt, balanceAfter.Amount.Sub(balanceBefore.Amount))
+	s.Require().Equal(btokenBefore.Amount.Sub(unstakingBtoken.Amount), s.app.BankKeeper.GetBalance(s.ctx, staker, params.LiquidBondDenom).Amount)
+	for _, valOper := range valOpers {
+		_, found := s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, staker, valOper)
+		s.Require().False(found)
+	}
+	s.Require().Len(s.keeper.GetUnbondingRequestsByStaker(s.ctx, staker), 0)
+	s.Require().True(s.keeper.GetNetAmountState(s.ctx).MintRate.LT(nas.MintRate))
+}
+
+func (s *KeeperTestSuite) TestInstantUnstakeReserveReStake() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	params.InstantReserveRate = sdk.NewDecWithPrec(1, 2)
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	staker := s.delAddrs[0]
+	s.Require().NoError(s.liquidStaking(staker, sdk.NewInt(100000000)))
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, s.delAddrs[1], types.LiquidStakingProxyAcc, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(3000000)))))
+
+	// allocate rewards
+	s.advanceHeight(100, false)
+	totalRewards, totalDelShares, _ := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().True(totalRewards.IsPositive())
+
+	// the reserve rate of the NetAmount is held back from the idle balance and the withdrawn rewards, the rest is re-staked
+	whitelistedValsMap := types.GetWhitelistedValsMap(params.WhitelistedValidators)
+	s.keeper.WithdrawRewardsAndReStake(s.ctx, whitelistedValsMap)
+	idleAmt := sdk.NewInt(3000000).Add(totalRewards.TruncateInt())
+	reserve := params.InstantReserveRate.Mul(s.keeper.GetNetAmountState(s.ctx).NetAmount).TruncateInt()
+	s.Require().True(reserve.LT(idleAmt))
+	proxyAccBalance := s.keeper.GetProxyAccBalance(s.ctx, types.LiquidStakingProxyAcc).Amount
+	s.Require().True(proxyAccBalance.Sub(reserve).Abs().LTE(sdk.NewInt(1)))
+	_, totalDelSharesAfter, _ := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().True(totalDelSharesAfter.GT(totalDelShares))
+	s.Require().True(totalDelSharesAfter.Sub(totalDelShares).TruncateInt().LTE(idleAmt.Sub(reserve)))
+
+	// the reserve survives the reward cycle and still serves the instant liquid unstaking
+	reserveAfter := s.keeper.InstantUnstakeReserve(s.ctx, types.LiquidStakingProxyAcc, s.keeper.GetNetAmountState(s.ctx).NetAmount)
+	s.Require().True(reserveAfter.IsPositive())
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	resp, err := msgServer.InstantLiquidUnstake(sdk.WrapSDKContext(s.ctx), types.NewMsgInstantLiquidUnstake(staker, sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(500000))))
+	s.Require().NoError(err)
+	s.Require().True(resp.Amount.Amount.LTE(reserveAfter))
+}
+
+func (s *KeeperTestSuite) TestInstantUnstakeReserveCap() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	params.InstantUnstakeFeeRate = sdk.ZeroDec()
+	params.InstantReserveRate = sdk.NewDecWithPrec(1, 1)
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	staker := s.delAddrs[0]
+	s.Require().NoError(s.liquidStaking(staker, sdk.NewInt(10000000)))
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, s.delAddrs[1], types.LiquidStakingProxyAcc, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(3000000)))))
+
+	// a run of instant unstakes, the reserve follows the NetAmount instead of shrinking with the paid out balance
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	paid := sdk.ZeroInt()
+	for i := 0; i < 4; i++ {
+		nas := s.keeper.GetNetAmountState(s.ctx)
+		balance := s.keeper.GetProxyAccBalance(s.ctx, types.LiquidStakingProxyAcc).Amount
+		reserve := s.keeper.InstantUnstakeReserve(s.ctx, types.LiquidStakingProxyAcc, nas.NetAmount)
+		s.Require().Equal(params.InstantReserveRate.Mul(nas.NetAmount).TruncateInt(), reserve)
+		s.Require().True(reserve.LT(balance))
+		s.Require().True(reserve.GT(sdk.NewInt(1000000)))
+
+		resp, err := msgServer.InstantLiquidUnstake(goCtx, types.NewMsgInstantLiquidUnstake(staker, sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(300000))))
+		s.Require().NoError(err)
+		s.Require().True(resp.Amount.Amount.LTE(reserve))
+		paid = paid.Add(resp.Amount.Amount)
+	}
+	s.Require().Equal(sdk.NewInt(3000000).Sub(paid), s.keeper.GetProxyAccBalance(s.ctx, types.LiquidStakingProxyAcc).Amount)
+
+	// the cap binds even though the idle balance covers the quote
+	nas := s.keeper.GetNetAmountState(s.ctx)
+	reserve := s.keeper.InstantUnstakeReserve(s.ctx, types.LiquidStakingProxyAcc, nas.NetAmount)
+	unstakingBtoken := sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(1000000))
+	quote := types.BTokenToNativeToken(unstakingBtoken.Amount, nas.BtokenTotalSupply, nas.NetAmount).TruncateInt()
+	s.Require().True(quote.GT(reserve))
+	s.Require().True(quote.LT(s.keeper.GetProxyAccBalance(s.ctx, types.LiquidStakingProxyAcc).Amount))
+	_, err := msgServer.InstantLiquidUnstake(goCtx, types.NewMsgInstantLiquidUnstake(staker, unstakingBtoken))
+	s.Require().ErrorIs(err, types.ErrInsufficientInstantReserve)
+
+	// once the idle balance is drained below the cap, the balance bounds the reserve
+	_, err = msgServer.InstantLiquidUnstake(goCtx, types.NewMsgInstantLiquidUnstake(staker, sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(700000))))
+	s.Require().NoError(err)
+	nas = s.keeper.GetNetAmountState(s.ctx)
+	balance := s.keeper.GetProxyAccBalance(s.ctx, types.LiquidStakingProxyAcc).Amount
+	s.Require().True(balance.LT(params.InstantReserveRate.Mul(nas.NetAmount).TruncateInt()))
+	s.Require().Equal(balance, s.keeper.InstantUnstakeReserve(s.ctx, types.LiquidStakingProxyAcc, nas.NetAmount))
+}
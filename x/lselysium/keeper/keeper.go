@@ -22,7 +22,11 @@ type Keeper struct {
 	bankKeeper     types.BankKeeper
 	stakingKeeper  types.StakingKeeper
 	distrKeeper    types.DistrKeeper
+	govKeeper      types.GovKeeper
 	slashingKeeper types.SlashingKeeper
+
+	// the address capable of executing the whitelist management messages, usually the gov module account
+	authority string
 }
 
 // NewKeeper returns a liquidstaking keeper. It handles:
@@ -31,7 +35,7 @@ type Keeper struct {
 // - minting, burning PoolCoins
 func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, stakingKeeper types.StakingKeeper,
-	distrKeeper types.DistrKeeper, slashingKeeper types.SlashingKeeper,
+	distrKeeper types.DistrKeeper, govKeeper types.GovKeeper, slashingKeeper types.SlashingKeeper, authority string,
 ) Keeper {
 	// ensure liquidstaking module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -51,7 +55,9 @@ func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramt
 		bankKeeper:     bankKeeper,
 		stakingKeeper:  stakingKeeper,
 		distrKeeper:    distrKeeper,
+		govKeeper:      govKeeper,
 		slashingKeeper: slashingKeeper,
+		authority:      authority,
 	}
 }
 
@@ -60,6 +66,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
+// GetAuthority returns the address capable of executing the whitelist management messages.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // GetParams gets the parameters for the liquidstaking module.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
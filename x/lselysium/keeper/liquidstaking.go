@@ -6,6 +6,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/merlin-network/estake-native/v2/x/lselysium/types"
@@ -63,7 +64,7 @@ func (k Keeper) LiquidStake(
 		)
 	}
 
-	whitelistedValsMap := types.GetWhitelistedValsMap(params.WhitelistedValidators)
+	whitelistedValsMap := k.GetWhitelistedValsMap(ctx, params)
 	activeVals := k.GetActiveLiquidValidators(ctx, whitelistedValsMap)
 	if activeVals.Len() == 0 || !activeVals.TotalWeight(whitelistedValsMap).IsPositive() {
 		return sdk.ZeroDec(), sdk.ZeroInt(), types.ErrActiveLiquidValidatorsNotExists
@@ -127,10 +128,120 @@ func (k Keeper) LiquidDelegate(ctx sdk.Context, proxyAcc sdk.AccAddress, activeV
 	return totalNewShares, nil
 }
 
+// StakeToLiquid moves the delegation shares of the delegator worth of stakingCoin on the active liquid validator to the
+// proxy account without unbonding, and mints bToken worth of the moved tokens according to NetAmount.
+func (k Keeper) StakeToLiquid(
+	ctx sdk.Context, proxyAcc, delegator sdk.AccAddress, valAddr sdk.ValAddress, stakingCoin sdk.Coin,
+) (newShares sdk.Dec, bTokenMintAmount math.Int, err error) {
+	params := k.GetParams(ctx)
+
+	// check minimum liquid staking amount
+	if stakingCoin.Amount.LT(params.MinLiquidStakingAmount) {
+		return sdk.ZeroDec(), sdk.ZeroInt(), types.ErrLessThanMinLiquidStakingAmount
+	}
+
+	// check bond denomination
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	if stakingCoin.Denom != bondDenom {
+		return sdk.ZeroDec(), sdk.ZeroInt(), errorsmod.Wrapf(
+			types.ErrInvalidBondDenom, "invalid coin denomination: got %s, expected %s", stakingCoin.Denom, bondDenom,
+		)
+	}
+
+	// the delegation can only be moved to an active liquid validator
+	lv, found := k.GetLiquidValidator(ctx, valAddr)
+	if !found || !k.IsActiveLiquidValidator(ctx, lv, k.GetWhitelistedValsMap(ctx, params)) {
+		return sdk.ZeroDec(), sdk.ZeroInt(), errorsmod.Wrapf(types.ErrLiquidValidatorNotActive, "validator %s", valAddr)
+	}
+
+	// the delegated vesting of vesting accounts is tracked on undelegation, which is skipped here
+	if _, ok := k.accountKeeper.GetAccount(ctx, delegator).(vestexported.VestingAccount); ok {
+		return sdk.ZeroDec(), sdk.ZeroInt(), types.ErrVestingAccountNotSupported
+	}
+
+	// the shares of a receiving redelegation are slashed for the infractions of the source validator, which the
+	// proxy account would escape once it holds them
+	if k.stakingKeeper.HasReceivingRedelegation(ctx, delegator, valAddr) {
+		return sdk.ZeroDec(), sdk.ZeroInt(), errorsmod.Wrapf(types.ErrReceivingRedelegation, "delegator %s, validator %s", delegator, valAddr)
+	}
+
+	// NetAmount must be calculated before moving the delegation
+	nas := k.GetNetAmountState(ctx)
+
+	shares, err := k.stakingKeeper.ValidateUnbondAmount(ctx, delegator, valAddr, stakingCoin.Amount)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroInt(), err
+	}
+	// the unbonded tokens stay in the pool of the validator, they are delegated again by the proxy account
+	movedAmount, err := k.stakingKeeper.Unbond(ctx, delegator, valAddr, shares)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroInt(), err
+	}
+
+	// mint btoken, MintAmount = TotalSupply * MovedAmount/NetAmount
+	bTokenMintAmount = movedAmount
+	if nas.BtokenTotalSupply.IsPositive() {
+		bTokenMintAmount = types.NativeTokenToBToken(movedAmount, nas.BtokenTotalSupply, nas.NetAmount)
+	}
+	if !bTokenMintAmount.IsPositive() {
+		return sdk.ZeroDec(), sdk.ZeroInt(), types.ErrTooSmallLiquidStakingAmount
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroDec(), sdk.ZeroInt(), errorsmod.Wrapf(types.ErrValidatorNotFound, "validator %s", valAddr)
+	}
+	// the staking module jails the validator whose operator unbonds below the min self delegation, the active
+	// liquid validator was not jailed before the unbond
+	if validator.IsJailed() {
+		return sdk.ZeroDec(), sdk.ZeroInt(), errorsmod.Wrapf(types.ErrSelfDelegationBelowMinimum, "validator %s", valAddr)
+	}
+	newShares, err = k.stakingKeeper.Delegate(ctx, proxyAcc, movedAmount, validator.GetStatus(), validator, false)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroInt(), err
+	}
+
+	// mint on module acc and send
+	mintCoin := sdk.NewCoins(sdk.NewCoin(params.LiquidBondDenom, bTokenMintAmount))
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, mintCoin)
+	if err != nil {
+		return sdk.ZeroDec(), bTokenMintAmount, err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegator, mintCoin)
+	if err != nil {
+		return sdk.ZeroDec(), bTokenMintAmount, err
+	}
+	return newShares, bTokenMintAmount, nil
+}
+
 // LiquidUnstake burns unstakingBtoken and performs LiquidUnbond to active liquid validators with del shares worth of shares according to NetAmount with each validators current weight.
 func (k Keeper) LiquidUnstake(
 	ctx sdk.Context, proxyAcc, liquidStaker sdk.AccAddress, unstakingBtoken sdk.Coin,
 ) (time.Time, math.Int, []stakingtypes.UnbondingDelegation, math.Int, error) {
+	return k.liquidUnstake(ctx, proxyAcc, liquidStaker, unstakingBtoken, false)
+}
+
+// PooledLiquidUnstake burns unstakingBtoken like LiquidUnstake, but queues the unbonding delegations under the
+// UnbondingPoolAcc without checking the max unbonding entries of the liquid staker, batched with the other pooled
+// liquid unstakings of the block into one entry per liquid validator. The unbonded amount is claimed by the liquid
+// staker with ClaimUnbonded once matured.
+func (k Keeper) PooledLiquidUnstake(
+	ctx sdk.Context, proxyAcc, liquidStaker sdk.AccAddress, unstakingBtoken sdk.Coin,
+) (completionTime time.Time, unbondingAmount, unbondedAmount math.Int, unbondingRequestID uint64, err error) {
+	completionTime, unbondingAmount, ubds, unbondedAmount, err := k.liquidUnstake(ctx, proxyAcc, liquidStaker, unstakingBtoken, true)
+	if err != nil {
+		return time.Time{}, sdk.ZeroInt(), sdk.ZeroInt(), 0, err
+	}
+	// the unbonding request is only recorded when unbonding from the liquid validators
+	if len(ubds) != 0 {
+		unbondingRequestID = k.GetLastUnbondingRequestID(ctx)
+	}
+	return completionTime, unbondingAmount, unbondedAmount, unbondingRequestID, nil
+}
+
+func (k Keeper) liquidUnstake(
+	ctx sdk.Context, proxyAcc, liquidStaker sdk.AccAddress, unstakingBtoken sdk.Coin, pooled bool,
+) (time.Time, math.Int, []stakingtypes.UnbondingDelegation, math.Int, error) {
 
 	// check bond denomination
 	params := k.GetParams(ctx)
@@ -196,6 +307,7 @@ func (k Keeper) LiquidUnstake(
 	totalReturnAmount := sdk.ZeroInt()
 	var ubdTime time.Time
 	var ubds []stakingtypes.UnbondingDelegation //nolint: prealloc
+	ubdEntries := make([]types.UnbondingRequestEntry, 0, liquidVals.Len())
 	for i, val := range liquidVals {
 		// skip zero weight liquid validator
 		if !unbondingAmounts[i].IsPositive() {
@@ -212,17 +324,93 @@ func (k Keeper) LiquidUnstake(
 		if !weightedShare.IsPositive() {
 			continue
 		}
-		// unbond with weightedShare
-		ubdTime, returnAmount, ubd, err = k.LiquidUnbond(ctx, proxyAcc, liquidStaker, val.GetOperator(), weightedShare, true)
+		// unbond with weightedShare, the pooled unbondings are queued under the unbonding pool with no max entries check
+		if pooled {
+			ubdTime, returnAmount, ubd, err = k.PooledLiquidUnbond(ctx, proxyAcc, val.GetOperator(), weightedShare)
+		} else {
+			ubdTime, returnAmount, ubd, err = k.LiquidUnbond(ctx, proxyAcc, liquidStaker, val.GetOperator(), weightedShare, true)
+		}
 		if err != nil {
 			return time.Time{}, sdk.ZeroInt(), []stakingtypes.UnbondingDelegation{}, sdk.ZeroInt(), err
 		}
 		ubds = append(ubds, ubd)
+		ubdEntries = append(ubdEntries, types.UnbondingRequestEntry{
+			ValidatorAddress: val.OperatorAddress,
+			Amount:           returnAmount,
+		})
 		totalReturnAmount = totalReturnAmount.Add(returnAmount)
 	}
+
+	// record the unbonding request to distinguish the liquid unstaking from the native unbondings of the liquid staker
+	if len(ubdEntries) != 0 {
+		k.AddUnbondingRequest(ctx, liquidStaker, unstakingBtoken, totalReturnAmount, ubdEntries, ubdTime, pooled)
+	}
 	return ubdTime, totalReturnAmount, ubds, sdk.ZeroInt(), nil
 }
 
+// InstantUnstakeReserve returns the amount of the proxy account balance available to the instant liquid unstaking,
+// the InstantReserveRate of the netAmount, bounded by the idle balance, which consists of the uncompounded rewards and
+// the matured unbondings.
+func (k Keeper) InstantUnstakeReserve(ctx sdk.Context, proxyAcc sdk.AccAddress, netAmount sdk.Dec) math.Int {
+	reserve := k.GetParams(ctx).InstantReserveRate.Mul(netAmount).TruncateInt()
+	return sdk.MinInt(reserve, k.GetProxyAccBalance(ctx, proxyAcc).Amount)
+}
+
+// InstantLiquidUnstake burns unstakingBtoken and pays out the native token worth of it immediately from the reserve
+// of the proxy account balance, charging InstantUnstakeFeeRate instead of UnstakeFeeRate. It fails with the quoted
+// amount when the reserve is insufficient.
+func (k Keeper) InstantLiquidUnstake(
+	ctx sdk.Context, proxyAcc, liquidStaker sdk.AccAddress, unstakingBtoken sdk.Coin,
+) (unstakedAmount math.Int, err error) {
+	params := k.GetParams(ctx)
+	if !params.InstantReserveRate.IsPositive() {
+		return sdk.ZeroInt(), types.ErrInstantUnstakeDisabled
+	}
+
+	// check bond denomination
+	liquidBondDenom := k.LiquidBondDenom(ctx)
+	if unstakingBtoken.Denom != liquidBondDenom {
+		return sdk.ZeroInt(), errorsmod.Wrapf(
+			types.ErrInvalidLiquidBondDenom, "invalid coin denomination: got %s, expected %s", unstakingBtoken.Denom, liquidBondDenom,
+		)
+	}
+
+	nas := k.GetNetAmountState(ctx)
+	if unstakingBtoken.Amount.GT(nas.BtokenTotalSupply) {
+		return sdk.ZeroInt(), types.ErrInvalidBTokenSupply
+	}
+
+	// UnstakeAmount = NetAmount * BTokenAmount/TotalSupply * (1-InstantUnstakeFeeRate)
+	unstakeAmount := types.BTokenToNativeToken(unstakingBtoken.Amount, nas.BtokenTotalSupply, nas.NetAmount)
+	unstakedAmount = types.DeductFeeRate(unstakeAmount, params.InstantUnstakeFeeRate).TruncateInt()
+	if !unstakedAmount.IsPositive() {
+		return sdk.ZeroInt(), types.ErrTooSmallLiquidUnstakingAmount
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	reserve := k.InstantUnstakeReserve(ctx, proxyAcc, nas.NetAmount)
+	if unstakedAmount.GT(reserve) {
+		return sdk.ZeroInt(), errorsmod.Wrapf(
+			types.ErrInsufficientInstantReserve, "quoted %s for %s, available reserve %s",
+			sdk.NewCoin(bondDenom, unstakedAmount), unstakingBtoken, sdk.NewCoin(bondDenom, reserve),
+		)
+	}
+
+	// burn btoken
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, liquidStaker, types.ModuleName, sdk.NewCoins(unstakingBtoken)); err != nil {
+		return sdk.ZeroInt(), err
+	}
+	if err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(unstakingBtoken)); err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	// the instant unstake fee remains in the proxy account balance, increasing the NetAmount
+	if err = k.bankKeeper.SendCoins(ctx, proxyAcc, liquidStaker, sdk.NewCoins(sdk.NewCoin(bondDenom, unstakedAmount))); err != nil {
+		return sdk.ZeroInt(), err
+	}
+	return unstakedAmount, nil
+}
+
 // LiquidUnbond unbond delegation shares to active validators by proxy account.
 func (k Keeper) LiquidUnbond(
 	ctx sdk.Context, proxyAcc, liquidStaker sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec, checkMaxEntries bool,
@@ -237,12 +425,63 @@ func (k Keeper) LiquidUnbond(
 		return time.Time{}, sdk.ZeroInt(), stakingtypes.UnbondingDelegation{}, stakingtypes.ErrMaxUnbondingDelegationEntries
 	}
 
-	// unbond from proxy account
-	returnAmount, err := k.stakingKeeper.Unbond(ctx, proxyAcc, valAddr, shares)
+	returnAmount, err := k.unbondProxyAcc(ctx, proxyAcc, validator, shares)
 	if err != nil {
 		return time.Time{}, sdk.ZeroInt(), stakingtypes.UnbondingDelegation{}, err
 	}
 
+	// Unbonding from proxy account, but queues to liquid staker.
+	completionTime := ctx.BlockHeader().Time.Add(k.stakingKeeper.UnbondingTime(ctx))
+	ubd := k.stakingKeeper.SetUnbondingDelegationEntry(ctx, liquidStaker, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
+	k.stakingKeeper.InsertUBDQueue(ctx, ubd, completionTime)
+
+	return completionTime, returnAmount, ubd, nil
+}
+
+// PooledLiquidUnbond unbonds delegation shares of the proxy account like LiquidUnbond, but queues the unbonding under
+// the UnbondingPoolAcc, merged into the entry of the pooled liquid unstakings of the current block on the validator if
+// any, so that the pool holds at most one unbonding entry per liquid validator and block.
+func (k Keeper) PooledLiquidUnbond(
+	ctx sdk.Context, proxyAcc sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec,
+) (time.Time, math.Int, stakingtypes.UnbondingDelegation, error) {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return time.Time{}, sdk.ZeroInt(), stakingtypes.UnbondingDelegation{}, stakingtypes.ErrNoDelegatorForAddress
+	}
+
+	returnAmount, err := k.unbondProxyAcc(ctx, proxyAcc, validator, shares)
+	if err != nil {
+		return time.Time{}, sdk.ZeroInt(), stakingtypes.UnbondingDelegation{}, err
+	}
+
+	completionTime := ctx.BlockHeader().Time.Add(k.stakingKeeper.UnbondingTime(ctx))
+	ubd, found := k.stakingKeeper.GetUnbondingDelegation(ctx, types.UnbondingPoolAcc, valAddr)
+	if found {
+		for i, entry := range ubd.Entries {
+			if entry.CreationHeight == ctx.BlockHeight() && entry.CompletionTime.Equal(completionTime) {
+				ubd.Entries[i].InitialBalance = entry.InitialBalance.Add(returnAmount)
+				ubd.Entries[i].Balance = entry.Balance.Add(returnAmount)
+				k.stakingKeeper.SetUnbondingDelegation(ctx, ubd)
+				k.AddPooledUnbonding(ctx, valAddr, completionTime, returnAmount)
+				return completionTime, returnAmount, ubd, nil
+			}
+		}
+	}
+	ubd = k.stakingKeeper.SetUnbondingDelegationEntry(ctx, types.UnbondingPoolAcc, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
+	k.stakingKeeper.InsertUBDQueue(ctx, ubd, completionTime)
+	k.AddPooledUnbonding(ctx, valAddr, completionTime, returnAmount)
+
+	return completionTime, returnAmount, ubd, nil
+}
+
+// unbondProxyAcc unbonds delegation shares of the proxy account and moves the returned tokens of a bonded validator to
+// the not bonded pool, where they wait for the unbonding delegation entry to mature.
+func (k Keeper) unbondProxyAcc(ctx sdk.Context, proxyAcc sdk.AccAddress, validator stakingtypes.Validator, shares sdk.Dec) (math.Int, error) {
+	returnAmount, err := k.stakingKeeper.Unbond(ctx, proxyAcc, validator.GetOperator(), shares)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
 	// transfer the validator tokens to the not bonded pool
 	if validator.IsBonded() {
 		coins := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), returnAmount))
@@ -250,13 +489,7 @@ func (k Keeper) LiquidUnbond(
 			panic(err)
 		}
 	}
-
-	// Unbonding from proxy account, but queues to liquid staker.
-	completionTime := ctx.BlockHeader().Time.Add(k.stakingKeeper.UnbondingTime(ctx))
-	ubd := k.stakingKeeper.SetUnbondingDelegationEntry(ctx, liquidStaker, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
-	k.stakingKeeper.InsertUBDQueue(ctx, ubd, completionTime)
-
-	return completionTime, returnAmount, ubd, nil
+	return returnAmount, nil
 }
 
 // CheckDelegationStates returns total remaining rewards, delshares, liquid tokens of delegations by proxy account
@@ -333,6 +566,30 @@ func (k Keeper) RemoveLiquidValidator(ctx sdk.Context, val types.LiquidValidator
 	store.Delete(types.GetLiquidValidatorKey(val.GetOperator()))
 }
 
+// GetUnbondCursor returns the operator address of the liquid validator the next unbonding of inactive liquid
+// validators resumes from
+func (k Keeper) GetUnbondCursor(ctx sdk.Context) (valAddr sdk.ValAddress, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.UnbondCursorKey)
+	if bz == nil {
+		return nil, false
+	}
+	return bz, true
+}
+
+// SetUnbondCursor sets the operator address of the liquid validator the next unbonding of inactive liquid validators
+// resumes from
+func (k Keeper) SetUnbondCursor(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.UnbondCursorKey, valAddr)
+}
+
+// DeleteUnbondCursor deletes the unbond cursor, the next unbonding of inactive liquid validators starts over
+func (k Keeper) DeleteUnbondCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.UnbondCursorKey)
+}
+
 // GetAllLiquidValidators get the set of all liquid validators with no limits, used during genesis dump
 func (k Keeper) GetAllLiquidValidators(ctx sdk.Context) (vals types.LiquidValidators) {
 	store := ctx.KVStore(k.storeKey)
@@ -365,7 +622,7 @@ func (k Keeper) GetActiveLiquidValidators(ctx sdk.Context, whitelistedValsMap ty
 
 func (k Keeper) GetAllLiquidValidatorStates(ctx sdk.Context) (liquidValidatorStates []types.LiquidValidatorState) {
 	lvs := k.GetAllLiquidValidators(ctx)
-	whitelistedValsMap := k.GetParams(ctx).WhitelistedValsMap()
+	whitelistedValsMap := k.GetWhitelistedValsMap(ctx, k.GetParams(ctx))
 	for _, lv := range lvs {
 		active := k.IsActiveLiquidValidator(ctx, lv, whitelistedValsMap)
 		lvState := types.LiquidValidatorState{
@@ -391,7 +648,7 @@ func (k Keeper) GetLiquidValidatorState(ctx sdk.Context, addr sdk.ValAddress) (l
 			LiquidTokens:    sdk.ZeroInt(),
 		}, false
 	}
-	whitelistedValsMap := k.GetParams(ctx).WhitelistedValsMap()
+	whitelistedValsMap := k.GetWhitelistedValsMap(ctx, k.GetParams(ctx))
 	active := k.IsActiveLiquidValidator(ctx, lv, whitelistedValsMap)
 	return types.LiquidValidatorState{
 		OperatorAddress: lv.OperatorAddress,
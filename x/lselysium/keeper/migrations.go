@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/merlin-network/estake-native/v2/x/lselysium/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. The rebalancing and reward triggers, which used to be constants, are
+// set in params to their former values along with the default max redelegations per block, rebalancing frequency,
+// reward fee, whitelist limits, weighting mode, liquid validator set update cadence and instant unstaking.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyRebalancingTrigger, types.DefaultRebalancingTrigger)
+	m.keeper.paramSpace.Set(ctx, types.KeyRewardTrigger, types.DefaultRewardTrigger)
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxRedelegationsPerBlock, types.DefaultMaxRedelegationsPerBlock)
+	m.keeper.paramSpace.Set(ctx, types.KeyRebalancingFrequency, types.DefaultRebalancingFrequency)
+	m.keeper.paramSpace.Set(ctx, types.KeyRewardFeeRate, types.DefaultRewardFeeRate)
+	m.keeper.paramSpace.Set(ctx, types.KeyFeeAccountAddress, types.DefaultFeeAccountAddress)
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxWhitelistedValidators, types.DefaultMaxWhitelistedValidators)
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxTargetWeight, types.DefaultMaxTargetWeight)
+	m.keeper.paramSpace.Set(ctx, types.KeyWeightingMode, types.DefaultWeightingMode)
+	m.keeper.paramSpace.Set(ctx, types.KeyMinPerformanceFactor, types.DefaultMinPerformanceFactor)
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxPerformanceFactor, types.DefaultMaxPerformanceFactor)
+	m.keeper.paramSpace.Set(ctx, types.KeyUpdateFrequency, types.DefaultUpdateFrequency)
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxUnbondsPerBlock, types.DefaultMaxUnbondsPerBlock)
+	m.keeper.paramSpace.Set(ctx, types.KeyInstantUnstakeFeeRate, types.DefaultInstantUnstakeFeeRate)
+	m.keeper.paramSpace.Set(ctx, types.KeyInstantReserveRate, types.DefaultInstantReserveRate)
+
+	return m.keeper.GetParams(ctx).Validate()
+}
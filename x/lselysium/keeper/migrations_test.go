@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/merlin-network/estake-native/v2/x/lselysium/keeper"
+	"github.com/merlin-network/estake-native/v2/x/lselysium/types"
+)
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	params := s.keeper.GetParams(s.ctx)
+	params.UnstakeFeeRate = sdk.NewDecWithPrec(5, 3)
+	params.RebalancingTrigger = sdk.ZeroDec()
+	params.RewardTrigger = sdk.ZeroDec()
+	params.MaxRedelegationsPerBlock = 1
+	params.RebalancingFrequency = 100
+	params.RewardFeeRate = sdk.NewDecWithPrec(5, 2)
+	params.FeeAccountAddress = s.delAddrs[0].String()
+	params.MaxWhitelistedValidators = 1
+	params.MaxTargetWeight = sdk.NewInt(1)
+	params.WeightingMode = types.WeightingModePerformance
+	params.UpdateFrequency = 10
+	params.MaxUnbondsPerBlock = 1
+	params.InstantUnstakeFeeRate = sdk.NewDecWithPrec(1, 2)
+	params.InstantReserveRate = sdk.NewDecWithPrec(5, 1)
+	s.keeper.SetParams(s.ctx, params)
+
+	s.Require().NoError(keeper.NewMigrator(s.keeper).Migrate1to2(s.ctx))
+
+	migrated := s.keeper.GetParams(s.ctx)
+	s.Require().Equal(params.UnstakeFeeRate, migrated.UnstakeFeeRate)
+	s.Require().Equal(params.LiquidBondDenom, migrated.LiquidBondDenom)
+	s.Require().Equal(types.DefaultRebalancingTrigger, migrated.RebalancingTrigger)
+	s.Require().Equal(types.DefaultRewardTrigger, migrated.RewardTrigger)
+	s.Require().Equal(types.DefaultMaxRedelegationsPerBlock, migrated.MaxRedelegationsPerBlock)
+	s.Require().Equal(types.DefaultRebalancingFrequency, migrated.RebalancingFrequency)
+	s.Require().Equal(types.DefaultRewardFeeRate, migrated.RewardFeeRate)
+	s.Require().Equal(types.DefaultFeeAccountAddress, migrated.FeeAccountAddress)
+	s.Require().Equal(types.DefaultMaxWhitelistedValidators, migrated.MaxWhitelistedValidators)
+	s.Require().Equal(types.DefaultMaxTargetWeight, migrated.MaxTargetWeight)
+	s.Require().Equal(types.DefaultWeightingMode, migrated.WeightingMode)
+	s.Require().Equal(types.DefaultMinPerformanceFactor, migrated.MinPerformanceFactor)
+	s.Require().Equal(types.DefaultMaxPerformanceFactor, migrated.MaxPerformanceFactor)
+	s.Require().Equal(types.DefaultUpdateFrequency, migrated.UpdateFrequency)
+	s.Require().Equal(types.DefaultMaxUnbondsPerBlock, migrated.MaxUnbondsPerBlock)
+	s.Require().Equal(types.DefaultInstantUnstakeFeeRate, migrated.InstantUnstakeFeeRate)
+	s.Require().Equal(types.DefaultInstantReserveRate, migrated.InstantReserveRate)
+}
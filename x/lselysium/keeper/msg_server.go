@@ -7,6 +7,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -78,3 +79,190 @@ func (k msgServer) LiquidUnstake(goCtx context.Context, msg *types.MsgLiquidUnst
 		CompletionTime: completionTime,
 	}, nil
 }
+
+func (k msgServer) StakeToLiquid(goCtx context.Context, msg *types.MsgStakeToLiquid) (*types.MsgStakeToLiquidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	newShares, bTokenMintAmount, err := k.Keeper.StakeToLiquid(ctx, types.LiquidStakingProxyAcc, msg.GetDelegator(), msg.GetValidator(), msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	liquidBondDenom := k.LiquidBondDenom(ctx)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeMsgStakeToLiquid,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyLiquidValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyNewShares, newShares.String()),
+			sdk.NewAttribute(types.AttributeKeyBTokenMintedAmount, sdk.Coin{Denom: liquidBondDenom, Amount: bTokenMintAmount}.String()),
+		),
+	})
+	return &types.MsgStakeToLiquidResponse{}, nil
+}
+
+func (k msgServer) PooledLiquidUnstake(goCtx context.Context, msg *types.MsgPooledLiquidUnstake) (*types.MsgPooledLiquidUnstakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	completionTime, unbondingAmount, unbondedAmount, unbondingRequestID, err := k.Keeper.PooledLiquidUnstake(ctx, types.LiquidStakingProxyAcc, msg.GetDelegator(), msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeMsgPooledLiquidUnstake,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyUnbondingRequestID, strconv.FormatUint(unbondingRequestID, 10)),
+			sdk.NewAttribute(types.AttributeKeyUnbondingAmount, sdk.Coin{Denom: bondDenom, Amount: unbondingAmount}.String()),
+			sdk.NewAttribute(types.AttributeKeyUnbondedAmount, sdk.Coin{Denom: bondDenom, Amount: unbondedAmount}.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	})
+	return &types.MsgPooledLiquidUnstakeResponse{
+		UnbondingRequestId: unbondingRequestID,
+		CompletionTime:     completionTime,
+	}, nil
+}
+
+func (k msgServer) ClaimUnbonded(goCtx context.Context, msg *types.MsgClaimUnbonded) (*types.MsgClaimUnbondedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	claimed, err := k.Keeper.ClaimUnbonded(ctx, msg.GetDelegator())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeMsgClaimUnbonded,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, claimed.String()),
+		),
+	})
+	return &types.MsgClaimUnbondedResponse{Amount: claimed}, nil
+}
+
+func (k msgServer) InstantLiquidUnstake(goCtx context.Context, msg *types.MsgInstantLiquidUnstake) (*types.MsgInstantLiquidUnstakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	unstakedAmount, err := k.Keeper.InstantLiquidUnstake(ctx, types.LiquidStakingProxyAcc, msg.GetDelegator(), msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	unstaked := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), unstakedAmount)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeMsgInstantLiquidUnstake,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyUnbondedAmount, unstaked.String()),
+		),
+	})
+	return &types.MsgInstantLiquidUnstakeResponse{
+		Amount: unstaked,
+	}, nil
+}
+
+func (k msgServer) AddWhitelistedValidator(goCtx context.Context, msg *types.MsgAddWhitelistedValidator) (*types.MsgAddWhitelistedValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.AddWhitelistedValidator(ctx, valAddr, msg.TargetWeight); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeAddWhitelistedValidator,
+			sdk.NewAttribute(types.AttributeKeyLiquidValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyTargetWeight, msg.TargetWeight.String()),
+		),
+	})
+	return &types.MsgAddWhitelistedValidatorResponse{}, nil
+}
+
+func (k msgServer) RemoveWhitelistedValidator(goCtx context.Context, msg *types.MsgRemoveWhitelistedValidator) (*types.MsgRemoveWhitelistedValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.RemoveWhitelistedValidator(ctx, valAddr); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeRemoveWhitelistedValidator,
+			sdk.NewAttribute(types.AttributeKeyLiquidValidator, msg.ValidatorAddress),
+		),
+	})
+	return &types.MsgRemoveWhitelistedValidatorResponse{}, nil
+}
+
+func (k msgServer) UpdateValidatorWeight(goCtx context.Context, msg *types.MsgUpdateValidatorWeight) (*types.MsgUpdateValidatorWeightResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.UpdateValidatorWeight(ctx, valAddr, msg.TargetWeight); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeUpdateValidatorWeight,
+			sdk.NewAttribute(types.AttributeKeyLiquidValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyTargetWeight, msg.TargetWeight.String()),
+		),
+	})
+	return &types.MsgUpdateValidatorWeightResponse{}, nil
+}
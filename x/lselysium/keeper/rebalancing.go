@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"strconv"
 	"time"
 
@@ -48,8 +49,9 @@ func (k Keeper) TryRedelegation(ctx sdk.Context, re types.Redelegation) (complet
 	return completionTime, nil
 }
 
-// Rebalance argument liquidVals containing ValidatorStatusActive which is containing just added on whitelist(liquidToken 0) and ValidatorStatusInactive to delist
-func (k Keeper) Rebalance(ctx sdk.Context, proxyAcc sdk.AccAddress, liquidVals types.LiquidValidators, whitelistedValsMap types.WhitelistedValsMap, rebalancingTrigger sdk.Dec) (redelegations []types.Redelegation) {
+// Rebalance argument liquidVals containing ValidatorStatusActive which is containing just added on whitelist(liquidToken 0) and ValidatorStatusInactive to delist,
+// at most maxRedelegations redelegations are tried
+func (k Keeper) Rebalance(ctx sdk.Context, proxyAcc sdk.AccAddress, liquidVals types.LiquidValidators, whitelistedValsMap types.WhitelistedValsMap, rebalancingTrigger sdk.Dec, maxRedelegations uint32) (redelegations []types.Redelegation) {
 	logger := k.Logger(ctx)
 	totalLiquidTokens, liquidTokenMap := liquidVals.TotalLiquidTokens(ctx, k.stakingKeeper, false)
 	if !totalLiquidTokens.IsPositive() {
@@ -85,7 +87,7 @@ func (k Keeper) Rebalance(ctx sdk.Context, proxyAcc sdk.AccAddress, liquidVals t
 	failCount := 0
 	rebalancingThresholdAmt := rebalancingTrigger.Mul(sdk.NewDecFromInt(totalLiquidTokens)).TruncateInt()
 
-	for i := 0; i < liquidVals.Len(); i++ {
+	for i := 0; i < liquidVals.Len() && i < int(maxRedelegations); i++ {
 		// get min, max of liquid token gap
 		minVal, maxVal, amountNeeded, last := liquidVals.MinMaxGap(targetMap, liquidTokenMap)
 		if amountNeeded.IsZero() || (i == 0 && !amountNeeded.GT(rebalancingThresholdAmt)) {
@@ -131,13 +133,48 @@ func (k Keeper) Rebalance(ctx sdk.Context, proxyAcc sdk.AccAddress, liquidVals t
 	return redelegations
 }
 
-// WithdrawRewardsAndReStake withdraw rewards and re-staking when over threshold
+// MintRewardFee mints bToken worth RewardFeeRate of the rewards to the FeeAccountAddress. The bToken are minted as if
+// the fee was liquid staked out of the rewards, so that the NetAmount backing the other bToken only grows by the
+// rewards net of fee and the mint rate stays consistent.
+func (k Keeper) MintRewardFee(ctx sdk.Context, rewards math.Int) (feeAmount, bTokenMintAmount math.Int, err error) {
+	params := k.GetParams(ctx)
+	if !params.RewardFeeRate.IsPositive() || params.FeeAccountAddress == "" {
+		return sdk.ZeroInt(), sdk.ZeroInt(), nil
+	}
+	feeAccount, err := sdk.AccAddressFromBech32(params.FeeAccountAddress)
+	if err != nil {
+		return sdk.ZeroInt(), sdk.ZeroInt(), err
+	}
+
+	feeAmount = params.RewardFeeRate.MulInt(rewards).TruncateInt()
+	nas := k.GetNetAmountState(ctx)
+	netAmountBeforeFee := nas.NetAmount.Sub(sdk.NewDecFromInt(feeAmount))
+	if !feeAmount.IsPositive() || !nas.BtokenTotalSupply.IsPositive() || !netAmountBeforeFee.IsPositive() {
+		return sdk.ZeroInt(), sdk.ZeroInt(), nil
+	}
+
+	bTokenMintAmount = types.NativeTokenToBToken(feeAmount, nas.BtokenTotalSupply, netAmountBeforeFee)
+	if !bTokenMintAmount.IsPositive() {
+		return sdk.ZeroInt(), sdk.ZeroInt(), nil
+	}
+	mintCoin := sdk.NewCoins(sdk.NewCoin(params.LiquidBondDenom, bTokenMintAmount))
+	if err = k.bankKeeper.MintCoins(ctx, types.ModuleName, mintCoin); err != nil {
+		return sdk.ZeroInt(), sdk.ZeroInt(), err
+	}
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, feeAccount, mintCoin); err != nil {
+		return sdk.ZeroInt(), sdk.ZeroInt(), err
+	}
+	return feeAmount, bTokenMintAmount, nil
+}
+
+// WithdrawRewardsAndReStake withdraw rewards and re-staking when over threshold, holding back the instant unstaking
+// reserve of the proxy account balance
 func (k Keeper) WithdrawRewardsAndReStake(ctx sdk.Context, whitelistedValsMap types.WhitelistedValsMap) {
 	totalRemainingRewards, _, totalLiquidTokens := k.CheckDelegationStates(ctx, types.LiquidStakingProxyAcc)
 
-	// checking over types.RewardTrigger and execute GetRewards
+	// checking over RewardTrigger param and execute GetRewards
 	proxyAccBalance := k.GetProxyAccBalance(ctx, types.LiquidStakingProxyAcc)
-	rewardsThreshold := types.RewardTrigger.Mul(sdk.NewDecFromInt(totalLiquidTokens))
+	rewardsThreshold := k.GetParams(ctx).RewardTrigger.Mul(sdk.NewDecFromInt(totalLiquidTokens))
 
 	// skip If it doesn't exceed the rewards threshold
 	if !sdk.NewDecFromInt(proxyAccBalance.Amount).Add(totalRemainingRewards).GT(rewardsThreshold) {
@@ -147,8 +184,18 @@ func (k Keeper) WithdrawRewardsAndReStake(ctx sdk.Context, whitelistedValsMap ty
 	// Withdraw rewards of LiquidStakingProxyAcc and re-staking
 	k.WithdrawLiquidRewards(ctx, types.LiquidStakingProxyAcc)
 
-	// re-staking with proxyAccBalance, due to auto-withdraw on add staking by f1
+	// re-staking with proxyAccBalance, due to auto-withdraw on add staking by f1,
+	// the reward fee is only charged on the rewards withdrawn above
+	balanceBeforeWithdraw := proxyAccBalance
 	proxyAccBalance = k.GetProxyAccBalance(ctx, types.LiquidStakingProxyAcc)
+	withdrawnRewards := proxyAccBalance.Amount.Sub(balanceBeforeWithdraw.Amount)
+
+	// the instant unstaking reserve, the InstantReserveRate of the NetAmount, is held back from the re-staking
+	reStakeAmount := proxyAccBalance
+	if reserveRate := k.GetParams(ctx).InstantReserveRate; reserveRate.IsPositive() {
+		nas := k.GetNetAmountState(ctx)
+		reStakeAmount = reStakeAmount.SubAmount(k.InstantUnstakeReserve(ctx, types.LiquidStakingProxyAcc, nas.NetAmount))
+	}
 
 	// skip when no active liquid validator
 	activeVals := k.GetActiveLiquidValidators(ctx, whitelistedValsMap)
@@ -156,26 +203,121 @@ func (k Keeper) WithdrawRewardsAndReStake(ctx sdk.Context, whitelistedValsMap ty
 		return
 	}
 
-	// re-staking
+	// mint reward fee and re-staking
 	cachedCtx, writeCache := ctx.CacheContext()
-	_, err := k.LiquidDelegate(cachedCtx, types.LiquidStakingProxyAcc, activeVals, proxyAccBalance.Amount, whitelistedValsMap)
+	feeAmount, bTokenMintAmount, err := k.MintRewardFee(cachedCtx, withdrawnRewards)
 	if err != nil {
 		logger := k.Logger(ctx)
-		logger.Error("re-staking failed", "error", err)
+		logger.Error("minting reward fee failed", "error", err)
 		return
 	}
+	if reStakeAmount.IsPositive() {
+		_, err = k.LiquidDelegate(cachedCtx, types.LiquidStakingProxyAcc, activeVals, reStakeAmount.Amount, whitelistedValsMap)
+		if err != nil {
+			logger := k.Logger(ctx)
+			logger.Error("re-staking failed", "error", err)
+			return
+		}
+	}
 	writeCache()
 	logger := k.Logger(ctx)
+	if bTokenMintAmount.IsPositive() {
+		feeAccount := k.GetParams(ctx).FeeAccountAddress
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRewardFee,
+				sdk.NewAttribute(types.AttributeKeyFeeAccount, feeAccount),
+				sdk.NewAttribute(types.AttributeKeyFeeAmount, feeAmount.String()),
+				sdk.NewAttribute(types.AttributeKeyBTokenMintedAmount, bTokenMintAmount.String()),
+			),
+		)
+		logger.Info(types.EventTypeRewardFee,
+			types.AttributeKeyFeeAccount, feeAccount,
+			types.AttributeKeyFeeAmount, feeAmount.String(),
+			types.AttributeKeyBTokenMintedAmount, bTokenMintAmount.String())
+	}
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeReStake,
 			sdk.NewAttribute(types.AttributeKeyDelegator, types.LiquidStakingProxyAcc.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, proxyAccBalance.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, reStakeAmount.String()),
 		),
 	})
 	logger.Info(types.EventTypeReStake,
 		types.AttributeKeyDelegator, types.LiquidStakingProxyAcc.String(),
-		sdk.AttributeKeyAmount, proxyAccBalance.String())
+		sdk.AttributeKeyAmount, reStakeAmount.String())
+}
+
+// UnbondInactiveLiquidValidators unbonds all delShares of the proxy account on the inactive liquid validators and
+// removes the ones without delegation. At most maxUnbonds unbondings are performed, the iteration resumes from the
+// persisted unbond cursor so that every inactive liquid validator is reached however large the set grows.
+func (k Keeper) UnbondInactiveLiquidValidators(ctx sdk.Context, liquidVals types.LiquidValidators, whitelistedValsMap types.WhitelistedValsMap, maxUnbonds uint32) {
+	logger := k.Logger(ctx)
+	if liquidVals.Len() == 0 {
+		k.DeleteUnbondCursor(ctx)
+		return
+	}
+
+	// resume from the first liquid validator not before the cursor on the store order
+	start := 0
+	if cursor, found := k.GetUnbondCursor(ctx); found {
+		cursorKey := types.GetLiquidValidatorKey(cursor)
+		for i, lv := range liquidVals {
+			if bytes.Compare(types.GetLiquidValidatorKey(lv.GetOperator()), cursorKey) >= 0 {
+				start = i
+				break
+			}
+		}
+	}
+
+	unbondCount := uint32(0)
+	for i := 0; i < liquidVals.Len(); i++ {
+		lv := liquidVals[(start+i)%liquidVals.Len()]
+		if k.IsActiveLiquidValidator(ctx, lv, whitelistedValsMap) {
+			continue
+		}
+		delShares := lv.GetDelShares(ctx, k.stakingKeeper)
+		if delShares.IsPositive() {
+			// the remaining inactive liquid validators are unbonded by the next updates
+			if unbondCount >= maxUnbonds {
+				k.SetUnbondCursor(ctx, lv.GetOperator())
+				return
+			}
+			unbondCount++
+			cachedCtx, writeCache := ctx.CacheContext()
+			completionTime, returnAmount, _, err := k.LiquidUnbond(cachedCtx, types.LiquidStakingProxyAcc, types.LiquidStakingProxyAcc, lv.GetOperator(), delShares, false)
+			if err != nil {
+				logger.Error("liquid unbonding of inactive liquid validator failed", "error", err)
+				continue
+			}
+			writeCache()
+			unbondingAmount := sdk.Coin{Denom: k.stakingKeeper.BondDenom(ctx), Amount: returnAmount}.String()
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeUnbondInactiveLiquidTokens,
+					sdk.NewAttribute(types.AttributeKeyLiquidValidator, lv.OperatorAddress),
+					sdk.NewAttribute(types.AttributeKeyUnbondingAmount, unbondingAmount),
+					sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+				),
+			})
+			logger.Info(types.EventTypeUnbondInactiveLiquidTokens,
+				types.AttributeKeyLiquidValidator, lv.OperatorAddress,
+				types.AttributeKeyUnbondingAmount, unbondingAmount,
+				types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339))
+		}
+		_, found := k.stakingKeeper.GetDelegation(ctx, types.LiquidStakingProxyAcc, lv.GetOperator())
+		if !found {
+			k.RemoveLiquidValidator(ctx, lv)
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeRemoveLiquidValidator,
+					sdk.NewAttribute(types.AttributeKeyLiquidValidator, lv.OperatorAddress),
+				),
+			})
+			logger.Info(types.EventTypeRemoveLiquidValidator, types.AttributeKeyLiquidValidator, lv.OperatorAddress)
+		}
+	}
+	k.DeleteUnbondCursor(ctx)
 }
 
 func (k Keeper) UpdateLiquidValidatorSet(ctx sdk.Context) []types.Redelegation {
@@ -183,7 +325,7 @@ func (k Keeper) UpdateLiquidValidatorSet(ctx sdk.Context) []types.Redelegation {
 	params := k.GetParams(ctx)
 	liquidValidators := k.GetAllLiquidValidators(ctx)
 	liquidValsMap := liquidValidators.Map()
-	whitelistedValsMap := types.GetWhitelistedValsMap(params.WhitelistedValidators)
+	whitelistedValsMap := k.GetWhitelistedValsMap(ctx, params)
 
 	// Set Liquid validators for added whitelist validators
 	for _, wv := range params.WhitelistedValidators {
@@ -205,51 +347,23 @@ func (k Keeper) UpdateLiquidValidatorSet(ctx sdk.Context) []types.Redelegation {
 		}
 	}
 
+	// rebalancing and re-staking walk every proxy delegation, so they only run every RebalancingFrequency blocks,
+	// by the first liquid validator set update of those blocks
+	rebalancing := ctx.BlockHeight()%int64(params.RebalancingFrequency) < int64(params.UpdateFrequency)
+
 	// rebalancing based updated liquid validators status with threshold, try by cachedCtx
 	// tombstone status also handled on Rebalance
-	reds := k.Rebalance(ctx, types.LiquidStakingProxyAcc, liquidValidators, whitelistedValsMap, types.RebalancingTrigger)
-
-	// unbond all delShares to proxyAcc if delShares exist on inactive liquid validators
-	for _, lv := range liquidValidators {
-		if !k.IsActiveLiquidValidator(ctx, lv, whitelistedValsMap) {
-			delShares := lv.GetDelShares(ctx, k.stakingKeeper)
-			if delShares.IsPositive() {
-				cachedCtx, writeCache := ctx.CacheContext()
-				completionTime, returnAmount, _, err := k.LiquidUnbond(cachedCtx, types.LiquidStakingProxyAcc, types.LiquidStakingProxyAcc, lv.GetOperator(), delShares, false)
-				if err != nil {
-					logger.Error("liquid unbonding of inactive liquid validator failed", "error", err)
-					continue
-				}
-				writeCache()
-				unbondingAmount := sdk.Coin{Denom: k.stakingKeeper.BondDenom(ctx), Amount: returnAmount}.String()
-				ctx.EventManager().EmitEvents(sdk.Events{
-					sdk.NewEvent(
-						types.EventTypeUnbondInactiveLiquidTokens,
-						sdk.NewAttribute(types.AttributeKeyLiquidValidator, lv.OperatorAddress),
-						sdk.NewAttribute(types.AttributeKeyUnbondingAmount, unbondingAmount),
-						sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
-					),
-				})
-				logger.Info(types.EventTypeUnbondInactiveLiquidTokens,
-					types.AttributeKeyLiquidValidator, lv.OperatorAddress,
-					types.AttributeKeyUnbondingAmount, unbondingAmount,
-					types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339))
-			}
-			_, found := k.stakingKeeper.GetDelegation(ctx, types.LiquidStakingProxyAcc, lv.GetOperator())
-			if !found {
-				k.RemoveLiquidValidator(ctx, lv)
-				ctx.EventManager().EmitEvents(sdk.Events{
-					sdk.NewEvent(
-						types.EventTypeRemoveLiquidValidator,
-						sdk.NewAttribute(types.AttributeKeyLiquidValidator, lv.OperatorAddress),
-					),
-				})
-				logger.Info(types.EventTypeRemoveLiquidValidator, types.AttributeKeyLiquidValidator, lv.OperatorAddress)
-			}
-		}
+	var reds []types.Redelegation
+	if rebalancing {
+		reds = k.Rebalance(ctx, types.LiquidStakingProxyAcc, liquidValidators, whitelistedValsMap, params.RebalancingTrigger, params.MaxRedelegationsPerBlock)
 	}
 
+	// unbond all delShares to proxyAcc if delShares exist on inactive liquid validators, at most MaxUnbondsPerBlock
+	k.UnbondInactiveLiquidValidators(ctx, liquidValidators, whitelistedValsMap, params.MaxUnbondsPerBlock)
+
 	// withdraw rewards and re-staking when over threshold
-	k.WithdrawRewardsAndReStake(ctx, whitelistedValsMap)
+	if rebalancing {
+		k.WithdrawRewardsAndReStake(ctx, whitelistedValsMap)
+	}
 	return reds
 }
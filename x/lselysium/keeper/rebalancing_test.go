@@ -502,6 +502,60 @@ func (s *KeeperTestSuite) TestWithdrawRewardsAndReStaking() {
 	s.EqualValues(totalDelSharesAfter, totalRewards.TruncateDec().Add(totalDelShares), totalLiquidTokensAfter)
 }
 
+func (s *KeeperTestSuite) TestWithdrawRewardsAndReStakingWithRewardFee() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	params.RewardFeeRate = sdk.NewDecWithPrec(1, 1)
+	params.FeeAccountAddress = s.delAddrs[1].String()
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	stakingAmt := sdk.NewInt(100000000)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], stakingAmt))
+
+	// no rewards, no fee
+	feeAmount, bTokenMintAmount, err := s.keeper.MintRewardFee(s.ctx, sdk.ZeroInt())
+	s.Require().NoError(err)
+	s.Require().True(feeAmount.IsZero())
+	s.Require().True(bTokenMintAmount.IsZero())
+
+	// allocate rewards
+	s.advanceHeight(100, false)
+	totalRewards, totalDelShares, _ := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().True(totalRewards.IsPositive())
+
+	// idle balance of the proxy account is re-staked but not charged
+	idleAmt := sdk.NewInt(1000000)
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, types.LiquidStakingProxyAcc, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, idleAmt))))
+	nasBefore := s.keeper.GetNetAmountState(s.ctx)
+
+	// withdraw rewards, mint reward fee and re-staking
+	whitelistedValsMap := types.GetWhitelistedValsMap(params.WhitelistedValidators)
+	s.keeper.WithdrawRewardsAndReStake(s.ctx, whitelistedValsMap)
+	totalRewardsAfter, totalDelSharesAfter, totalLiquidTokensAfter := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.EqualValues(totalRewardsAfter, sdk.ZeroDec())
+	// all the rewards are re-staked along with the idle balance, the fee is only paid in bToken
+	s.EqualValues(totalDelSharesAfter, totalRewards.TruncateDec().Add(totalDelShares).Add(sdk.NewDecFromInt(idleAmt)), totalLiquidTokensAfter)
+
+	feeBalance := s.app.BankKeeper.GetBalance(s.ctx, s.delAddrs[1], params.LiquidBondDenom).Amount
+	s.Require().True(feeBalance.IsPositive())
+
+	// the fee account owns the fee share of the rewards, the liquid staker the rest
+	nasAfter := s.keeper.GetNetAmountState(s.ctx)
+	s.Require().Equal(nasBefore.BtokenTotalSupply.Add(feeBalance), nasAfter.BtokenTotalSupply)
+	feeValue := types.BTokenToNativeToken(feeBalance, nasAfter.BtokenTotalSupply, nasAfter.NetAmount)
+	expectedFee := params.RewardFeeRate.Mul(totalRewards)
+	s.Require().True(feeValue.LTE(expectedFee))
+	s.Require().True(feeValue.GT(expectedFee.Sub(sdk.NewDec(10))))
+	stakerValue := types.BTokenToNativeToken(nasBefore.BtokenTotalSupply, nasAfter.BtokenTotalSupply, nasAfter.NetAmount)
+	s.Require().True(stakerValue.GTE(nasBefore.NetAmount.Sub(expectedFee).Sub(sdk.NewDec(10))))
+}
+
 func (s *KeeperTestSuite) TestRemoveAllLiquidValidator() {
 	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
 	params := s.keeper.GetParams(s.ctx)
@@ -546,3 +600,105 @@ func (s *KeeperTestSuite) TestRemoveAllLiquidValidator() {
 	s.Require().EqualValues(nasAfter2.ProxyAccBalance, nasAfter.ProxyAccBalance.Add(nasBefore.TotalLiquidTokens))
 	s.Require().EqualValues(nasAfter2.NetAmount.TruncateInt(), nasBefore.NetAmount.TruncateInt())
 }
+
+func (s *KeeperTestSuite) TestUnbondInactiveLiquidValidatorsWithCursor() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(30000000)))
+	lvs := s.keeper.GetAllLiquidValidators(s.ctx)
+	s.Require().Len(lvs, 3)
+
+	// remove all whitelist, only one inactive liquid validator is unbonded by each update
+	params.WhitelistedValidators = []types.WhitelistedValidator{}
+	params.MaxUnbondsPerBlock = 1
+	s.keeper.SetParams(s.ctx, params)
+
+	for i := range lvs {
+		s.keeper.UpdateLiquidValidatorSet(s.ctx)
+		s.Require().Len(s.keeper.GetAllLiquidValidators(s.ctx), len(lvs)-i-1)
+		_, found := s.keeper.GetLiquidValidator(s.ctx, lvs[i].GetOperator())
+		s.Require().False(found)
+
+		cursor, found := s.keeper.GetUnbondCursor(s.ctx)
+		if i < len(lvs)-1 {
+			// the next update resumes from the next inactive liquid validator
+			s.Require().True(found)
+			s.Require().Equal(lvs[i+1].GetOperator(), cursor)
+		} else {
+			s.Require().False(found)
+		}
+	}
+
+	nas := s.keeper.GetNetAmountState(s.ctx)
+	s.Require().EqualValues(sdk.ZeroInt(), nas.TotalLiquidTokens)
+	s.Require().EqualValues(sdk.NewInt(30000000), nas.TotalUnbondingBalance)
+}
+
+func (s *KeeperTestSuite) TestUpdateFrequency() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	params.UpdateFrequency = 10
+	s.keeper.SetParams(s.ctx, params)
+
+	// the liquid validator set is not updated until the block height is a multiple of UpdateFrequency
+	s.advanceHeight(9, true)
+	s.Require().Equal(int64(109), s.ctx.BlockHeight())
+	s.Require().Len(s.keeper.GetAllLiquidValidators(s.ctx), 0)
+
+	s.advanceHeight(1, true)
+	s.Require().Len(s.keeper.GetAllLiquidValidators(s.ctx), 2)
+}
+
+func (s *KeeperTestSuite) TestRebalancingFrequency() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000, 1000000})
+	s.ctx = s.ctx.WithBlockHeight(100).WithBlockTime(helpers.ParseTime("2022-03-01T00:00:00Z"))
+	params := s.keeper.GetParams(s.ctx)
+	params.UnstakeFeeRate = sdk.ZeroDec()
+	params.MinLiquidStakingAmount = sdk.NewInt(10000)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	_, _, err := s.keeper.LiquidStake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(49998)))
+	s.Require().NoError(err)
+
+	// rebalancing every 20 blocks, the liquid validator set is updated every 10 blocks
+	params.WhitelistedValidators = append(params.WhitelistedValidators,
+		types.WhitelistedValidator{ValidatorAddress: valOpers[3].String(), TargetWeight: sdk.NewInt(10)})
+	params.UpdateFrequency = 10
+	params.RebalancingFrequency = 20
+	s.keeper.SetParams(s.ctx, params)
+
+	// the new liquid validator is added at block 110 but not rebalanced yet
+	s.ctx = s.ctx.WithBlockHeight(110)
+	reds := s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(reds, 0)
+	s.Require().Len(s.keeper.GetAllLiquidValidators(s.ctx), 4)
+	_, found := s.app.StakingKeeper.GetDelegation(s.ctx, types.LiquidStakingProxyAcc, valOpers[3])
+	s.Require().False(found)
+
+	// the update at block 120 rebalances
+	s.ctx = s.ctx.WithBlockHeight(120)
+	reds = s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(reds, 3)
+	proxyAccDel4, found := s.app.StakingKeeper.GetDelegation(s.ctx, types.LiquidStakingProxyAcc, valOpers[3])
+	s.Require().True(found)
+	s.Require().EqualValues(sdk.NewInt(12499), proxyAccDel4.Shares.TruncateInt())
+}
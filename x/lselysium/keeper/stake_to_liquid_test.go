@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/merlin-network/estake-native/v2/x/lselysium/keeper"
+	"github.com/merlin-network/estake-native/v2/x/lselysium/types"
+)
+
+func (s *KeeperTestSuite) TestStakeToLiquid() {
+	valAddrs, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(10000000)))
+
+	// native delegations to a liquid and a non liquid validator
+	delegator := s.delAddrs[1]
+	for _, valOper := range []sdk.ValAddress{valOpers[0], valOpers[2]} {
+		val, found := s.app.StakingKeeper.GetValidator(s.ctx, valOper)
+		s.Require().True(found)
+		_, err := s.app.StakingKeeper.Delegate(s.ctx, delegator, sdk.NewInt(5000000), stakingtypes.Unbonded, val, true)
+		s.Require().NoError(err)
+	}
+
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := msgServer.StakeToLiquid(goCtx, types.NewMsgStakeToLiquid(delegator, valOpers[2], sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2000000))))
+	s.Require().ErrorIs(err, types.ErrLiquidValidatorNotActive)
+	_, err = msgServer.StakeToLiquid(goCtx, types.NewMsgStakeToLiquid(delegator, valOpers[0], sdk.NewCoin(sdk.DefaultBondDenom, params.MinLiquidStakingAmount.SubRaw(1))))
+	s.Require().ErrorIs(err, types.ErrLessThanMinLiquidStakingAmount)
+	_, err = msgServer.StakeToLiquid(goCtx, types.NewMsgStakeToLiquid(delegator, valOpers[0], sdk.NewCoin("invalidDenom", sdk.NewInt(2000000))))
+	s.Require().ErrorIs(err, types.ErrInvalidBondDenom)
+	_, err = msgServer.StakeToLiquid(goCtx, types.NewMsgStakeToLiquid(s.delAddrs[2], valOpers[0], sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2000000))))
+	s.Require().ErrorIs(err, stakingtypes.ErrNoDelegation)
+
+	nas := s.keeper.GetNetAmountState(s.ctx)
+	proxyDel, found := s.app.StakingKeeper.GetDelegation(s.ctx, types.LiquidStakingProxyAcc, valOpers[0])
+	s.Require().True(found)
+	balanceBefore := s.app.BankKeeper.GetBalance(s.ctx, delegator, sdk.DefaultBondDenom)
+
+	stakingAmt := sdk.NewInt(2000000)
+	_, err = msgServer.StakeToLiquid(goCtx, types.NewMsgStakeToLiquid(delegator, valOpers[0], sdk.NewCoin(sdk.DefaultBondDenom, stakingAmt)))
+	s.Require().NoError(err)
+
+	// bToken is minted at the current mint rate without moving any native token
+	expectedMintAmt := types.NativeTokenToBToken(stakingAmt, nas.BtokenTotalSupply, nas.NetAmount)
+	s.Require().Equal(expectedMintAmt, s.app.BankKeeper.GetBalance(s.ctx, delegator, params.LiquidBondDenom).Amount)
+	s.Require().Equal(balanceBefore, s.app.BankKeeper.GetBalance(s.ctx, delegator, sdk.DefaultBondDenom))
+
+	// the delegation shares are moved to the proxy account
+	del, found := s.app.StakingKeeper.GetDelegation(s.ctx, delegator, valOpers[0])
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewDec(3000000), del.Shares)
+	proxyDelAfter, found := s.app.StakingKeeper.GetDelegation(s.ctx, types.LiquidStakingProxyAcc, valOpers[0])
+	s.Require().True(found)
+	s.Require().Equal(proxyDel.Shares.Add(sdk.NewDec(2000000)), proxyDelAfter.Shares)
+
+	nasAfter := s.keeper.GetNetAmountState(s.ctx)
+	s.Require().Equal(nas.TotalLiquidTokens.Add(stakingAmt), nasAfter.TotalLiquidTokens)
+	s.Require().Equal(nas.BtokenTotalSupply.Add(expectedMintAmt), nasAfter.BtokenTotalSupply)
+
+	// the operator can not unbond below the min self delegation, it would jail the validator
+	cachedCtx, _ := s.ctx.CacheContext()
+	_, err = msgServer.StakeToLiquid(sdk.WrapSDKContext(cachedCtx), types.NewMsgStakeToLiquid(valAddrs[0], valOpers[0], sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000))))
+	s.Require().ErrorIs(err, types.ErrSelfDelegationBelowMinimum)
+
+	// the shares of a receiving redelegation can not be moved
+	_, err = s.app.StakingKeeper.BeginRedelegation(s.ctx, delegator, valOpers[2], valOpers[0], sdk.NewDec(2000000))
+	s.Require().NoError(err)
+	_, err = msgServer.StakeToLiquid(goCtx, types.NewMsgStakeToLiquid(delegator, valOpers[0], sdk.NewCoin(sdk.DefaultBondDenom, stakingAmt)))
+	s.Require().ErrorIs(err, types.ErrReceivingRedelegation)
+}
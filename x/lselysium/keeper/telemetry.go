@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/merlin-network/estake-native/v2/utils"
+	"github.com/merlin-network/estake-native/v2/x/lselysium/types"
+)
+
+// EmitTelemetry updates the liquid staking health gauges from the NetAmountState
+// and the active liquid validator set.
+func (k Keeper) EmitTelemetry(ctx sdk.Context) {
+	nas := k.GetNetAmountState(ctx)
+	telemetry.ModuleSetGauge(types.ModuleName, utils.DecToFloat32(nas.MintRate), types.MetricKeyMintRate)
+	telemetry.ModuleSetGauge(types.ModuleName, utils.DecToFloat32(nas.NetAmount), types.MetricKeyNetAmount)
+	telemetry.ModuleSetGauge(types.ModuleName, utils.IntToFloat32(nas.BtokenTotalSupply), types.MetricKeyBTokenSupply)
+	telemetry.ModuleSetGauge(types.ModuleName, utils.IntToFloat32(nas.TotalLiquidTokens), types.MetricKeyTotalLiquidTokens)
+	telemetry.ModuleSetGauge(types.ModuleName, utils.DecToFloat32(nas.TotalRemainingRewards), types.MetricKeyTotalRemainingRewards)
+	telemetry.ModuleSetGauge(types.ModuleName, utils.IntToFloat32(nas.TotalUnbondingBalance), types.MetricKeyTotalUnbondingBalance)
+	telemetry.ModuleSetGauge(types.ModuleName, utils.IntToFloat32(nas.ProxyAccBalance), types.MetricKeyProxyAccBalance)
+
+	whitelistedValsMap := types.GetWhitelistedValsMap(k.GetParams(ctx).WhitelistedValidators)
+	activeVals := k.GetActiveLiquidValidators(ctx, whitelistedValsMap)
+	telemetry.ModuleSetGauge(types.ModuleName, float32(len(activeVals)), types.MetricKeyActiveLiquidVals)
+}
@@ -0,0 +1,267 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/merlin-network/estake-native/v2/x/lselysium/types"
+)
+
+// GetLastUnbondingRequestID returns the id of the last unbonding request.
+func (k Keeper) GetLastUnbondingRequestID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastUnbondingRequestIDKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastUnbondingRequestID sets the id of the last unbonding request.
+func (k Keeper) SetLastUnbondingRequestID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastUnbondingRequestIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetUnbondingRequest returns the unbonding request of the liquid staker with id.
+func (k Keeper) GetUnbondingRequest(ctx sdk.Context, staker sdk.AccAddress, id uint64) (req types.UnbondingRequest, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetUnbondingRequestKey(staker, id))
+	if bz == nil {
+		return req, false
+	}
+	k.cdc.MustUnmarshal(bz, &req)
+	return req, true
+}
+
+// SetUnbondingRequest sets the unbonding request.
+func (k Keeper) SetUnbondingRequest(ctx sdk.Context, req types.UnbondingRequest) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&req)
+	store.Set(types.GetUnbondingRequestKey(req.GetStaker(), req.Id), bz)
+}
+
+// DeleteUnbondingRequest deletes the unbonding request.
+func (k Keeper) DeleteUnbondingRequest(ctx sdk.Context, req types.UnbondingRequest) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetUnbondingRequestKey(req.GetStaker(), req.Id))
+}
+
+// GetUnbondingRequestsByStaker returns all unbonding requests of the liquid staker ordered by id.
+func (k Keeper) GetUnbondingRequestsByStaker(ctx sdk.Context, staker sdk.AccAddress) (reqs []types.UnbondingRequest) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetUnbondingRequestsByStakerKey(staker))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var req types.UnbondingRequest
+		k.cdc.MustUnmarshal(iterator.Value(), &req)
+		reqs = append(reqs, req)
+	}
+	return reqs
+}
+
+// GetAllUnbondingRequests returns the unbonding requests of all liquid stakers.
+func (k Keeper) GetAllUnbondingRequests(ctx sdk.Context) (reqs []types.UnbondingRequest) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.UnbondingRequestsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var req types.UnbondingRequest
+		k.cdc.MustUnmarshal(iterator.Value(), &req)
+		reqs = append(reqs, req)
+	}
+	return reqs
+}
+
+// DeleteMaturedUnbondingRequests deletes the unbonding requests of the liquid staker whose unbonding delegations
+// have matured, the staking module has already paid them out. The pooled ones are kept until claimed.
+func (k Keeper) DeleteMaturedUnbondingRequests(ctx sdk.Context, staker sdk.AccAddress) {
+	for _, req := range k.GetUnbondingRequestsByStaker(ctx, staker) {
+		if !req.Pooled && !req.CompletionTime.After(ctx.BlockTime()) {
+			k.DeleteUnbondingRequest(ctx, req)
+		}
+	}
+}
+
+// AddUnbondingRequest records a liquid unstaking of the liquid staker with a new id, after pruning its matured
+// unbonding requests.
+func (k Keeper) AddUnbondingRequest(
+	ctx sdk.Context, staker sdk.AccAddress, bTokenBurned sdk.Coin, unbondingAmount math.Int,
+	entries []types.UnbondingRequestEntry, completionTime time.Time, pooled bool,
+) types.UnbondingRequest {
+	k.DeleteMaturedUnbondingRequests(ctx, staker)
+
+	id := k.GetLastUnbondingRequestID(ctx) + 1
+	req := types.UnbondingRequest{
+		Id:              id,
+		StakerAddress:   staker.String(),
+		BtokenBurned:    bTokenBurned,
+		UnbondingAmount: unbondingAmount,
+		Entries:         entries,
+		CreationHeight:  ctx.BlockHeight(),
+		CompletionTime:  completionTime,
+		Pooled:          pooled,
+	}
+	k.SetUnbondingRequest(ctx, req)
+	k.SetLastUnbondingRequestID(ctx, id)
+	return req
+}
+
+// GetPooledUnbonding returns the pooled unbonding of the block on the liquid validator.
+func (k Keeper) GetPooledUnbonding(ctx sdk.Context, creationHeight int64, valAddr sdk.ValAddress) (pu types.PooledUnbonding, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPooledUnbondingKey(creationHeight, valAddr))
+	if bz == nil {
+		return pu, false
+	}
+	k.cdc.MustUnmarshal(bz, &pu)
+	return pu, true
+}
+
+// SetPooledUnbonding sets the pooled unbonding.
+func (k Keeper) SetPooledUnbonding(ctx sdk.Context, pu types.PooledUnbonding) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pu)
+	store.Set(types.GetPooledUnbondingKey(pu.CreationHeight, pu.GetValidator()), bz)
+}
+
+// DeletePooledUnbonding deletes the pooled unbonding.
+func (k Keeper) DeletePooledUnbonding(ctx sdk.Context, pu types.PooledUnbonding) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPooledUnbondingKey(pu.CreationHeight, pu.GetValidator()))
+}
+
+// GetAllPooledUnbondings returns all pooled unbondings ordered by creation height.
+func (k Keeper) GetAllPooledUnbondings(ctx sdk.Context) (pus []types.PooledUnbonding) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PooledUnbondingsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pu types.PooledUnbonding
+		k.cdc.MustUnmarshal(iterator.Value(), &pu)
+		pus = append(pus, pu)
+	}
+	return pus
+}
+
+// InsertPooledUnbondingQueue queues the pooled unbonding to be settled once matured.
+func (k Keeper) InsertPooledUnbondingQueue(ctx sdk.Context, pu types.PooledUnbonding) {
+	store := ctx.KVStore(k.storeKey)
+	valAddr := pu.GetValidator()
+	store.Set(types.GetPooledUnbondingQueueKey(pu.CompletionTime, pu.CreationHeight, valAddr), types.GetPooledUnbondingKey(pu.CreationHeight, valAddr))
+}
+
+// AddPooledUnbonding adds the amount unbonded from the liquid validator by a pooled liquid unstaking of the current
+// block to its pooled unbonding, which is created and queued by the first pooled liquid unstaking of the block.
+func (k Keeper) AddPooledUnbonding(ctx sdk.Context, valAddr sdk.ValAddress, completionTime time.Time, amount math.Int) {
+	pu, found := k.GetPooledUnbonding(ctx, ctx.BlockHeight(), valAddr)
+	if !found {
+		pu = types.PooledUnbonding{
+			ValidatorAddress: valAddr.String(),
+			CreationHeight:   ctx.BlockHeight(),
+			CompletionTime:   completionTime,
+			UnclaimedAmount:  sdk.ZeroInt(),
+			Balance:          sdk.ZeroInt(),
+		}
+		k.InsertPooledUnbondingQueue(ctx, pu)
+	}
+	pu.UnclaimedAmount = pu.UnclaimedAmount.Add(amount)
+	pu.Balance = pu.Balance.Add(amount)
+	k.SetPooledUnbonding(ctx, pu)
+}
+
+// SettleMaturedPooledUnbondings sets the balance of the pooled unbondings maturing by the current block to the balance
+// of their unbonding delegation entries, which can no longer be slashed and are paid out to the UnbondingPoolAcc by the
+// staking EndBlocker of the block. A pooled unbonding without its unbonding delegation entry is settled to a zero
+// balance, so that its claims can not be paid out of the balances of the other pooled unbondings.
+func (k Keeper) SettleMaturedPooledUnbondings(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.PooledUnbondingQueueKey, sdk.PrefixEndBytes(types.GetPooledUnbondingQueueTimeKey(ctx.BlockTime())))
+	var queueKeys, puKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		queueKeys = append(queueKeys, iterator.Key())
+		puKeys = append(puKeys, iterator.Value())
+	}
+	iterator.Close()
+
+	for i, queueKey := range queueKeys {
+		store.Delete(queueKey)
+		bz := store.Get(puKeys[i])
+		if bz == nil {
+			continue
+		}
+		var pu types.PooledUnbonding
+		k.cdc.MustUnmarshal(bz, &pu)
+
+		balance, found := sdk.ZeroInt(), false
+		if ubd, ok := k.stakingKeeper.GetUnbondingDelegation(ctx, types.UnbondingPoolAcc, pu.GetValidator()); ok {
+			for _, entry := range ubd.Entries {
+				if entry.CreationHeight == pu.CreationHeight && entry.CompletionTime.Equal(pu.CompletionTime) {
+					balance, found = entry.Balance, true
+					break
+				}
+			}
+		}
+		if !found {
+			k.Logger(ctx).Error("unbonding delegation entry of the pooled unbonding not found",
+				"validator", pu.ValidatorAddress, "creation_height", pu.CreationHeight)
+		}
+		pu.Balance = balance
+		k.SetPooledUnbonding(ctx, pu)
+	}
+}
+
+// ClaimUnbonded pays the liquid staker out of the UnbondingPoolAcc for its matured pooled unbonding requests and
+// deletes them. Each unbonding request entry is paid its share of the balance of the pooled unbonding it was merged
+// into, so that the slashings of the unbonding delegation entry are borne pro rata by the unbonding requests.
+func (k Keeper) ClaimUnbonded(ctx sdk.Context, liquidStaker sdk.AccAddress) (claimed sdk.Coin, err error) {
+	claimed = sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdk.ZeroInt())
+	var matured []types.UnbondingRequest
+	for _, req := range k.GetUnbondingRequestsByStaker(ctx, liquidStaker) {
+		if !req.Pooled || req.CompletionTime.After(ctx.BlockTime()) {
+			continue
+		}
+		matured = append(matured, req)
+		for _, entry := range req.Entries {
+			pu, found := k.GetPooledUnbonding(ctx, req.CreationHeight, entry.GetValidator())
+			if !found {
+				return claimed, errorsmod.Wrapf(types.ErrPooledUnbondingNotFound, "unbonding request %d on %s", req.Id, entry.ValidatorAddress)
+			}
+			// the last unbonding request claims what is left of the balance
+			paid := pu.Balance
+			if entry.Amount.LT(pu.UnclaimedAmount) {
+				paid = pu.Balance.Mul(entry.Amount).Quo(pu.UnclaimedAmount)
+			}
+			pu.Balance = pu.Balance.Sub(paid)
+			pu.UnclaimedAmount = pu.UnclaimedAmount.Sub(entry.Amount)
+			if pu.UnclaimedAmount.IsPositive() {
+				k.SetPooledUnbonding(ctx, pu)
+			} else {
+				k.DeletePooledUnbonding(ctx, pu)
+			}
+			claimed.Amount = claimed.Amount.Add(paid)
+		}
+	}
+	if len(matured) == 0 {
+		return claimed, types.ErrNoClaimableUnbonding
+	}
+
+	// the unbonding delegations maturing in the current block are only completed by the staking EndBlocker
+	poolBalance := k.GetProxyAccBalance(ctx, types.UnbondingPoolAcc)
+	if poolBalance.Amount.LT(claimed.Amount) {
+		return claimed, errorsmod.Wrapf(types.ErrInsufficientUnbondingPool, "pool balance %s, claimed %s", poolBalance, claimed)
+	}
+	if err = k.bankKeeper.SendCoins(ctx, types.UnbondingPoolAcc, liquidStaker, sdk.NewCoins(claimed)); err != nil {
+		return claimed, err
+	}
+	for _, req := range matured {
+		k.DeleteUnbondingRequest(ctx, req)
+	}
+	return claimed, nil
+}
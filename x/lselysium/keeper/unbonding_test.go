@@ -0,0 +1,308 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/merlin-network/estake-native/v2/x/lselysium/keeper"
+	"github.com/merlin-network/estake-native/v2/x/lselysium/types"
+)
+
+func (s *KeeperTestSuite) TestUnbondingRequests() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	staker := s.delAddrs[0]
+	s.Require().NoError(s.liquidStaking(staker, sdk.NewInt(30000000)))
+
+	unstakingBtoken := sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(3000000))
+	ubdTime, unbondingAmt, _, _, err := s.liquidUnstakingWithResult(staker, unstakingBtoken)
+	s.Require().NoError(err)
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(s.ctx.BlockTime().Add(BlockTime))
+	_, _, _, _, err = s.liquidUnstakingWithResult(staker, unstakingBtoken)
+	s.Require().NoError(err)
+
+	reqs := s.keeper.GetUnbondingRequestsByStaker(s.ctx, staker)
+	s.Require().Len(reqs, 2)
+	s.Require().Equal(uint64(1), reqs[0].Id)
+	s.Require().Equal(uint64(2), reqs[1].Id)
+	s.Require().Equal(staker.String(), reqs[0].StakerAddress)
+	s.Require().Equal(unstakingBtoken, reqs[0].BtokenBurned)
+	s.Require().Equal(unbondingAmt, reqs[0].UnbondingAmount)
+	s.Require().Equal(ubdTime, reqs[0].CompletionTime)
+	s.Require().Len(reqs[0].Entries, 3)
+	entriesAmt := sdk.ZeroInt()
+	for _, entry := range reqs[0].Entries {
+		entriesAmt = entriesAmt.Add(entry.Amount)
+	}
+	s.Require().Equal(unbondingAmt, entriesAmt)
+	s.Require().Equal(reqs, s.keeper.GetAllUnbondingRequests(s.ctx))
+
+	// Test UnbondingRequests grpc query
+	resp, err := s.querier.UnbondingRequests(sdk.WrapSDKContext(s.ctx), &types.QueryUnbondingRequestsRequest{Staker: staker.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(reqs, resp.UnbondingRequests)
+
+	resp, err = s.querier.UnbondingRequests(sdk.WrapSDKContext(s.ctx), &types.QueryUnbondingRequestsRequest{
+		Staker:     staker.String(),
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(reqs[:1], resp.UnbondingRequests)
+	s.Require().NotNil(resp.Pagination.NextKey)
+
+	resp, err = s.querier.UnbondingRequests(sdk.WrapSDKContext(s.ctx), &types.QueryUnbondingRequestsRequest{Staker: s.delAddrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Len(resp.UnbondingRequests, 0)
+
+	resp, err = s.querier.UnbondingRequests(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Nil(resp)
+	s.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	_, err = s.querier.UnbondingRequests(sdk.WrapSDKContext(s.ctx), &types.QueryUnbondingRequestsRequest{Staker: "invalid"})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	// the matured unbonding requests are left out of the query
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(ubdTime)
+	resp, err = s.querier.UnbondingRequests(sdk.WrapSDKContext(s.ctx), &types.QueryUnbondingRequestsRequest{Staker: staker.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(reqs[1:], resp.UnbondingRequests)
+
+	// the matured unbonding requests are pruned by the next liquid unstaking
+	_, _, _, _, err = s.liquidUnstakingWithResult(staker, unstakingBtoken)
+	s.Require().NoError(err)
+	reqs = s.keeper.GetUnbondingRequestsByStaker(s.ctx, staker)
+	s.Require().Len(reqs, 2)
+	s.Require().Equal(uint64(2), reqs[0].Id)
+	s.Require().Equal(uint64(3), reqs[1].Id)
+	s.Require().Equal(uint64(3), s.keeper.GetLastUnbondingRequestID(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestPooledLiquidUnstake() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	staker := s.delAddrs[0]
+	s.Require().NoError(s.liquidStaking(staker, sdk.NewInt(30000000)))
+
+	// more pooled liquid unstakings than the max unbonding entries of the staking module
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	maxEntries := s.app.StakingKeeper.MaxEntries(s.ctx)
+	unstakingBtoken := sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(1000000))
+	var completionTime time.Time
+	totalUnbondingAmt := sdk.ZeroInt()
+	valUnbondingAmts := map[string]math.Int{}
+	for i := uint32(0); i <= maxEntries; i++ {
+		resp, err := msgServer.PooledLiquidUnstake(sdk.WrapSDKContext(s.ctx), types.NewMsgPooledLiquidUnstake(staker, unstakingBtoken))
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(i+1), resp.UnbondingRequestId)
+		req, found := s.keeper.GetUnbondingRequest(s.ctx, staker, resp.UnbondingRequestId)
+		s.Require().True(found)
+		s.Require().True(req.Pooled)
+		totalUnbondingAmt = totalUnbondingAmt.Add(req.UnbondingAmount)
+		for _, entry := range req.Entries {
+			if amt, ok := valUnbondingAmts[entry.ValidatorAddress]; ok {
+				valUnbondingAmts[entry.ValidatorAddress] = amt.Add(entry.Amount)
+			} else {
+				valUnbondingAmts[entry.ValidatorAddress] = entry.Amount
+			}
+		}
+		completionTime = resp.CompletionTime
+	}
+
+	// the unbonding delegations are queued under the unbonding pool, not the liquid staker, and the pooled liquid
+	// unstakings of a block are merged into a single entry per validator
+	for _, valOper := range valOpers {
+		_, found := s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, staker, valOper)
+		s.Require().False(found)
+		ubd, found := s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, types.UnbondingPoolAcc, valOper)
+		s.Require().True(found)
+		s.Require().Len(ubd.Entries, 1)
+		s.Require().Equal(valUnbondingAmts[valOper.String()], ubd.Entries[0].InitialBalance)
+		s.Require().Equal(valUnbondingAmts[valOper.String()], ubd.Entries[0].Balance)
+		s.Require().Equal(completionTime, ubd.Entries[0].CompletionTime)
+	}
+
+	// a pooled liquid unstaking of the next block gets its own entry
+	nextCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(s.ctx.BlockTime().Add(BlockTime))
+	cachedCtx, _ := nextCtx.CacheContext()
+	_, err := msgServer.PooledLiquidUnstake(sdk.WrapSDKContext(cachedCtx), types.NewMsgPooledLiquidUnstake(staker, unstakingBtoken))
+	s.Require().NoError(err)
+	ubd, found := s.app.StakingKeeper.GetUnbondingDelegation(cachedCtx, types.UnbondingPoolAcc, valOpers[0])
+	s.Require().True(found)
+	s.Require().Len(ubd.Entries, 2)
+
+	// nothing to claim before maturity
+	_, err = msgServer.ClaimUnbonded(sdk.WrapSDKContext(s.ctx), types.NewMsgClaimUnbonded(staker))
+	s.Require().ErrorIs(err, types.ErrNoClaimableUnbonding)
+
+	// matured but not completed by the staking module yet
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(completionTime)
+	_, err = msgServer.ClaimUnbonded(sdk.WrapSDKContext(s.ctx), types.NewMsgClaimUnbonded(staker))
+	s.Require().ErrorIs(err, types.ErrInsufficientUnbondingPool)
+
+	s.app.StakingKeeper.BlockValidatorUpdates(s.ctx) // EndBlock of staking keeper, mature UBD
+	balanceBefore := s.app.BankKeeper.GetBalance(s.ctx, staker, sdk.DefaultBondDenom)
+	resp, err := msgServer.ClaimUnbonded(sdk.WrapSDKContext(s.ctx), types.NewMsgClaimUnbonded(staker))
+	s.Require().NoError(err)
+	s.Require().Equal(totalUnbondingAmt, resp.Amount.Amount)
+	balanceAfter := s.app.BankKeeper.GetBalance(s.ctx, staker, sdk.DefaultBondDenom)
+	s.Require().Equal(totalUnbondingAmt, balanceAfter.Amount.Sub(balanceBefore.Amount))
+	s.Require().Len(s.keeper.GetUnbondingRequestsByStaker(s.ctx, staker), 0)
+	s.Require().True(s.keeper.GetProxyAccBalance(s.ctx, types.UnbondingPoolAcc).IsZero())
+
+	_, err = msgServer.ClaimUnbonded(sdk.WrapSDKContext(s.ctx), types.NewMsgClaimUnbonded(staker))
+	s.Require().ErrorIs(err, types.ErrNoClaimableUnbonding)
+}
+
+func (s *KeeperTestSuite) TestClaimUnbondedSlashed() {
+	_, valOpers, pks := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	stakers := []sdk.AccAddress{s.delAddrs[0], s.delAddrs[1]}
+	for _, staker := range stakers {
+		s.Require().NoError(s.liquidStaking(staker, sdk.NewInt(10000000)))
+	}
+
+	// both stakers unstake through the pool in the same block, the second one twice as much as the first one
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	reqs := make([]types.UnbondingRequest, len(stakers))
+	var completionTime time.Time
+	for i, staker := range stakers {
+		unstakingBtoken := sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(int64(i+1)*1000000))
+		resp, err := msgServer.PooledLiquidUnstake(sdk.WrapSDKContext(s.ctx), types.NewMsgPooledLiquidUnstake(staker, unstakingBtoken))
+		s.Require().NoError(err)
+		req, found := s.keeper.GetUnbondingRequest(s.ctx, staker, resp.UnbondingRequestId)
+		s.Require().True(found)
+		reqs[i] = req
+		completionTime = resp.CompletionTime
+	}
+
+	// the pooled unbonding entry on the first validator is slashed
+	s.doubleSign(valOpers[0], sdk.ConsAddress(pks[0].Address()))
+	ubd, found := s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, types.UnbondingPoolAcc, valOpers[0])
+	s.Require().True(found)
+	s.Require().Len(ubd.Entries, 1)
+	slashedEntry := ubd.Entries[0]
+	s.Require().True(slashedEntry.Balance.LT(slashedEntry.InitialBalance))
+
+	// the pooled unbondings are settled by the BeginBlocker of the block they mature in, before the staking EndBlocker
+	// pays them out to the unbonding pool
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(completionTime)
+	s.keeper.SettleMaturedPooledUnbondings(s.ctx)
+	pu, found := s.keeper.GetPooledUnbonding(s.ctx, reqs[0].CreationHeight, valOpers[0])
+	s.Require().True(found)
+	s.Require().Equal(slashedEntry.Balance, pu.Balance)
+	s.Require().Equal(slashedEntry.InitialBalance, pu.UnclaimedAmount)
+	s.app.StakingKeeper.BlockValidatorUpdates(s.ctx)
+	poolBalance := s.keeper.GetProxyAccBalance(s.ctx, types.UnbondingPoolAcc).Amount
+
+	// the first staker bears its share of the slashing
+	expectedClaim := sdk.ZeroInt()
+	for _, entry := range reqs[0].Entries {
+		if entry.ValidatorAddress == valOpers[0].String() {
+			expectedClaim = expectedClaim.Add(slashedEntry.Balance.Mul(entry.Amount).Quo(slashedEntry.InitialBalance))
+		} else {
+			expectedClaim = expectedClaim.Add(entry.Amount)
+		}
+	}
+	resp, err := msgServer.ClaimUnbonded(sdk.WrapSDKContext(s.ctx), types.NewMsgClaimUnbonded(stakers[0]))
+	s.Require().NoError(err)
+	s.Require().Equal(expectedClaim, resp.Amount.Amount)
+	s.Require().True(resp.Amount.Amount.LT(reqs[0].UnbondingAmount))
+
+	// the last staker claims what is left of the pool
+	resp2, err := msgServer.ClaimUnbonded(sdk.WrapSDKContext(s.ctx), types.NewMsgClaimUnbonded(stakers[1]))
+	s.Require().NoError(err)
+	s.Require().True(resp2.Amount.Amount.LT(reqs[1].UnbondingAmount))
+	s.Require().Equal(poolBalance, resp.Amount.Amount.Add(resp2.Amount.Amount))
+	s.Require().True(s.keeper.GetProxyAccBalance(s.ctx, types.UnbondingPoolAcc).IsZero())
+	s.Require().Len(s.keeper.GetAllPooledUnbondings(s.ctx), 0)
+}
+
+func (s *KeeperTestSuite) TestSettleMissingPooledUnbonding() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	stakers := []sdk.AccAddress{s.delAddrs[0], s.delAddrs[1]}
+	for _, staker := range stakers {
+		s.Require().NoError(s.liquidStaking(staker, sdk.NewInt(10000000)))
+	}
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	reqs := make([]types.UnbondingRequest, len(stakers))
+	var completionTime time.Time
+	for i, staker := range stakers {
+		resp, err := msgServer.PooledLiquidUnstake(sdk.WrapSDKContext(s.ctx),
+			types.NewMsgPooledLiquidUnstake(staker, sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(1000000))))
+		s.Require().NoError(err)
+		req, found := s.keeper.GetUnbondingRequest(s.ctx, staker, resp.UnbondingRequestId)
+		s.Require().True(found)
+		reqs[i] = req
+		completionTime = resp.CompletionTime
+	}
+
+	// the unbonding delegation of the pool on the first validator goes missing
+	ubd, found := s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, types.UnbondingPoolAcc, valOpers[0])
+	s.Require().True(found)
+	s.app.StakingKeeper.RemoveUnbondingDelegation(s.ctx, ubd)
+
+	// its pooled unbonding is settled to a zero balance instead of keeping the unbonded amount
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(completionTime)
+	s.keeper.SettleMaturedPooledUnbondings(s.ctx)
+	pu, found := s.keeper.GetPooledUnbonding(s.ctx, reqs[0].CreationHeight, valOpers[0])
+	s.Require().True(found)
+	s.Require().True(pu.Balance.IsZero())
+	s.app.StakingKeeper.BlockValidatorUpdates(s.ctx)
+	poolBalance := s.keeper.GetProxyAccBalance(s.ctx, types.UnbondingPoolAcc).Amount
+
+	// the claims only pay the entries on the other validators, the pool is not overdrawn
+	claimed := sdk.ZeroInt()
+	for i, staker := range stakers {
+		expectedClaim := sdk.ZeroInt()
+		for _, entry := range reqs[i].Entries {
+			if entry.ValidatorAddress != valOpers[0].String() {
+				expectedClaim = expectedClaim.Add(entry.Amount)
+			}
+		}
+		resp, err := msgServer.ClaimUnbonded(sdk.WrapSDKContext(s.ctx), types.NewMsgClaimUnbonded(staker))
+		s.Require().NoError(err)
+		s.Require().Equal(expectedClaim, resp.Amount.Amount)
+		claimed = claimed.Add(resp.Amount.Amount)
+	}
+	s.Require().Equal(poolBalance, claimed)
+	s.Require().True(s.keeper.GetProxyAccBalance(s.ctx, types.UnbondingPoolAcc).IsZero())
+	s.Require().Len(s.keeper.GetAllPooledUnbondings(s.ctx), 0)
+}
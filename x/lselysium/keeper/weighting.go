@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/merlin-network/estake-native/v2/x/lselysium/types"
+)
+
+// PerformanceFactor returns the factor scaling the target weight of the validator in performance weighting mode,
+// its uptime over the slashing signed blocks window multiplied by one minus its commission rate, clamped between
+// params.MinPerformanceFactor and params.MaxPerformanceFactor.
+func (k Keeper) PerformanceFactor(ctx sdk.Context, params types.Params, val stakingtypes.Validator) sdk.Dec {
+	uptime := sdk.OneDec()
+	if consAddr, err := val.GetConsAddr(); err == nil {
+		info, found := k.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		window := k.slashingKeeper.SignedBlocksWindow(ctx)
+		if found && window > 0 {
+			missedRatio := sdk.NewDec(info.MissedBlocksCounter).QuoInt64(window)
+			uptime = sdk.OneDec().Sub(sdk.MinDec(missedRatio, sdk.OneDec()))
+		}
+	}
+	factor := uptime.Mul(sdk.OneDec().Sub(val.Commission.Rate))
+	return sdk.MaxDec(params.MinPerformanceFactor, sdk.MinDec(factor, params.MaxPerformanceFactor))
+}
+
+// GetWhitelistedValsMap returns the whitelisted validators of params by operator address. In performance weighting
+// mode, their target weights are scaled by types.PerformanceWeightPrecision and their performance factor, so that
+// the poorly performing validators receive less of the liquid staking and Rebalance shifts their stake away.
+func (k Keeper) GetWhitelistedValsMap(ctx sdk.Context, params types.Params) types.WhitelistedValsMap {
+	whitelistedValsMap := params.WhitelistedValsMap()
+	if params.WeightingMode != types.WeightingModePerformance {
+		return whitelistedValsMap
+	}
+	for operatorAddr, wv := range whitelistedValsMap {
+		targetWeight := wv.TargetWeight.Mul(types.PerformanceWeightPrecision)
+		if valAddr, err := sdk.ValAddressFromBech32(operatorAddr); err == nil {
+			if val, found := k.stakingKeeper.GetValidator(ctx, valAddr); found {
+				targetWeight = k.PerformanceFactor(ctx, params, val).MulInt(targetWeight).TruncateInt()
+			}
+		}
+		wv.TargetWeight = targetWeight
+		whitelistedValsMap[operatorAddr] = wv
+	}
+	return whitelistedValsMap
+}
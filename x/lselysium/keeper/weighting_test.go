@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	"github.com/merlin-network/estake-native/v2/x/lselysium/types"
+)
+
+func (s *KeeperTestSuite) TestPerformanceWeighting() {
+	_, valOpers, pks := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+
+	// the first validator missed half of the window, the second one the whole window
+	window := s.app.SlashingKeeper.SignedBlocksWindow(s.ctx)
+	for i, missed := range []int64{window / 2, window} {
+		consAddr := sdk.ConsAddress(pks[i].Address())
+		s.app.SlashingKeeper.SetValidatorSigningInfo(s.ctx, consAddr,
+			slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, missed))
+	}
+	// the third one signed every block with a 20% commission
+	val, found := s.app.StakingKeeper.GetValidator(s.ctx, valOpers[2])
+	s.Require().True(found)
+	val.Commission.Rate = sdk.NewDecWithPrec(2, 1)
+	s.app.StakingKeeper.SetValidator(s.ctx, val)
+	consAddr := sdk.ConsAddress(pks[2].Address())
+	s.app.SlashingKeeper.SetValidatorSigningInfo(s.ctx, consAddr,
+		slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0))
+
+	expectedFactors := []sdk.Dec{
+		sdk.OneDec().Sub(sdk.NewDec(window / 2).QuoInt64(window)),
+		params.MinPerformanceFactor,
+		sdk.NewDecWithPrec(8, 1),
+	}
+	for i, valOper := range valOpers {
+		val, found := s.app.StakingKeeper.GetValidator(s.ctx, valOper)
+		s.Require().True(found)
+		s.Require().Equal(expectedFactors[i], s.keeper.PerformanceFactor(s.ctx, params, val))
+	}
+
+	// target weights are applied as they are in static weighting mode
+	whitelistedValsMap := s.keeper.GetWhitelistedValsMap(s.ctx, params)
+	for _, valOper := range valOpers {
+		s.Require().Equal(sdk.NewInt(10), whitelistedValsMap[valOper.String()].TargetWeight)
+	}
+
+	params.WeightingMode = types.WeightingModePerformance
+	s.keeper.SetParams(s.ctx, params)
+	whitelistedValsMap = s.keeper.GetWhitelistedValsMap(s.ctx, params)
+	for i, valOper := range valOpers {
+		expected := expectedFactors[i].MulInt(sdk.NewInt(10).Mul(types.PerformanceWeightPrecision)).TruncateInt()
+		s.Require().Equal(expected, whitelistedValsMap[valOper.String()].TargetWeight)
+	}
+
+	// the liquid staking is divided by the scaled weights
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(1000000)))
+	states := map[string]types.LiquidValidatorState{}
+	for _, state := range s.keeper.GetAllLiquidValidatorStates(s.ctx) {
+		states[state.OperatorAddress] = state
+	}
+	s.Require().True(states[valOpers[2].String()].LiquidTokens.GT(states[valOpers[0].String()].LiquidTokens))
+	s.Require().True(states[valOpers[0].String()].LiquidTokens.GT(states[valOpers[1].String()].LiquidTokens))
+	s.Require().Equal(whitelistedValsMap[valOpers[1].String()].TargetWeight, states[valOpers[1].String()].Weight)
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/merlin-network/estake-native/v2/x/lselysium/types"
+)
+
+// validateAuthority returns an error if the authority is not the one of the keeper.
+func (k Keeper) validateAuthority(authority string) error {
+	if k.authority != authority {
+		return errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, authority)
+	}
+	return nil
+}
+
+// validateTargetWeight returns an error if the target weight is not positive or exceeds params.MaxTargetWeight.
+func validateTargetWeight(params types.Params, targetWeight math.Int) error {
+	if !targetWeight.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidTargetWeight, "target weight must be positive: %s", targetWeight)
+	}
+	if targetWeight.GT(params.MaxTargetWeight) {
+		return errorsmod.Wrapf(types.ErrInvalidTargetWeight, "target weight exceeds max target weight %s: %s", params.MaxTargetWeight, targetWeight)
+	}
+	return nil
+}
+
+// AddWhitelistedValidator adds an existing validator of the staking module to params.WhitelistedValidators, it
+// becomes a liquid validator on the next UpdateLiquidValidatorSet, run every params.UpdateFrequency blocks.
+func (k Keeper) AddWhitelistedValidator(ctx sdk.Context, valAddr sdk.ValAddress, targetWeight math.Int) error {
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); !found {
+		return errorsmod.Wrapf(types.ErrValidatorNotFound, "validator %s", valAddr)
+	}
+
+	params := k.GetParams(ctx)
+	if params.WhitelistedValsMap().IsListed(valAddr.String()) {
+		return errorsmod.Wrapf(types.ErrWhitelistedValidatorExists, "validator %s", valAddr)
+	}
+	if len(params.WhitelistedValidators) >= int(params.MaxWhitelistedValidators) {
+		return errorsmod.Wrapf(types.ErrMaxWhitelistedValidators, "max %d", params.MaxWhitelistedValidators)
+	}
+	if err := validateTargetWeight(params, targetWeight); err != nil {
+		return err
+	}
+
+	params.WhitelistedValidators = append(params.WhitelistedValidators, types.WhitelistedValidator{
+		ValidatorAddress: valAddr.String(),
+		TargetWeight:     targetWeight,
+	})
+	k.SetParams(ctx, params)
+	return nil
+}
+
+// RemoveWhitelistedValidator removes the validator from params.WhitelistedValidators, its liquid validator becomes
+// inactive on the next UpdateLiquidValidatorSet, run every params.UpdateFrequency blocks, and its delegations are
+// rebalanced.
+func (k Keeper) RemoveWhitelistedValidator(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	params := k.GetParams(ctx)
+	whitelistedValidators := make([]types.WhitelistedValidator, 0, len(params.WhitelistedValidators))
+	for _, wv := range params.WhitelistedValidators {
+		if wv.ValidatorAddress != valAddr.String() {
+			whitelistedValidators = append(whitelistedValidators, wv)
+		}
+	}
+	if len(whitelistedValidators) == len(params.WhitelistedValidators) {
+		return errorsmod.Wrapf(types.ErrWhitelistedValidatorNotExists, "validator %s", valAddr)
+	}
+
+	params.WhitelistedValidators = whitelistedValidators
+	k.SetParams(ctx, params)
+	return nil
+}
+
+// UpdateValidatorWeight updates the target weight of the whitelisted validator, the liquid tokens are rebalanced
+// on the next UpdateLiquidValidatorSet, run every params.UpdateFrequency blocks.
+func (k Keeper) UpdateValidatorWeight(ctx sdk.Context, valAddr sdk.ValAddress, targetWeight math.Int) error {
+	params := k.GetParams(ctx)
+	if err := validateTargetWeight(params, targetWeight); err != nil {
+		return err
+	}
+
+	for i, wv := range params.WhitelistedValidators {
+		if wv.ValidatorAddress == valAddr.String() {
+			params.WhitelistedValidators[i].TargetWeight = targetWeight
+			k.SetParams(ctx, params)
+			return nil
+		}
+	}
+	return errorsmod.Wrapf(types.ErrWhitelistedValidatorNotExists, "validator %s", valAddr)
+}
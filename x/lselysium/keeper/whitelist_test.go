@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/merlin-network/estake-native/v2/x/lselysium/keeper"
+	"github.com/merlin-network/estake-native/v2/x/lselysium/types"
+)
+
+func (s *KeeperTestSuite) TestWhitelistManagement() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.MaxWhitelistedValidators = 2
+	params.MaxTargetWeight = sdk.NewInt(100)
+	s.keeper.SetParams(s.ctx, params)
+
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	s.Require().Equal(authority.String(), s.keeper.GetAuthority())
+
+	// only the authority can manage the whitelist
+	_, err := msgServer.AddWhitelistedValidator(goCtx, types.NewMsgAddWhitelistedValidator(s.delAddrs[0], valOpers[0], sdk.NewInt(10)))
+	s.Require().ErrorIs(err, types.ErrInvalidAuthority)
+
+	_, err = msgServer.AddWhitelistedValidator(goCtx, types.NewMsgAddWhitelistedValidator(authority, valOpers[0], sdk.NewInt(10)))
+	s.Require().NoError(err)
+	_, err = msgServer.AddWhitelistedValidator(goCtx, types.NewMsgAddWhitelistedValidator(authority, valOpers[0], sdk.NewInt(10)))
+	s.Require().ErrorIs(err, types.ErrWhitelistedValidatorExists)
+	_, err = msgServer.AddWhitelistedValidator(goCtx, types.NewMsgAddWhitelistedValidator(authority, s.valAddrs[0], sdk.NewInt(10)))
+	s.Require().ErrorIs(err, types.ErrValidatorNotFound)
+	_, err = msgServer.AddWhitelistedValidator(goCtx, types.NewMsgAddWhitelistedValidator(authority, valOpers[1], sdk.NewInt(101)))
+	s.Require().ErrorIs(err, types.ErrInvalidTargetWeight)
+	_, err = msgServer.AddWhitelistedValidator(goCtx, types.NewMsgAddWhitelistedValidator(authority, valOpers[1], sdk.NewInt(100)))
+	s.Require().NoError(err)
+	_, err = msgServer.AddWhitelistedValidator(goCtx, types.NewMsgAddWhitelistedValidator(authority, valOpers[2], sdk.NewInt(10)))
+	s.Require().ErrorIs(err, types.ErrMaxWhitelistedValidators)
+
+	// whitelisted validators become liquid validators on the next update
+	s.Require().Len(s.keeper.GetAllLiquidValidators(s.ctx), 0)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(s.keeper.GetAllLiquidValidators(s.ctx), 2)
+
+	_, err = msgServer.UpdateValidatorWeight(goCtx, types.NewMsgUpdateValidatorWeight(authority, valOpers[0], sdk.NewInt(20)))
+	s.Require().NoError(err)
+	_, err = msgServer.UpdateValidatorWeight(goCtx, types.NewMsgUpdateValidatorWeight(authority, valOpers[2], sdk.NewInt(20)))
+	s.Require().ErrorIs(err, types.ErrWhitelistedValidatorNotExists)
+	_, err = msgServer.UpdateValidatorWeight(goCtx, types.NewMsgUpdateValidatorWeight(authority, valOpers[0], sdk.NewInt(101)))
+	s.Require().ErrorIs(err, types.ErrInvalidTargetWeight)
+	whitelistedValsMap := s.keeper.GetParams(s.ctx).WhitelistedValsMap()
+	s.Require().Equal(sdk.NewInt(20), whitelistedValsMap[valOpers[0].String()].TargetWeight)
+
+	_, err = msgServer.RemoveWhitelistedValidator(goCtx, types.NewMsgRemoveWhitelistedValidator(authority, valOpers[1]))
+	s.Require().NoError(err)
+	_, err = msgServer.RemoveWhitelistedValidator(goCtx, types.NewMsgRemoveWhitelistedValidator(authority, valOpers[1]))
+	s.Require().ErrorIs(err, types.ErrWhitelistedValidatorNotExists)
+	whitelistedValsMap = s.keeper.GetParams(s.ctx).WhitelistedValsMap()
+	s.Require().False(whitelistedValsMap.IsListed(valOpers[1].String()))
+	s.Require().True(whitelistedValsMap.IsListed(valOpers[0].String()))
+
+	// the removed validator has no liquid tokens, it is removed from the liquid validators on the next update
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(s.keeper.GetAllLiquidValidators(s.ctx), 1)
+}
@@ -21,6 +21,10 @@ const (
 	liquidBondDenom        = "liquid_bond_denom"
 	minLiquidStakingAmount = "min_liquid_staking_amount"
 	whitelistedValidator   = "whiteliqted_validator"
+	rebalancingTrigger     = "rebalancing_trigger"
+	rewardTrigger          = "reward_trigger"
+	maxRedelegations       = "max_redelegations_per_block"
+	rebalancingFrequency   = "rebalancing_frequency"
 )
 
 func genUnstakeFeeRate(r *rand.Rand) sdk.Dec {
@@ -39,6 +43,22 @@ func genTargetWeight(r *rand.Rand) math.Int {
 	return sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 20)))
 }
 
+func genRebalancingTrigger(r *rand.Rand) sdk.Dec {
+	return simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(1, 2))
+}
+
+func genRewardTrigger(r *rand.Rand) sdk.Dec {
+	return simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(1, 2))
+}
+
+func genMaxRedelegationsPerBlock(r *rand.Rand) uint32 {
+	return uint32(simtypes.RandIntBetween(r, 1, 30))
+}
+
+func genRebalancingFrequency(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 100))
+}
+
 // genWhitelistedValidator returns randomized whitelisted validators.
 func genWhitelistedValidator(r *rand.Rand) []types.WhitelistedValidator {
 	return []types.WhitelistedValidator{}
@@ -68,6 +88,26 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { genesis.Params.WhitelistedValidators = genWhitelistedValidator(r) },
 	)
 
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, rebalancingTrigger, &genesis.Params.RebalancingTrigger, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.RebalancingTrigger = genRebalancingTrigger(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, rewardTrigger, &genesis.Params.RewardTrigger, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.RewardTrigger = genRewardTrigger(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxRedelegations, &genesis.Params.MaxRedelegationsPerBlock, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.MaxRedelegationsPerBlock = genMaxRedelegationsPerBlock(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, rebalancingFrequency, &genesis.Params.RebalancingFrequency, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.RebalancingFrequency = genRebalancingFrequency(r) },
+	)
+
 	bz, _ := json.MarshalIndent(&genesis, "", " ")
 	fmt.Printf("Selected randomly generated liquidstaking parameters:\n%s\n", bz)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
@@ -44,6 +44,10 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, []types.WhitelistedValidator{}, genState.Params.WhitelistedValidators)
 	require.Equal(t, sdk.MustNewDecFromStr("0.007235342144855554"), genState.Params.UnstakeFeeRate)
 	require.Equal(t, sdk.NewInt(5142676), genState.Params.MinLiquidStakingAmount)
+	require.Equal(t, sdk.MustNewDecFromStr("0.008984429640002898"), genState.Params.RebalancingTrigger)
+	require.Equal(t, sdk.MustNewDecFromStr("0.004273914046994164"), genState.Params.RewardTrigger)
+	require.Equal(t, uint32(29), genState.Params.MaxRedelegationsPerBlock)
+	require.Equal(t, uint64(13), genState.Params.RebalancingFrequency)
 }
 
 // TestRandomizedGenState tests abnormal scenarios of applying RandomizedGenState.
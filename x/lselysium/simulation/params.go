@@ -45,5 +45,29 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%s\"", genMinLiquidStakingAmount(r))
 			},
 		),
+
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRebalancingTrigger),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", genRebalancingTrigger(r).String())
+			},
+		),
+
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRewardTrigger),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", genRewardTrigger(r).String())
+			},
+		),
+
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxRedelegationsPerBlock),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", genMaxRedelegationsPerBlock(r))
+			},
+		),
+
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRebalancingFrequency),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", genRebalancingFrequency(r))
+			},
+		),
 	}
 }
@@ -23,10 +23,14 @@ func TestParamChanges(t *testing.T) {
 		{"lselysium/LiquidBondDenom", "LiquidBondDenom", "\"bstake\"", "lselysium"},
 		{"lselysium/UnstakeFeeRate", "UnstakeFeeRate", "\"0.010000000000000000\"", "lselysium"},
 		{"lselysium/MinLiquidStakingAmount", "MinLiquidStakingAmount", "\"9727887\"", "lselysium"},
+		{"lselysium/RebalancingTrigger", "RebalancingTrigger", "\"0.003824594017559308\"", "lselysium"},
+		{"lselysium/RewardTrigger", "RewardTrigger", "\"0.000000000000000000\"", "lselysium"},
+		{"lselysium/MaxRedelegationsPerBlock", "MaxRedelegationsPerBlock", "12", "lselysium"},
+		{"lselysium/RebalancingFrequency", "RebalancingFrequency", "\"7\"", "lselysium"},
 	}
 
 	paramChanges := simulation.ParamChanges(r)
-	require.Len(t, paramChanges, 4)
+	require.Len(t, paramChanges, 8)
 
 	for i, p := range paramChanges {
 		require.Equal(t, expected[i].composedKey, p.ComposedKey())
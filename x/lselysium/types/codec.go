@@ -12,6 +12,13 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgLiquidStake{}, "liquidstaking/MsgLiquidStake", nil)
 	cdc.RegisterConcrete(&MsgLiquidUnstake{}, "liquidstaking/MsgLiquidUnstake", nil)
+	cdc.RegisterConcrete(&MsgStakeToLiquid{}, "liquidstaking/MsgStakeToLiquid", nil)
+	cdc.RegisterConcrete(&MsgPooledLiquidUnstake{}, "liquidstaking/MsgPooledLiquidUnstake", nil)
+	cdc.RegisterConcrete(&MsgClaimUnbonded{}, "liquidstaking/MsgClaimUnbonded", nil)
+	cdc.RegisterConcrete(&MsgInstantLiquidUnstake{}, "liquidstaking/MsgInstantLiquidUnstake", nil)
+	cdc.RegisterConcrete(&MsgAddWhitelistedValidator{}, "liquidstaking/MsgAddWhitelistedValidator", nil)
+	cdc.RegisterConcrete(&MsgRemoveWhitelistedValidator{}, "liquidstaking/MsgRemoveWhitelistedValidator", nil)
+	cdc.RegisterConcrete(&MsgUpdateValidatorWeight{}, "liquidstaking/MsgUpdateValidatorWeight", nil)
 }
 
 // RegisterInterfaces registers the x/liquidstaking interfaces types with the interface registry.
@@ -20,6 +27,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgLiquidStake{},
 		&MsgLiquidUnstake{},
+		&MsgStakeToLiquid{},
+		&MsgPooledLiquidUnstake{},
+		&MsgClaimUnbonded{},
+		&MsgInstantLiquidUnstake{},
+		&MsgAddWhitelistedValidator{},
+		&MsgRemoveWhitelistedValidator{},
+		&MsgUpdateValidatorWeight{},
 	)
 }
 
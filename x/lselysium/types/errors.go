@@ -18,4 +18,19 @@ var (
 	ErrInsufficientProxyAccBalance     = errorsmod.Register(ModuleName, 11, "insufficient liquid tokens or balance of proxy account, need to wait for new liquid validator to be added or unbonding of proxy account to be completed")
 	ErrTooSmallLiquidStakingAmount     = errorsmod.Register(ModuleName, 12, "liquid staking amount is too small, the result becomes zero")
 	ErrTooSmallLiquidUnstakingAmount   = errorsmod.Register(ModuleName, 13, "liquid unstaking amount is too small, the result becomes zero")
+	ErrInvalidAuthority                = errorsmod.Register(ModuleName, 14, "invalid authority")
+	ErrValidatorNotFound               = errorsmod.Register(ModuleName, 15, "validator not found")
+	ErrWhitelistedValidatorExists      = errorsmod.Register(ModuleName, 16, "validator is already whitelisted")
+	ErrWhitelistedValidatorNotExists   = errorsmod.Register(ModuleName, 17, "validator is not whitelisted")
+	ErrMaxWhitelistedValidators        = errorsmod.Register(ModuleName, 18, "whitelisted validators reached params.max_whitelisted_validators")
+	ErrInvalidTargetWeight             = errorsmod.Register(ModuleName, 19, "invalid target weight")
+	ErrLiquidValidatorNotActive        = errorsmod.Register(ModuleName, 20, "validator is not an active liquid validator")
+	ErrVestingAccountNotSupported      = errorsmod.Register(ModuleName, 21, "vesting accounts are not supported")
+	ErrNoClaimableUnbonding            = errorsmod.Register(ModuleName, 22, "no matured pooled liquid unstaking to claim")
+	ErrInsufficientUnbondingPool       = errorsmod.Register(ModuleName, 23, "insufficient balance of unbonding pool, need to wait for the unbonding to be completed")
+	ErrInstantUnstakeDisabled          = errorsmod.Register(ModuleName, 24, "instant liquid unstaking is disabled")
+	ErrInsufficientInstantReserve      = errorsmod.Register(ModuleName, 25, "insufficient instant unstaking reserve of proxy account")
+	ErrReceivingRedelegation           = errorsmod.Register(ModuleName, 26, "delegation is receiving a redelegation, it can still be slashed for the source validator")
+	ErrSelfDelegationBelowMinimum      = errorsmod.Register(ModuleName, 27, "self delegation of the validator would fall below its min self delegation")
+	ErrPooledUnbondingNotFound         = errorsmod.Register(ModuleName, 28, "pooled unbonding of the unbonding request not found")
 )
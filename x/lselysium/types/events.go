@@ -4,10 +4,18 @@ package types
 const (
 	EventTypeMsgLiquidStake             = TypeMsgLiquidStake
 	EventTypeMsgLiquidUnstake           = TypeMsgLiquidUnstake
+	EventTypeMsgStakeToLiquid           = TypeMsgStakeToLiquid
+	EventTypeMsgPooledLiquidUnstake     = TypeMsgPooledLiquidUnstake
+	EventTypeMsgClaimUnbonded           = TypeMsgClaimUnbonded
+	EventTypeMsgInstantLiquidUnstake    = TypeMsgInstantLiquidUnstake
+	EventTypeAddWhitelistedValidator    = TypeMsgAddWhitelistedValidator
+	EventTypeRemoveWhitelistedValidator = TypeMsgRemoveWhitelistedValidator
+	EventTypeUpdateValidatorWeight      = TypeMsgUpdateValidatorWeight
 	EventTypeAddLiquidValidator         = "add_liquid_validator"
 	EventTypeRemoveLiquidValidator      = "remove_liquid_validator"
 	EventTypeBeginRebalancing           = "begin_rebalancing"
 	EventTypeReStake                    = "re_stake"
+	EventTypeRewardFee                  = "reward_fee"
 	EventTypeUnbondInactiveLiquidTokens = "unbond_inactive_liquid_tokens"
 
 	AttributeKeyDelegator             = "delegator"
@@ -19,6 +27,10 @@ const (
 	AttributeKeyLiquidValidator       = "liquid_validator"
 	AttributeKeyRedelegationCount     = "redelegation_count"
 	AttributeKeyRedelegationFailCount = "redelegation_fail_count"
+	AttributeKeyFeeAccount            = "fee_account"
+	AttributeKeyFeeAmount             = "fee_amount"
+	AttributeKeyTargetWeight          = "target_weight"
+	AttributeKeyUnbondingRequestID    = "unbonding_request_id"
 
 	AttributeValueCategory = ModuleName
 )
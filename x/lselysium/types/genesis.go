@@ -1,15 +1,26 @@
 package types
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // NewGenesisState returns new GenesisState instance.
-func NewGenesisState(params Params, liquidValidators []LiquidValidator) *GenesisState {
+func NewGenesisState(
+	params Params, liquidValidators []LiquidValidator, unbondingRequests []UnbondingRequest,
+	lastUnbondingRequestID uint64, unbondCursor string, pooledUnbondings []PooledUnbonding,
+) *GenesisState {
 	return &GenesisState{
-		Params:           params,
-		LiquidValidators: liquidValidators,
+		Params:                 params,
+		LiquidValidators:       liquidValidators,
+		UnbondingRequests:      unbondingRequests,
+		LastUnbondingRequestId: lastUnbondingRequestID,
+		UnbondCursor:           unbondCursor,
+		PooledUnbondings:       pooledUnbondings,
 	}
 }
 
@@ -18,6 +29,10 @@ func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		[]LiquidValidator{},
+		nil,
+		0,
+		"",
+		nil,
 	)
 }
 
@@ -33,5 +48,65 @@ func ValidateGenesis(data GenesisState) error {
 				"invalid liquid validator %s: %v", lv, err)
 		}
 	}
+	if data.UnbondCursor != "" {
+		if _, err := sdk.ValAddressFromBech32(data.UnbondCursor); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid unbond cursor %s: %v", data.UnbondCursor, err)
+		}
+	}
+
+	pooledUnbondings := map[string]PooledUnbonding{}
+	for _, pu := range data.PooledUnbondings {
+		key := pooledUnbondingGenesisKey(pu.CreationHeight, pu.ValidatorAddress)
+		if err := pu.Validate(); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid pooled unbonding %s: %v", key, err)
+		}
+		if _, ok := pooledUnbondings[key]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate pooled unbonding %s", key)
+		}
+		pooledUnbondings[key] = pu
+	}
+
+	ids := map[uint64]bool{}
+	pooledAmounts := map[string]math.Int{}
+	for _, req := range data.UnbondingRequests {
+		if err := req.Validate(); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid unbonding request %d: %v", req.Id, err)
+		}
+		if req.Id > data.LastUnbondingRequestId {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+				"unbonding request id %d is greater than the last unbonding request id %d", req.Id, data.LastUnbondingRequestId)
+		}
+		if ids[req.Id] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate unbonding request id %d", req.Id)
+		}
+		ids[req.Id] = true
+		if !req.Pooled {
+			continue
+		}
+		for _, entry := range req.Entries {
+			key := pooledUnbondingGenesisKey(req.CreationHeight, entry.ValidatorAddress)
+			if _, ok := pooledUnbondings[key]; !ok {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "pooled unbonding %s of unbonding request %d not found", key, req.Id)
+			}
+			if amount, ok := pooledAmounts[key]; ok {
+				pooledAmounts[key] = amount.Add(entry.Amount)
+			} else {
+				pooledAmounts[key] = entry.Amount
+			}
+		}
+	}
+
+	// the unclaimed amount of a pooled unbonding is the sum of its unbonding request entries
+	for key, pu := range pooledUnbondings {
+		amount, ok := pooledAmounts[key]
+		if !ok || !amount.Equal(pu.UnclaimedAmount) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+				"unclaimed amount %s of pooled unbonding %s does not match its unbonding requests", pu.UnclaimedAmount, key)
+		}
+	}
 	return nil
 }
+
+func pooledUnbondingGenesisKey(creationHeight int64, valAddr string) string {
+	return fmt.Sprintf("%d/%s", creationHeight, valAddr)
+}
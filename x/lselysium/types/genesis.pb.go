@@ -28,6 +28,15 @@ type GenesisState struct {
 	// params defines all the parameters for the liquidstaking module
 	Params           Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	LiquidValidators []LiquidValidator `protobuf:"bytes,2,rep,name=liquid_validators,json=liquidValidators,proto3" json:"liquid_validators" yaml:"liquid_validators"`
+	// unbonding_requests defines the unbonding requests of all liquid stakers
+	UnbondingRequests []UnbondingRequest `protobuf:"bytes,3,rep,name=unbonding_requests,json=unbondingRequests,proto3" json:"unbonding_requests" yaml:"unbonding_requests"`
+	// last_unbonding_request_id defines the id of the last unbonding request
+	LastUnbondingRequestId uint64 `protobuf:"varint,4,opt,name=last_unbonding_request_id,json=lastUnbondingRequestId,proto3" json:"last_unbonding_request_id,omitempty" yaml:"last_unbonding_request_id"`
+	// unbond_cursor defines the liquid validator the next unbonding of inactive liquid validators resumes from, empty
+	// if it starts over
+	UnbondCursor string `protobuf:"bytes,5,opt,name=unbond_cursor,json=unbondCursor,proto3" json:"unbond_cursor,omitempty" yaml:"unbond_cursor"`
+	// pooled_unbondings defines the pooled unbondings not fully claimed yet
+	PooledUnbondings []PooledUnbonding `protobuf:"bytes,6,rep,name=pooled_unbondings,json=pooledUnbondings,proto3" json:"pooled_unbondings" yaml:"pooled_unbondings"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -72,26 +81,36 @@ func init() {
 }
 
 var fileDescriptor_7f1ffec0efd8ea86 = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2a, 0x28, 0x2e, 0x49,
-	0xcc, 0x4e, 0xd5, 0xcf, 0x29, 0x2e, 0x48, 0x2d, 0x2a, 0xce, 0x2c, 0x2e, 0x49, 0xcd, 0x4b, 0x4e,
-	0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0xa8, 0xd5, 0x43, 0x51, 0xab, 0x07, 0x55,
-	0x2b, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0x19, 0xe0,
-	0x35, 0x3f, 0x27, 0xb3, 0xb0, 0x34, 0x33, 0x05, 0xa4, 0x22, 0x33, 0x2f, 0x1d, 0xa2, 0x43, 0xe9,
-	0x16, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0xde, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x27, 0x2e, 0xb6,
-	0x82, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x15, 0x3d, 0x7c,
-	0xee, 0xd0, 0x0b, 0x00, 0xab, 0x75, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0x53, 0xa8,
-	0x86, 0x4b, 0x10, 0x62, 0x57, 0x7c, 0x59, 0x62, 0x4e, 0x66, 0x4a, 0x62, 0x49, 0x7e, 0x51, 0xb1,
-	0x04, 0x93, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0x2e, 0x7e, 0xe3, 0x7c, 0xc0, 0xda, 0xc2, 0x60, 0xba,
-	0x9c, 0x14, 0x40, 0xe6, 0x7e, 0xba, 0x27, 0x2f, 0x51, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x84, 0x61,
-	0xaa, 0x52, 0x90, 0x40, 0x0e, 0xaa, 0x96, 0x62, 0x2b, 0x8e, 0x8e, 0x05, 0xf2, 0x0c, 0x2f, 0x16,
-	0xc8, 0x33, 0x38, 0xc5, 0xad, 0x78, 0x24, 0xc7, 0x78, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0x0e, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x48,
-	0x6e, 0xf1, 0xcf, 0x4b, 0xd5, 0x87, 0xb8, 0x51, 0x37, 0x2f, 0xb1, 0x24, 0xb3, 0x2c, 0x55, 0xbf,
-	0xcc, 0x48, 0xbf, 0x02, 0x2d, 0x48, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x61, 0x68,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xec, 0x08, 0xec, 0xd7, 0x01, 0x00, 0x00,
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x6a, 0xd4, 0x40,
+	0x18, 0xc7, 0x13, 0xbb, 0x2e, 0x9a, 0x56, 0x68, 0x43, 0x91, 0x69, 0x0f, 0x49, 0x0c, 0x22, 0xab,
+	0xd8, 0x0c, 0x5d, 0x6f, 0x45, 0x3d, 0xc4, 0x83, 0x08, 0x1e, 0x4a, 0x44, 0x0f, 0xbd, 0x84, 0xd9,
+	0x66, 0x88, 0x43, 0x27, 0x33, 0xe9, 0x7c, 0x93, 0xb5, 0x0b, 0x3e, 0x80, 0x47, 0x1f, 0xa1, 0x47,
+	0x1f, 0xa5, 0xc7, 0x1e, 0x3d, 0x2d, 0xb2, 0x7b, 0x11, 0x8f, 0xfb, 0x04, 0x92, 0x4c, 0x5a, 0xdb,
+	0x5d, 0x82, 0xb7, 0x0c, 0xf3, 0xfb, 0xfd, 0xff, 0x5f, 0xf8, 0xc6, 0x79, 0x42, 0x41, 0x93, 0x13,
+	0x8a, 0x39, 0x50, 0x3e, 0x01, 0x56, 0x15, 0x78, 0xbc, 0x3f, 0xa2, 0x9a, 0xec, 0xe3, 0x9c, 0x0a,
+	0x0a, 0x0c, 0xa2, 0x52, 0x49, 0x2d, 0x5d, 0x64, 0xb8, 0xe8, 0x9a, 0x8b, 0x5a, 0x6e, 0x77, 0x3b,
+	0x97, 0xb9, 0x6c, 0x20, 0x5c, 0x7f, 0x19, 0x7e, 0xf7, 0x79, 0x67, 0x2e, 0x67, 0xa7, 0x15, 0xcb,
+	0xea, 0x5b, 0x26, 0x72, 0x43, 0x87, 0x7f, 0x7a, 0xce, 0xc6, 0x5b, 0xd3, 0xf7, 0x41, 0x13, 0x4d,
+	0xdd, 0xd7, 0x4e, 0xbf, 0x24, 0x8a, 0x14, 0x80, 0xec, 0xc0, 0x1e, 0xac, 0x0f, 0x83, 0xa8, 0xab,
+	0x3f, 0x3a, 0x6c, 0xb8, 0xb8, 0x77, 0x31, 0xf5, 0xad, 0xa4, 0xb5, 0xdc, 0x33, 0x67, 0xcb, 0xf4,
+	0xa4, 0x63, 0xc2, 0x59, 0x46, 0xb4, 0x54, 0x80, 0xee, 0x04, 0x6b, 0x83, 0xf5, 0xe1, 0xd3, 0xee,
+	0xa8, 0xf7, 0x8d, 0xf2, 0xe9, 0xca, 0x88, 0x83, 0x3a, 0x73, 0x31, 0xf5, 0xd1, 0x84, 0x14, 0xfc,
+	0x20, 0x5c, 0x49, 0x0c, 0x93, 0x4d, 0x7e, 0x5b, 0x01, 0xf7, 0xab, 0xe3, 0x56, 0x62, 0x24, 0x45,
+	0xc6, 0x44, 0x9e, 0x2a, 0x7a, 0x5a, 0x51, 0xd0, 0x80, 0xd6, 0x9a, 0xea, 0x67, 0xdd, 0xd5, 0x1f,
+	0xaf, 0x9c, 0xc4, 0x28, 0xf1, 0xa3, 0xb6, 0x7b, 0xc7, 0x74, 0xaf, 0x66, 0x86, 0xc9, 0x56, 0xb5,
+	0x24, 0x81, 0x9b, 0x3a, 0x3b, 0x9c, 0x80, 0x4e, 0x57, 0xf0, 0x94, 0x65, 0xa8, 0x17, 0xd8, 0x83,
+	0x5e, 0xfc, 0x78, 0x31, 0xf5, 0x83, 0xf6, 0x87, 0xba, 0xd0, 0x30, 0x79, 0x58, 0xdf, 0x2d, 0x0f,
+	0xf5, 0x2e, 0x73, 0x5f, 0x39, 0x0f, 0x8c, 0x90, 0x1e, 0x57, 0x0a, 0xa4, 0x42, 0x77, 0x03, 0x7b,
+	0x70, 0x3f, 0x46, 0x8b, 0xa9, 0xbf, 0x7d, 0x73, 0xd2, 0xf6, 0x3a, 0x4c, 0x36, 0xcc, 0xf9, 0x4d,
+	0x73, 0xac, 0xf7, 0x52, 0x4a, 0xc9, 0x69, 0xf6, 0xaf, 0x16, 0x50, 0xff, 0x7f, 0x7b, 0x39, 0x6c,
+	0x94, 0xeb, 0x69, 0x96, 0xf7, 0xb2, 0x92, 0x18, 0x26, 0x9b, 0xe5, 0x6d, 0x05, 0x0e, 0xee, 0x7d,
+	0x3b, 0xf7, 0xad, 0xdf, 0xe7, 0xbe, 0x15, 0x1f, 0xfd, 0x98, 0x79, 0xf6, 0xc5, 0xcc, 0xb3, 0x2f,
+	0x67, 0x9e, 0xfd, 0x6b, 0xe6, 0xd9, 0xdf, 0xe7, 0x9e, 0x75, 0x39, 0xf7, 0xac, 0x9f, 0x73, 0xcf,
+	0x3a, 0x7a, 0x99, 0x33, 0xfd, 0xb9, 0x1a, 0x45, 0xc7, 0xb2, 0xc0, 0x05, 0x55, 0x9c, 0x89, 0x3d,
+	0x41, 0xf5, 0x17, 0xa9, 0x4e, 0xb0, 0x99, 0x6f, 0x4f, 0x10, 0xcd, 0xc6, 0x14, 0x8f, 0x87, 0xf8,
+	0xec, 0xc6, 0xf3, 0xd6, 0x93, 0x92, 0xc2, 0xa8, 0xdf, 0xbc, 0xe7, 0x17, 0x7f, 0x07, 0x00, 0x30,
+	0xab, 0x6a, 0x1b, 0x57, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -114,6 +133,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PooledUnbondings) > 0 {
+		for iNdEx := len(m.PooledUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PooledUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.UnbondCursor) > 0 {
+		i -= len(m.UnbondCursor)
+		copy(dAtA[i:], m.UnbondCursor)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.UnbondCursor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LastUnbondingRequestId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastUnbondingRequestId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.UnbondingRequests) > 0 {
+		for iNdEx := len(m.UnbondingRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.LiquidValidators) > 0 {
 		for iNdEx := len(m.LiquidValidators) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -166,6 +225,25 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.UnbondingRequests) > 0 {
+		for _, e := range m.UnbondingRequests {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastUnbondingRequestId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastUnbondingRequestId))
+	}
+	l = len(m.UnbondCursor)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PooledUnbondings) > 0 {
+		for _, e := range m.PooledUnbondings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -271,6 +349,125 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingRequests = append(m.UnbondingRequests, UnbondingRequest{})
+			if err := m.UnbondingRequests[len(m.UnbondingRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUnbondingRequestId", wireType)
+			}
+			m.LastUnbondingRequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUnbondingRequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondCursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondCursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PooledUnbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PooledUnbondings = append(m.PooledUnbondings, PooledUnbonding{})
+			if err := m.PooledUnbondings[len(m.PooledUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
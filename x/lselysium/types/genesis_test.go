@@ -11,6 +11,26 @@ import (
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	valAddr := sdk.ValAddress("validator1__________").String()
+	setUnbonding := func(genState *types.GenesisState) {
+		genState.UnbondingRequests = []types.UnbondingRequest{
+			{
+				Id:              1,
+				StakerAddress:   sdk.AccAddress("staker______________").String(),
+				BtokenBurned:    sdk.NewInt64Coin(types.DefaultLiquidBondDenom, 100),
+				UnbondingAmount: sdk.NewInt(100),
+				Entries:         []types.UnbondingRequestEntry{{ValidatorAddress: valAddr, Amount: sdk.NewInt(100)}},
+				CreationHeight:  10,
+				Pooled:          true,
+			},
+		}
+		genState.LastUnbondingRequestId = 1
+		genState.UnbondCursor = valAddr
+		genState.PooledUnbondings = []types.PooledUnbonding{
+			{ValidatorAddress: valAddr, CreationHeight: 10, UnclaimedAmount: sdk.NewInt(100), Balance: sdk.NewInt(95)},
+		}
+	}
+
 	for _, tc := range []struct {
 		name        string
 		malleate    func(genState *types.GenesisState)
@@ -50,6 +70,50 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			"unstake fee rate must not be nil",
 		},
+		{
+			"valid unbonding requests",
+			setUnbonding,
+			"",
+		},
+		{
+			"invalid unbond cursor",
+			func(genState *types.GenesisState) {
+				genState.UnbondCursor = "invalidAddr"
+			},
+			"invalid unbond cursor invalidAddr: decoding bech32 failed: string not all lowercase or all uppercase: invalid address",
+		},
+		{
+			"unbonding request id greater than the last unbonding request id",
+			func(genState *types.GenesisState) {
+				setUnbonding(genState)
+				genState.LastUnbondingRequestId = 0
+			},
+			"unbonding request id 1 is greater than the last unbonding request id 0: invalid request",
+		},
+		{
+			"unbonding amount not matching the entries",
+			func(genState *types.GenesisState) {
+				setUnbonding(genState)
+				genState.UnbondingRequests[0].UnbondingAmount = sdk.NewInt(90)
+			},
+			"invalid unbonding request 1: unbonding amount 90 does not match the entries amount 100: invalid request",
+		},
+		{
+			"pooled unbonding of the unbonding request not found",
+			func(genState *types.GenesisState) {
+				setUnbonding(genState)
+				genState.PooledUnbondings = nil
+			},
+			"pooled unbonding 10/" + valAddr + " of unbonding request 1 not found: invalid request",
+		},
+		{
+			"unclaimed amount of the pooled unbonding not matching the unbonding requests",
+			func(genState *types.GenesisState) {
+				setUnbonding(genState)
+				genState.PooledUnbondings[0].UnclaimedAmount = sdk.NewInt(120)
+			},
+			"unclaimed amount 120 of pooled unbonding 10/" + valAddr + " does not match its unbonding requests: invalid request",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			genState := types.DefaultGenesisState()
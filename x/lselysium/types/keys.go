@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -22,6 +24,12 @@ const (
 var (
 	// Keys for store prefixes
 	LiquidValidatorsKey = []byte{0xc0} // prefix for each key to a liquid validator
+	UnbondCursorKey     = []byte{0xc1} // key for the liquid validator the next unbonding of inactive liquid validators resumes from
+
+	UnbondingRequestsKey      = []byte{0xc2} // prefix for each key to an unbonding request
+	LastUnbondingRequestIDKey = []byte{0xc3} // key for the id of the last unbonding request
+	PooledUnbondingsKey       = []byte{0xc4} // prefix for each key to a pooled unbonding
+	PooledUnbondingQueueKey   = []byte{0xc5} // prefix for the timestamps of the pooled unbondings to settle
 )
 
 // GetLiquidValidatorKey creates the key for the liquid validator with address
@@ -29,3 +37,33 @@ var (
 func GetLiquidValidatorKey(operatorAddr sdk.ValAddress) []byte {
 	return append(LiquidValidatorsKey, address.MustLengthPrefix(operatorAddr)...)
 }
+
+// GetUnbondingRequestsByStakerKey creates the prefix for the unbonding requests of the liquid staker
+func GetUnbondingRequestsByStakerKey(staker sdk.AccAddress) []byte {
+	return append(UnbondingRequestsKey, address.MustLengthPrefix(staker)...)
+}
+
+// GetUnbondingRequestKey creates the key for the unbonding request of the liquid staker with id
+// VALUE: lselysium/UnbondingRequest
+func GetUnbondingRequestKey(staker sdk.AccAddress, id uint64) []byte {
+	return append(GetUnbondingRequestsByStakerKey(staker), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetPooledUnbondingKey creates the key for the pooled unbonding of the block on the liquid validator
+// VALUE: lselysium/PooledUnbonding
+func GetPooledUnbondingKey(creationHeight int64, valAddr sdk.ValAddress) []byte {
+	key := append(PooledUnbondingsKey, sdk.Uint64ToBigEndian(uint64(creationHeight))...)
+	return append(key, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetPooledUnbondingQueueTimeKey creates the prefix for the pooled unbondings maturing at completionTime
+func GetPooledUnbondingQueueTimeKey(completionTime time.Time) []byte {
+	return append(PooledUnbondingQueueKey, sdk.FormatTimeBytes(completionTime)...)
+}
+
+// GetPooledUnbondingQueueKey creates the key for the pooled unbonding of the block on the liquid validator in the
+// settlement queue
+// VALUE: the key of the pooled unbonding
+func GetPooledUnbondingQueueKey(completionTime time.Time, creationHeight int64, valAddr sdk.ValAddress) []byte {
+	return append(GetPooledUnbondingQueueTimeKey(completionTime), GetPooledUnbondingKey(creationHeight, valAddr)[len(PooledUnbondingsKey):]...)
+}
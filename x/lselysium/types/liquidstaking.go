@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -208,3 +210,74 @@ func UnmarshalLiquidValidator(cdc codec.BinaryCodec, value []byte) (val LiquidVa
 	err = cdc.Unmarshal(value, &val)
 	return val, err
 }
+
+// GetStaker returns the liquid staker of the unbonding request.
+func (r UnbondingRequest) GetStaker() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(r.StakerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// Validate validates UnbondingRequest.
+func (r UnbondingRequest) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("id must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.StakerAddress); err != nil {
+		return err
+	}
+	if err := r.BtokenBurned.Validate(); err != nil {
+		return err
+	}
+	if r.UnbondingAmount.IsNil() || r.UnbondingAmount.IsNegative() {
+		return fmt.Errorf("unbonding amount must not be negative: %s", r.UnbondingAmount)
+	}
+	entriesAmount := sdk.ZeroInt()
+	for _, entry := range r.Entries {
+		if _, err := sdk.ValAddressFromBech32(entry.ValidatorAddress); err != nil {
+			return err
+		}
+		if entry.Amount.IsNil() || entry.Amount.IsNegative() {
+			return fmt.Errorf("entry amount must not be negative: %s", entry.Amount)
+		}
+		entriesAmount = entriesAmount.Add(entry.Amount)
+	}
+	if !entriesAmount.Equal(r.UnbondingAmount) {
+		return fmt.Errorf("unbonding amount %s does not match the entries amount %s", r.UnbondingAmount, entriesAmount)
+	}
+	return nil
+}
+
+// Validate validates PooledUnbonding.
+func (u PooledUnbonding) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(u.ValidatorAddress); err != nil {
+		return err
+	}
+	if u.UnclaimedAmount.IsNil() || !u.UnclaimedAmount.IsPositive() {
+		return fmt.Errorf("unclaimed amount must be positive: %s", u.UnclaimedAmount)
+	}
+	if u.Balance.IsNil() || u.Balance.IsNegative() {
+		return fmt.Errorf("balance must not be negative: %s", u.Balance)
+	}
+	return nil
+}
+
+// GetValidator returns the liquid validator of the unbonding request entry.
+func (e UnbondingRequestEntry) GetValidator() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(e.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// GetValidator returns the liquid validator of the pooled unbonding.
+func (u PooledUnbonding) GetValidator() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(u.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
@@ -6,20 +6,23 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -59,6 +62,35 @@ func (ValidatorStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_729ca6a6bfc9e5d3, []int{0}
 }
 
+// WeightingMode enumerates how the target weights of the whitelisted validators are applied.
+type WeightingMode int32
+
+const (
+	// WEIGHTING_MODE_STATIC applies the target weights as they are.
+	WeightingModeStatic WeightingMode = 0
+	// WEIGHTING_MODE_PERFORMANCE scales the target weights by the recent uptime and the commission rate of the
+	// validators.
+	WeightingModePerformance WeightingMode = 1
+)
+
+var WeightingMode_name = map[int32]string{
+	0: "WEIGHTING_MODE_STATIC",
+	1: "WEIGHTING_MODE_PERFORMANCE",
+}
+
+var WeightingMode_value = map[string]int32{
+	"WEIGHTING_MODE_STATIC":      0,
+	"WEIGHTING_MODE_PERFORMANCE": 1,
+}
+
+func (x WeightingMode) String() string {
+	return proto.EnumName(WeightingMode_name, int32(x))
+}
+
+func (WeightingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{1}
+}
+
 // Params defines the set of params for the liquidstaking module.
 type Params struct {
 	// LiquidBondDenom specifies the denomination of the token receiving after LiquidStaking, The value is calculated
@@ -72,6 +104,42 @@ type Params struct {
 	// MinLiquidStakingAmount specifies the minimum number of coins to be staked to the active liquid validators on liquid
 	// staking to minimize decimal loss and consider gas efficiency.
 	MinLiquidStakingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=min_liquid_staking_amount,json=minLiquidStakingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_liquid_staking_amount" yaml:"min_liquid_staking_amount"`
+	// RebalancingTrigger specifies the ratio of the total liquid tokens the needed redelegation amount must exceed for
+	// the asset rebalancing to be executed.
+	RebalancingTrigger github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=rebalancing_trigger,json=rebalancingTrigger,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rebalancing_trigger" yaml:"rebalancing_trigger"`
+	// RewardTrigger specifies the ratio of the total liquid tokens the sum of balance and upcoming rewards of the
+	// LiquidStakingProxyAcc must exceed for the rewards to be withdrawn and re-staked.
+	RewardTrigger github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=reward_trigger,json=rewardTrigger,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_trigger" yaml:"reward_trigger"`
+	// MaxRedelegationsPerBlock specifies the maximum number of redelegations tried by a single rebalancing.
+	MaxRedelegationsPerBlock uint32 `protobuf:"varint,8,opt,name=max_redelegations_per_block,json=maxRedelegationsPerBlock,proto3" json:"max_redelegations_per_block,omitempty" yaml:"max_redelegations_per_block"`
+	// RebalancingFrequency specifies the number of blocks between two asset rebalancings and reward re-stakings,
+	// they run by the first liquid validator set update of every RebalancingFrequency blocks.
+	RebalancingFrequency uint64 `protobuf:"varint,9,opt,name=rebalancing_frequency,json=rebalancingFrequency,proto3" json:"rebalancing_frequency,omitempty" yaml:"rebalancing_frequency"`
+	// RewardFeeRate specifies the fee rate taken from the auto-compounded rewards, minted as bToken to the
+	// FeeAccountAddress before re-staking.
+	RewardFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=reward_fee_rate,json=rewardFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_fee_rate" yaml:"reward_fee_rate"`
+	// FeeAccountAddress specifies the bech32-encoded address receiving the reward fee.
+	FeeAccountAddress string `protobuf:"bytes,11,opt,name=fee_account_address,json=feeAccountAddress,proto3" json:"fee_account_address,omitempty" yaml:"fee_account_address"`
+	// MaxWhitelistedValidators specifies the maximum number of whitelisted validators.
+	MaxWhitelistedValidators uint32 `protobuf:"varint,12,opt,name=max_whitelisted_validators,json=maxWhitelistedValidators,proto3" json:"max_whitelisted_validators,omitempty" yaml:"max_whitelisted_validators"`
+	// MaxTargetWeight specifies the maximum target weight of a whitelisted validator.
+	MaxTargetWeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=max_target_weight,json=maxTargetWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_target_weight" yaml:"max_target_weight"`
+	// WeightingMode specifies how the target weights of the whitelisted validators are applied.
+	WeightingMode WeightingMode `protobuf:"varint,14,opt,name=weighting_mode,json=weightingMode,proto3,enum=estake.lselysium.v1beta1.WeightingMode" json:"weighting_mode,omitempty" yaml:"weighting_mode"`
+	// MinPerformanceFactor specifies the floor of the factor scaling the target weights in performance weighting mode.
+	MinPerformanceFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=min_performance_factor,json=minPerformanceFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_performance_factor" yaml:"min_performance_factor"`
+	// MaxPerformanceFactor specifies the ceiling of the factor scaling the target weights in performance weighting mode.
+	MaxPerformanceFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=max_performance_factor,json=maxPerformanceFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_performance_factor" yaml:"max_performance_factor"`
+	// UpdateFrequency specifies the number of blocks between two liquid validator set updates by the BeginBlocker.
+	UpdateFrequency uint64 `protobuf:"varint,17,opt,name=update_frequency,json=updateFrequency,proto3" json:"update_frequency,omitempty" yaml:"update_frequency"`
+	// MaxUnbondsPerBlock specifies the maximum number of inactive liquid validators unbonded by a single liquid
+	// validator set update, the remaining ones are unbonded by the next updates.
+	MaxUnbondsPerBlock uint32 `protobuf:"varint,18,opt,name=max_unbonds_per_block,json=maxUnbondsPerBlock,proto3" json:"max_unbonds_per_block,omitempty" yaml:"max_unbonds_per_block"`
+	// InstantUnstakeFeeRate specifies the fee rate of the instant liquid unstaking, paid instead of UnstakeFeeRate.
+	InstantUnstakeFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=instant_unstake_fee_rate,json=instantUnstakeFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"instant_unstake_fee_rate" yaml:"instant_unstake_fee_rate"`
+	// InstantReserveRate specifies the fraction of the NetAmount available to the instant liquid unstaking from the
+	// proxy account balance, zero disables it.
+	InstantReserveRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=instant_reserve_rate,json=instantReserveRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"instant_reserve_rate" yaml:"instant_reserve_rate"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -342,14 +410,163 @@ func (m *VotingPower) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VotingPower proto.InternalMessageInfo
 
+// UnbondingRequest defines a liquid unstaking of the staker, queued as unbonding delegations of the staker on the
+// liquid validators.
+type UnbondingRequest struct {
+	// id defines the sequence of the unbonding request.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// staker_address defines the bech32-encoded address of the liquid staker.
+	StakerAddress string `protobuf:"bytes,2,opt,name=staker_address,json=stakerAddress,proto3" json:"staker_address,omitempty"`
+	// btoken_burned defines the bToken burned by the liquid unstaking.
+	BtokenBurned types.Coin `protobuf:"bytes,3,opt,name=btoken_burned,json=btokenBurned,proto3" json:"btoken_burned"`
+	// unbonding_amount defines the native token amount being unbonded by all liquid validators.
+	UnbondingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=unbonding_amount,json=unbondingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"unbonding_amount"`
+	// entries defines the native token amount being unbonded by each liquid validator.
+	Entries []UnbondingRequestEntry `protobuf:"bytes,5,rep,name=entries,proto3" json:"entries"`
+	// creation_height defines the height at which the liquid unstaking was requested.
+	CreationHeight int64 `protobuf:"varint,6,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	// completion_time defines the time at which the unbonding delegations mature.
+	CompletionTime time.Time `protobuf:"bytes,7,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	// pooled defines whether the unbonding delegations are queued under the unbonding pool account, the unbonded
+	// amount is then claimed by the liquid staker once matured.
+	Pooled bool `protobuf:"varint,8,opt,name=pooled,proto3" json:"pooled,omitempty"`
+}
+
+func (m *UnbondingRequest) Reset()         { *m = UnbondingRequest{} }
+func (m *UnbondingRequest) String() string { return proto.CompactTextString(m) }
+func (*UnbondingRequest) ProtoMessage()    {}
+func (*UnbondingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{6}
+}
+func (m *UnbondingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingRequest.Merge(m, src)
+}
+func (m *UnbondingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingRequest proto.InternalMessageInfo
+
+// UnbondingRequestEntry defines the native token amount being unbonded by a liquid validator for an unbonding request.
+type UnbondingRequestEntry struct {
+	// validator_address defines the bech32-encoded address of the liquid validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount defines the native token amount being unbonded.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *UnbondingRequestEntry) Reset()         { *m = UnbondingRequestEntry{} }
+func (m *UnbondingRequestEntry) String() string { return proto.CompactTextString(m) }
+func (*UnbondingRequestEntry) ProtoMessage()    {}
+func (*UnbondingRequestEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{7}
+}
+func (m *UnbondingRequestEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingRequestEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingRequestEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingRequestEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingRequestEntry.Merge(m, src)
+}
+func (m *UnbondingRequestEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingRequestEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingRequestEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingRequestEntry proto.InternalMessageInfo
+
+// PooledUnbonding defines the unbonding delegation entry queued under the unbonding pool account on a liquid validator
+// by the pooled liquid unstakings of a block, and the part of it left to be claimed.
+type PooledUnbonding struct {
+	// validator_address defines the bech32-encoded address of the liquid validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// creation_height defines the height at which the pooled liquid unstakings were requested.
+	CreationHeight int64 `protobuf:"varint,2,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	// completion_time defines the time at which the unbonding delegation entry matures.
+	CompletionTime time.Time `protobuf:"bytes,3,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	// unclaimed_amount defines the native token amount recorded by the unbonding requests not claimed yet.
+	UnclaimedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=unclaimed_amount,json=unclaimedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"unclaimed_amount"`
+	// balance defines the native token amount of the unbonding delegation entry left to pay out to the unclaimed
+	// unbonding requests, lowered by the slashings of the entry once it matures.
+	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+}
+
+func (m *PooledUnbonding) Reset()         { *m = PooledUnbonding{} }
+func (m *PooledUnbonding) String() string { return proto.CompactTextString(m) }
+func (*PooledUnbonding) ProtoMessage()    {}
+func (*PooledUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{8}
+}
+func (m *PooledUnbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PooledUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PooledUnbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PooledUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PooledUnbonding.Merge(m, src)
+}
+func (m *PooledUnbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *PooledUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_PooledUnbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PooledUnbonding proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("estake.lselysium.v1beta1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
+	proto.RegisterEnum("estake.lselysium.v1beta1.WeightingMode", WeightingMode_name, WeightingMode_value)
 	proto.RegisterType((*Params)(nil), "estake.lselysium.v1beta1.Params")
 	proto.RegisterType((*WhitelistedValidator)(nil), "estake.lselysium.v1beta1.WhitelistedValidator")
 	proto.RegisterType((*LiquidValidator)(nil), "estake.lselysium.v1beta1.LiquidValidator")
 	proto.RegisterType((*LiquidValidatorState)(nil), "estake.lselysium.v1beta1.LiquidValidatorState")
 	proto.RegisterType((*NetAmountState)(nil), "estake.lselysium.v1beta1.NetAmountState")
 	proto.RegisterType((*VotingPower)(nil), "estake.lselysium.v1beta1.VotingPower")
+	proto.RegisterType((*UnbondingRequest)(nil), "estake.lselysium.v1beta1.UnbondingRequest")
+	proto.RegisterType((*UnbondingRequestEntry)(nil), "estake.lselysium.v1beta1.UnbondingRequestEntry")
+	proto.RegisterType((*PooledUnbonding)(nil), "estake.lselysium.v1beta1.PooledUnbonding")
 }
 
 func init() {
@@ -357,74 +574,126 @@ func init() {
 }
 
 var fileDescriptor_729ca6a6bfc9e5d3 = []byte{
-	// 1070 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6b, 0x1b, 0x47,
-	0x18, 0xd6, 0x5a, 0x8e, 0x6a, 0x4f, 0x6a, 0x5b, 0x9e, 0xc8, 0xf6, 0x5a, 0x76, 0x25, 0x21, 0x68,
-	0x09, 0x05, 0x6b, 0x1b, 0x17, 0x7a, 0x30, 0x39, 0x44, 0x8e, 0x6c, 0x2a, 0x70, 0x1d, 0xb3, 0x92,
-	0x6d, 0x9a, 0x1e, 0xb6, 0xa3, 0xdd, 0xb1, 0xbc, 0x78, 0x77, 0x66, 0xbb, 0x33, 0x92, 0x23, 0x7a,
-	0x69, 0x6f, 0xc1, 0xa7, 0xf4, 0x56, 0x28, 0x86, 0x40, 0xff, 0x42, 0x7f, 0x44, 0x2e, 0x85, 0xd0,
-	0x43, 0x29, 0x3d, 0x88, 0x62, 0x5f, 0x7a, 0xe8, 0xc9, 0xbf, 0xa0, 0xec, 0xcc, 0xea, 0x6b, 0xa5,
-	0x06, 0x44, 0xf7, 0x24, 0xcd, 0xcc, 0xfb, 0x3e, 0xcf, 0xfb, 0xbc, 0x1f, 0x33, 0x0b, 0x3e, 0xf1,
-	0x18, 0x47, 0x17, 0x58, 0x73, 0x98, 0x87, 0x7d, 0x66, 0x33, 0x8e, 0x89, 0x89, 0xb5, 0xf6, 0xa3,
-	0x06, 0xe6, 0xe8, 0x91, 0xe6, 0xd8, 0xdf, 0xb4, 0x6c, 0x2b, 0xb0, 0xb0, 0x49, 0xb3, 0xe4, 0xf9,
-	0x94, 0x53, 0xb8, 0x29, 0x3d, 0x4a, 0x23, 0x1e, 0xa5, 0xd0, 0x23, 0x9b, 0x69, 0xd2, 0x26, 0x15,
-	0x86, 0x5a, 0xf0, 0x4f, 0xfa, 0x64, 0xd7, 0x4d, 0xca, 0x5c, 0xca, 0x0c, 0x79, 0x20, 0x17, 0xe1,
-	0x51, 0x4e, 0xae, 0xb4, 0x06, 0x62, 0x03, 0x5e, 0x93, 0xda, 0x24, 0x3c, 0xcf, 0x37, 0x29, 0x6d,
-	0x3a, 0x58, 0x13, 0xab, 0x46, 0xeb, 0x4c, 0xe3, 0xb6, 0x8b, 0x19, 0x47, 0xae, 0x27, 0x0d, 0x8a,
-	0x3f, 0xcc, 0x82, 0xd4, 0x11, 0xf2, 0x91, 0xcb, 0xe0, 0xe7, 0x60, 0x59, 0x46, 0x6c, 0x34, 0x28,
-	0xb1, 0x0c, 0x0b, 0x13, 0xea, 0xaa, 0x4a, 0x41, 0x79, 0x38, 0xbf, 0xbb, 0x79, 0xd7, 0xcd, 0xab,
-	0x1d, 0xe4, 0x3a, 0x3b, 0xc5, 0x31, 0x93, 0xa2, 0xbe, 0x24, 0xf7, 0x76, 0x29, 0xb1, 0x2a, 0xc1,
-	0x0e, 0x7c, 0xa5, 0x80, 0xd5, 0xcb, 0x73, 0x9b, 0x63, 0x27, 0x10, 0x68, 0x19, 0x6d, 0xe4, 0xd8,
-	0x16, 0xe2, 0xd4, 0x67, 0xea, 0x4c, 0x21, 0xf9, 0xf0, 0xfe, 0xf6, 0x76, 0xe9, 0x5d, 0x69, 0x28,
-	0x9d, 0x0e, 0x7c, 0x4f, 0x7a, 0xae, 0xbb, 0x1f, 0xbe, 0xe9, 0xe6, 0x13, 0x77, 0xdd, 0xfc, 0x07,
-	0x32, 0x8e, 0xc9, 0xf8, 0x45, 0x7d, 0xe5, 0x72, 0x82, 0x33, 0x83, 0xdf, 0x2b, 0x20, 0xdd, 0x22,
-	0x82, 0xd4, 0x38, 0xc3, 0xd8, 0xf0, 0x11, 0xc7, 0x6a, 0x52, 0x88, 0x3b, 0x0d, 0x80, 0xff, 0xec,
-	0xe6, 0x3f, 0x6a, 0xda, 0xfc, 0xbc, 0xd5, 0x28, 0x99, 0xd4, 0x0d, 0x93, 0x1c, 0xfe, 0x6c, 0x31,
-	0xeb, 0x42, 0xe3, 0x1d, 0x0f, 0xb3, 0x52, 0x05, 0x9b, 0x77, 0xdd, 0xfc, 0x9a, 0x0c, 0x21, 0x8a,
-	0x57, 0xfc, 0xed, 0x97, 0x2d, 0x10, 0x96, 0xa7, 0x82, 0x4d, 0x7d, 0x31, 0x34, 0xd8, 0xc7, 0x58,
-	0x47, 0x1c, 0xc3, 0x9f, 0x14, 0xb0, 0xee, 0xda, 0xc4, 0x08, 0x53, 0x18, 0x36, 0x86, 0x81, 0x5c,
-	0xda, 0x22, 0x5c, 0xbd, 0x27, 0x82, 0xf9, 0x7a, 0x8a, 0x60, 0xaa, 0x84, 0xdf, 0x75, 0xf3, 0x05,
-	0x19, 0xcc, 0x7f, 0x02, 0x0f, 0x47, 0x55, 0x25, 0x5c, 0x5f, 0x75, 0x6d, 0x72, 0x20, 0x0c, 0x6b,
-	0xd2, 0xae, 0x2c, 0xcc, 0x76, 0xe6, 0x5e, 0xbe, 0xce, 0x27, 0x7e, 0x7c, 0x9d, 0x4f, 0x14, 0x6f,
-	0x14, 0x90, 0x99, 0x54, 0x02, 0x58, 0x05, 0xcb, 0xfd, 0x54, 0x1b, 0xc8, 0xb2, 0x7c, 0xcc, 0xd8,
-	0x78, 0x87, 0x8c, 0x99, 0x14, 0xf5, 0x74, 0x7f, 0xaf, 0x2c, 0xb7, 0x60, 0x07, 0x2c, 0x70, 0xe4,
-	0x37, 0x31, 0x37, 0x2e, 0xb1, 0xdd, 0x3c, 0xe7, 0xea, 0x8c, 0x80, 0xa9, 0x4f, 0x2d, 0x3f, 0x23,
-	0x49, 0x47, 0xc0, 0xa2, 0x92, 0xdf, 0x97, 0xa7, 0xa7, 0xe2, 0x70, 0x67, 0x36, 0x10, 0x5a, 0x34,
-	0xc1, 0x92, 0xcc, 0xc2, 0x40, 0xde, 0x3e, 0x48, 0x53, 0x0f, 0xfb, 0x13, 0xd4, 0x6d, 0x0c, 0x8a,
-	0x1e, 0xb5, 0x28, 0xea, 0x4b, 0xbd, 0xad, 0x50, 0x9b, 0xcc, 0xe4, 0xdf, 0x01, 0xc9, 0xef, 0x49,
-	0x90, 0x89, 0xb0, 0xd4, 0x78, 0xd0, 0x0a, 0x31, 0x51, 0x41, 0x0c, 0x52, 0x23, 0xf9, 0xfb, 0x62,
-	0xea, 0xfc, 0x2d, 0x84, 0xe3, 0x34, 0x31, 0x71, 0x21, 0x38, 0xdc, 0x03, 0x29, 0xc6, 0x11, 0x6f,
-	0x31, 0x31, 0x32, 0x8b, 0xdb, 0x5b, 0xef, 0x9e, 0xdf, 0x11, 0xb1, 0x2d, 0xa6, 0x87, 0xce, 0xf0,
-	0x2b, 0x00, 0x2c, 0xec, 0x18, 0xec, 0x1c, 0xf9, 0x98, 0xa9, 0xb3, 0x22, 0xe2, 0xc7, 0xd3, 0x4d,
-	0x5f, 0x64, 0xc4, 0xe6, 0x2d, 0xec, 0xd4, 0x04, 0x1c, 0x44, 0x60, 0x21, 0xec, 0x7f, 0x4e, 0x2f,
-	0x30, 0x61, 0xe1, 0x40, 0x3d, 0x9e, 0x2e, 0x23, 0xd1, 0xce, 0x91, 0x90, 0x75, 0x81, 0x38, 0x54,
-	0xd8, 0x7f, 0x52, 0x60, 0xf1, 0x10, 0x73, 0x39, 0x3a, 0xb2, 0xa4, 0x5f, 0x82, 0x79, 0xd7, 0x26,
-	0x5c, 0xde, 0x2c, 0x4a, 0x0c, 0xda, 0xe6, 0x02, 0x38, 0x71, 0x71, 0x38, 0xe0, 0x41, 0x43, 0x88,
-	0x32, 0x38, 0xe5, 0xc8, 0x31, 0x58, 0xcb, 0xf3, 0x9c, 0x4e, 0x58, 0xf2, 0xff, 0x27, 0x70, 0x59,
-	0x02, 0xd7, 0x03, 0xdc, 0x9a, 0x80, 0x0d, 0xaa, 0x44, 0x30, 0xef, 0x5d, 0x4b, 0xc9, 0x38, 0xaa,
-	0x44, 0x7a, 0xa9, 0x82, 0x67, 0x20, 0x2d, 0x35, 0xc4, 0xdc, 0x08, 0x8b, 0x02, 0xb5, 0xd2, 0xef,
-	0x06, 0x07, 0x3c, 0x90, 0x3c, 0xf1, 0xf7, 0xc4, 0xb2, 0x00, 0x3e, 0x18, 0x6a, 0x0c, 0xc8, 0xc1,
-	0x9a, 0x64, 0xf3, 0xb1, 0x8b, 0x6c, 0x12, 0x5c, 0xbe, 0x3e, 0xbe, 0x44, 0xbe, 0xc5, 0xd4, 0x54,
-	0x0c, 0xe2, 0x56, 0x04, 0xb8, 0xde, 0xc3, 0xd6, 0x25, 0xf4, 0x80, 0xb5, 0x45, 0x82, 0xe7, 0x38,
-	0x60, 0x6d, 0x20, 0x07, 0x11, 0x13, 0xab, 0xef, 0xc5, 0xa0, 0x53, 0xb2, 0x1e, 0xf7, 0xb0, 0x77,
-	0x25, 0x34, 0x3c, 0x07, 0xcb, 0x9e, 0x4f, 0x5f, 0x74, 0x0c, 0x64, 0x9a, 0x7d, 0xbe, 0xb9, 0x18,
-	0xf8, 0x96, 0x04, 0x6c, 0xd9, 0x34, 0x43, 0x26, 0x31, 0x6e, 0x8a, 0x18, 0xb7, 0xef, 0x92, 0xe0,
-	0xfe, 0x09, 0xe5, 0x36, 0x69, 0x1e, 0xd1, 0x4b, 0xec, 0xc3, 0x0c, 0xb8, 0xd7, 0xa6, 0x1c, 0xfb,
-	0x72, 0xce, 0x74, 0xb9, 0x80, 0x04, 0x64, 0x7a, 0x4f, 0x5f, 0x5b, 0x18, 0x1b, 0x5e, 0x60, 0x1d,
-	0xcb, 0x9c, 0xc0, 0x10, 0x79, 0x38, 0x8a, 0x6f, 0xc1, 0x46, 0xe4, 0xc5, 0x1d, 0xa1, 0x4d, 0xc6,
-	0x40, 0xab, 0x3a, 0xc3, 0x2f, 0xf5, 0x30, 0xb9, 0x05, 0x56, 0x07, 0x0f, 0xed, 0x08, 0xaf, 0x1c,
-	0xa7, 0xd2, 0x74, 0xbc, 0x7a, 0xa6, 0x8f, 0x36, 0xc4, 0x32, 0xb8, 0xf1, 0x3e, 0xfe, 0x55, 0x01,
-	0x4b, 0x91, 0x7b, 0x1d, 0x3e, 0x01, 0x9b, 0x27, 0xe5, 0x83, 0x6a, 0xa5, 0x5c, 0x7f, 0xa6, 0x1b,
-	0xb5, 0x7a, 0xb9, 0x7e, 0x5c, 0x33, 0x8e, 0x0f, 0x6b, 0x47, 0x7b, 0x4f, 0xab, 0xfb, 0xd5, 0xbd,
-	0x4a, 0x3a, 0x91, 0xcd, 0x5d, 0x5d, 0x17, 0xb2, 0x11, 0xb7, 0x63, 0xc2, 0x3c, 0x6c, 0xda, 0x67,
-	0x36, 0xb6, 0xe0, 0x67, 0x60, 0x6d, 0x0c, 0xa1, 0xfc, 0xb4, 0x5e, 0x3d, 0xd9, 0x4b, 0x2b, 0xd9,
-	0xf5, 0xab, 0xeb, 0xc2, 0x4a, 0xc4, 0xb9, 0x6c, 0x72, 0xbb, 0x8d, 0xe1, 0x0e, 0x58, 0x1f, 0xf3,
-	0xab, 0x1e, 0x86, 0x9e, 0x33, 0xd9, 0x8d, 0xab, 0xeb, 0xc2, 0x5a, 0xc4, 0xb3, 0x4a, 0x90, 0xf0,
-	0xcd, 0xce, 0xbe, 0xfc, 0x39, 0x97, 0xd8, 0x7d, 0xfe, 0xe6, 0x26, 0xa7, 0xbc, 0xbd, 0xc9, 0x29,
-	0x7f, 0xdd, 0xe4, 0x94, 0x57, 0xb7, 0xb9, 0xc4, 0xdb, 0xdb, 0x5c, 0xe2, 0x8f, 0xdb, 0x5c, 0xe2,
-	0xf9, 0x93, 0xa1, 0x8c, 0x0d, 0xbd, 0x6e, 0xcf, 0x08, 0xd6, 0xe4, 0xab, 0xb7, 0x45, 0x50, 0x00,
-	0xa4, 0xb5, 0xb7, 0xb5, 0x17, 0x91, 0x4f, 0x7f, 0x91, 0xcf, 0x46, 0x4a, 0x7c, 0x5b, 0x7f, 0xfa,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc3, 0x78, 0xce, 0xc0, 0x1f, 0x0c, 0x00, 0x00,
+	// 1892 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6b, 0x23, 0xc9,
+	0x15, 0x57, 0x5b, 0x1e, 0x8d, 0xa7, 0x3c, 0xfa, 0x2a, 0x4b, 0x76, 0x5b, 0x9e, 0x95, 0x14, 0xc1,
+	0xec, 0x3a, 0x9b, 0x8c, 0xc4, 0x3a, 0x90, 0xc3, 0x30, 0x87, 0x48, 0xfe, 0xd8, 0x11, 0x8c, 0x3d,
+	0xa6, 0x2d, 0xdb, 0xc9, 0x2e, 0xa1, 0x53, 0xea, 0x2e, 0xcb, 0x8d, 0xfb, 0x43, 0xdb, 0x5d, 0xb2,
+	0x65, 0x12, 0x42, 0x02, 0x81, 0x2c, 0x4e, 0x02, 0x0b, 0xb9, 0x2c, 0x04, 0xc3, 0x40, 0xfe, 0x85,
+	0xfc, 0x11, 0x7b, 0x09, 0x2c, 0x7b, 0x0a, 0x39, 0x68, 0x83, 0xe7, 0x92, 0x43, 0x4e, 0xbe, 0x07,
+	0x96, 0xfa, 0x68, 0xa9, 0xd5, 0x92, 0x96, 0x6d, 0x56, 0x27, 0x5b, 0xd5, 0xbf, 0xf7, 0x7b, 0xbf,
+	0xf7, 0xea, 0xd5, 0x7b, 0xd5, 0x0d, 0x7e, 0x8c, 0x3d, 0x82, 0x2e, 0x70, 0xcd, 0xf4, 0xb0, 0x79,
+	0xed, 0x19, 0x3d, 0xab, 0x76, 0xf9, 0x41, 0x1b, 0x13, 0xf4, 0x41, 0xcd, 0x34, 0x3e, 0xe9, 0x19,
+	0x3a, 0x7d, 0x6a, 0xd8, 0x9d, 0x6a, 0xd7, 0x75, 0x88, 0x03, 0x65, 0x8e, 0xae, 0x0e, 0xd1, 0x55,
+	0x81, 0x2e, 0xe4, 0x3a, 0x4e, 0xc7, 0x61, 0xa0, 0x1a, 0xfd, 0x8f, 0xe3, 0x0b, 0xeb, 0x9a, 0xe3,
+	0x59, 0x8e, 0xa7, 0xf2, 0x07, 0xfc, 0x87, 0x78, 0x54, 0xe4, 0xbf, 0x6a, 0x6d, 0xe4, 0xe1, 0xa1,
+	0x4f, 0xcd, 0x31, 0x6c, 0xf1, 0xbc, 0xd4, 0x71, 0x9c, 0x8e, 0x89, 0x6b, 0xec, 0x57, 0xbb, 0x77,
+	0x56, 0x23, 0x86, 0x45, 0xbd, 0x5b, 0x5d, 0x0e, 0xa8, 0xdc, 0x67, 0x41, 0xe2, 0x10, 0xb9, 0xc8,
+	0xf2, 0xe0, 0x4b, 0x90, 0xe5, 0x6a, 0xd5, 0xb6, 0x63, 0xeb, 0xaa, 0x8e, 0x6d, 0xc7, 0x92, 0xa5,
+	0xb2, 0xb4, 0xf9, 0xa8, 0xf1, 0xe4, 0x7e, 0x50, 0x92, 0xaf, 0x91, 0x65, 0x3e, 0xaf, 0x4c, 0x40,
+	0x2a, 0x4a, 0x9a, 0xaf, 0x35, 0x1c, 0x5b, 0xdf, 0xa1, 0x2b, 0xf0, 0xcf, 0x12, 0x58, 0xbd, 0x3a,
+	0x37, 0x08, 0x36, 0x0d, 0x8f, 0x60, 0x5d, 0xbd, 0x44, 0xa6, 0xa1, 0x23, 0xe2, 0xb8, 0x9e, 0xbc,
+	0x50, 0x8e, 0x6f, 0x2e, 0x6f, 0x55, 0xab, 0xb3, 0x52, 0x50, 0x3d, 0x1d, 0xd9, 0x9d, 0xf8, 0x66,
+	0x8d, 0xa7, 0x5f, 0x0c, 0x4a, 0xb1, 0xfb, 0x41, 0xe9, 0x1d, 0xae, 0x61, 0x3a, 0x77, 0x45, 0xc9,
+	0x5f, 0x4d, 0x31, 0xf6, 0xe0, 0xef, 0x25, 0x90, 0xe9, 0xd9, 0xcc, 0xa1, 0x7a, 0x86, 0xb1, 0xea,
+	0x22, 0x82, 0xe5, 0x38, 0x0b, 0xec, 0x94, 0x12, 0xff, 0x7b, 0x50, 0x7a, 0xb7, 0x63, 0x90, 0xf3,
+	0x5e, 0xbb, 0xaa, 0x39, 0x96, 0x48, 0xb0, 0xf8, 0xf3, 0xcc, 0xd3, 0x2f, 0x6a, 0xe4, 0xba, 0x8b,
+	0xbd, 0xea, 0x0e, 0xd6, 0xee, 0x07, 0xa5, 0x35, 0x2e, 0x21, 0xcc, 0x57, 0xf9, 0xea, 0x1f, 0xcf,
+	0x80, 0xd8, 0x9a, 0x1d, 0xac, 0x29, 0x29, 0x01, 0xd8, 0xc3, 0x58, 0x41, 0x04, 0xc3, 0xbf, 0x49,
+	0x60, 0xdd, 0x32, 0x6c, 0x55, 0xa4, 0x4f, 0x14, 0x84, 0x8a, 0x2c, 0xa7, 0x67, 0x13, 0xf9, 0x01,
+	0x13, 0xf3, 0xab, 0x08, 0x62, 0x9a, 0x36, 0xb9, 0x1f, 0x94, 0xca, 0x5c, 0xcc, 0x4c, 0xe2, 0xa0,
+	0xaa, 0xa6, 0x4d, 0x94, 0x55, 0xcb, 0xb0, 0x5f, 0x31, 0xe0, 0x11, 0xc7, 0xd5, 0x19, 0x0c, 0xfe,
+	0x49, 0x02, 0x2b, 0x2e, 0x6e, 0x23, 0x13, 0xd9, 0x1a, 0xb5, 0x26, 0xae, 0xd1, 0xe9, 0x60, 0x57,
+	0x4e, 0x30, 0x5d, 0x1f, 0x45, 0x4e, 0x52, 0x81, 0xeb, 0x9a, 0x42, 0x19, 0xce, 0x13, 0x0c, 0x60,
+	0x5a, 0x1c, 0x02, 0x7f, 0x03, 0x52, 0x2e, 0xbe, 0x42, 0xae, 0x3e, 0xd4, 0xf1, 0x90, 0xe9, 0x38,
+	0x8e, 0xac, 0x23, 0xef, 0xeb, 0x08, 0xb2, 0x85, 0x25, 0x24, 0xf9, 0x63, 0xdf, 0x3b, 0x06, 0x1b,
+	0x16, 0xea, 0xab, 0x2e, 0xd6, 0xb1, 0x89, 0x3b, 0x88, 0x18, 0x8e, 0xed, 0xa9, 0x5d, 0xec, 0xaa,
+	0x6d, 0xd3, 0xd1, 0x2e, 0xe4, 0xa5, 0xb2, 0xb4, 0x99, 0x6c, 0xbc, 0x7b, 0x3f, 0x28, 0x55, 0x44,
+	0xf2, 0x67, 0x83, 0x2b, 0x8a, 0x6c, 0xa1, 0xbe, 0x12, 0x7c, 0x78, 0x88, 0xdd, 0x06, 0x7d, 0x04,
+	0x8f, 0x41, 0x3e, 0x98, 0x9e, 0x33, 0x17, 0x7f, 0xd2, 0xc3, 0xb6, 0x76, 0x2d, 0x3f, 0x2a, 0x4b,
+	0x9b, 0x8b, 0x8d, 0xf2, 0xfd, 0xa0, 0xf4, 0x64, 0x32, 0x8b, 0x43, 0x58, 0x45, 0xc9, 0x05, 0xd6,
+	0xf7, 0xfc, 0x65, 0xf8, 0x5b, 0x90, 0x16, 0xd1, 0x0e, 0x2b, 0x1d, 0xb0, 0xe4, 0x9d, 0x44, 0x4e,
+	0xde, 0xea, 0x58, 0xf2, 0x66, 0x15, 0xba, 0xc8, 0x9e, 0x5f, 0xe7, 0x07, 0x60, 0x85, 0x22, 0x91,
+	0xa6, 0xd1, 0xc2, 0x52, 0x91, 0xae, 0xbb, 0xd8, 0xf3, 0xe4, 0x65, 0xa6, 0xa1, 0x38, 0x2a, 0x8d,
+	0x29, 0xa0, 0x8a, 0x92, 0x3d, 0xc3, 0xb8, 0xce, 0x17, 0xeb, 0x7c, 0x0d, 0x6a, 0xa0, 0x40, 0x13,
+	0x3c, 0xa3, 0x9b, 0x3c, 0x66, 0x9b, 0xf1, 0xf4, 0x7e, 0x50, 0xfa, 0xc1, 0x68, 0x33, 0x66, 0x75,
+	0x07, 0xba, 0x17, 0xa7, 0x53, 0x1b, 0xc4, 0x1f, 0x24, 0x90, 0xa5, 0x96, 0x04, 0xb9, 0x1d, 0x4c,
+	0xd4, 0x2b, 0x6c, 0x74, 0xce, 0x89, 0x9c, 0x64, 0x9a, 0x7f, 0x1e, 0xf9, 0x50, 0xca, 0x23, 0x29,
+	0x63, 0x84, 0xe1, 0xc3, 0x98, 0xb6, 0x50, 0xbf, 0xc5, 0x00, 0xa7, 0xec, 0x39, 0x34, 0x40, 0x8a,
+	0x23, 0xe9, 0x4e, 0x5b, 0x8e, 0x8e, 0xe5, 0x54, 0x59, 0xda, 0x4c, 0x6d, 0xbd, 0xf7, 0x2d, 0xdd,
+	0xd2, 0xc7, 0xef, 0x3b, 0x3a, 0x6e, 0xac, 0x8f, 0x4a, 0x7e, 0x9c, 0xa8, 0xa2, 0x24, 0xaf, 0x82,
+	0x48, 0xf8, 0x57, 0x09, 0xd0, 0x5e, 0x40, 0x4b, 0xf5, 0xcc, 0x71, 0x2d, 0x64, 0x6b, 0x58, 0x3d,
+	0x43, 0x1a, 0x71, 0x5c, 0x39, 0xcd, 0xc2, 0xfe, 0x65, 0xe4, 0x72, 0x79, 0x67, 0xd4, 0x8b, 0x26,
+	0x59, 0xc3, 0x55, 0x93, 0xb3, 0x0c, 0xfb, 0x70, 0x84, 0xda, 0x63, 0x20, 0xae, 0x0a, 0xf5, 0xa7,
+	0xa9, 0xca, 0x7c, 0x4f, 0x55, 0xa8, 0xff, 0x9d, 0x54, 0xa1, 0xfe, 0xa4, 0xaa, 0x3d, 0x90, 0xe9,
+	0x75, 0x75, 0x44, 0x70, 0xe0, 0x90, 0x66, 0xd9, 0x21, 0xdd, 0x08, 0xcc, 0x83, 0x10, 0xa2, 0xa2,
+	0xa4, 0xf9, 0xd2, 0xe8, 0x68, 0x1e, 0x81, 0x3c, 0x95, 0xd1, 0xb3, 0xe9, 0xec, 0x0c, 0xb6, 0x14,
+	0xc8, 0xaa, 0x38, 0x70, 0xe2, 0xa7, 0xc2, 0x2a, 0x0a, 0xb4, 0x50, 0xff, 0x98, 0x2f, 0x0f, 0xdb,
+	0xc8, 0xe7, 0x12, 0x90, 0x0d, 0x3a, 0x6a, 0x6c, 0xa2, 0x4e, 0xcc, 0xb8, 0x15, 0x96, 0x34, 0x35,
+	0x72, 0xd2, 0x4a, 0x5c, 0xc6, 0x2c, 0xde, 0x70, 0xda, 0xf2, 0x02, 0x78, 0x3c, 0x3e, 0xf2, 0xfe,
+	0x22, 0x81, 0x9c, 0x4f, 0xe1, 0x62, 0x0f, 0xbb, 0x97, 0x42, 0x56, 0x8e, 0xc9, 0xfa, 0x38, 0xb2,
+	0xac, 0x8d, 0x71, 0x59, 0x41, 0xce, 0x89, 0xb1, 0x22, 0x40, 0x0a, 0xc7, 0x50, 0x3d, 0xcf, 0x97,
+	0x3e, 0x7d, 0x53, 0x8a, 0x7d, 0xfe, 0xa6, 0x14, 0xab, 0xdc, 0x49, 0x20, 0x37, 0xad, 0x13, 0xc0,
+	0x26, 0xc8, 0x0e, 0x3b, 0xc6, 0xb0, 0x77, 0x4d, 0x5c, 0x81, 0x26, 0x20, 0x15, 0x25, 0x33, 0x5c,
+	0xf3, 0x1b, 0xd7, 0x35, 0x48, 0x8e, 0xb7, 0x93, 0x05, 0x46, 0xd3, 0x8a, 0xdc, 0x4e, 0x72, 0xdc,
+	0xe9, 0xb7, 0xb6, 0x92, 0xc7, 0x24, 0xd0, 0x47, 0x9e, 0x2f, 0xd2, 0x40, 0x2b, 0x1a, 0x48, 0xf3,
+	0x51, 0x3f, 0x0a, 0x6f, 0x0f, 0x64, 0x9c, 0x2e, 0x76, 0xa7, 0x44, 0x17, 0xa8, 0xe4, 0x30, 0xa2,
+	0xa2, 0xa4, 0xfd, 0x25, 0x11, 0x1b, 0xcf, 0xe4, 0x7f, 0xa9, 0x93, 0xaf, 0xe2, 0x20, 0x17, 0xf2,
+	0x72, 0x44, 0xe8, 0xe6, 0xcf, 0xc9, 0x15, 0xc4, 0x20, 0x31, 0x96, 0xbf, 0xfd, 0xc8, 0xf9, 0x4b,
+	0x06, 0x1b, 0x62, 0x38, 0x71, 0x82, 0x1c, 0xd6, 0x41, 0xc2, 0x23, 0x88, 0xf4, 0x3c, 0x76, 0x2f,
+	0x4c, 0x6d, 0xfd, 0x70, 0x76, 0xcb, 0x1d, 0x0b, 0xb4, 0xe7, 0x29, 0xc2, 0x10, 0x7e, 0x0c, 0x80,
+	0x8e, 0x4d, 0xd5, 0x3b, 0x47, 0x2e, 0xf6, 0xe4, 0x45, 0xa6, 0xf6, 0x45, 0xb4, 0x1a, 0x0f, 0x15,
+	0xf1, 0x23, 0x1d, 0x9b, 0x47, 0x8c, 0x0e, 0x22, 0x90, 0x14, 0x17, 0x3c, 0xe2, 0x5c, 0x60, 0xdb,
+	0x93, 0x1f, 0x44, 0xe6, 0x6f, 0xda, 0x24, 0x5c, 0x35, 0x9c, 0xb2, 0xc5, 0x18, 0x03, 0x9b, 0xfa,
+	0xbf, 0x04, 0x48, 0x1d, 0x60, 0xc2, 0xef, 0x86, 0x7c, 0x3b, 0x7f, 0x01, 0x1e, 0x59, 0x86, 0x4d,
+	0xf8, 0xf9, 0x95, 0xe6, 0x10, 0xdb, 0x12, 0xa5, 0x63, 0x6d, 0xc2, 0x04, 0x2b, 0x6d, 0x16, 0x94,
+	0x4a, 0x1c, 0x82, 0x4c, 0xd5, 0xeb, 0x75, 0xbb, 0xe6, 0xb5, 0xbc, 0x10, 0xd9, 0xc9, 0x64, 0x80,
+	0x59, 0x4e, 0xdc, 0xa2, 0xbc, 0x47, 0x8c, 0x96, 0xee, 0x92, 0x8d, 0x89, 0x7f, 0xef, 0x8e, 0xcf,
+	0x63, 0x97, 0x6c, 0x3f, 0x55, 0xf0, 0x0c, 0x64, 0x78, 0x0c, 0x73, 0x2e, 0x84, 0x14, 0x63, 0xdd,
+	0x19, 0x56, 0x83, 0x09, 0x56, 0xb8, 0x9f, 0xf9, 0xd7, 0x44, 0x96, 0x11, 0xbf, 0x0a, 0x14, 0x06,
+	0x24, 0x60, 0x8d, 0x7b, 0x73, 0xb1, 0x85, 0x0c, 0x9b, 0xde, 0x29, 0xf8, 0x9d, 0xcf, 0x93, 0x13,
+	0x91, 0x3d, 0x4e, 0x06, 0x97, 0x67, 0xe4, 0x8a, 0xcf, 0xad, 0x70, 0xea, 0x91, 0x57, 0x3e, 0x08,
+	0xa9, 0x57, 0x7e, 0xdd, 0xc5, 0xf2, 0xc3, 0xc8, 0x5e, 0x27, 0xe3, 0xe4, 0x5e, 0x8f, 0x7d, 0xee,
+	0x06, 0xa7, 0x86, 0xe7, 0x20, 0xdb, 0x75, 0x9d, 0xfe, 0x35, 0xbd, 0x9b, 0x0e, 0xfd, 0x2d, 0xcd,
+	0xc1, 0x5f, 0x9a, 0xd1, 0xd6, 0x35, 0x4d, 0x78, 0x62, 0xc7, 0x4d, 0x62, 0xc7, 0xed, 0x77, 0x71,
+	0xb0, 0x7c, 0xe2, 0xd0, 0xab, 0xd9, 0xa1, 0x73, 0x85, 0x5d, 0x98, 0x03, 0x0f, 0x2e, 0x1d, 0x82,
+	0x5d, 0x7e, 0xce, 0x14, 0xfe, 0x03, 0xda, 0x20, 0xe7, 0xbf, 0xdb, 0x5d, 0x32, 0xb0, 0xda, 0xa5,
+	0xe8, 0xb9, 0x9c, 0x13, 0x28, 0x98, 0x83, 0x2a, 0x7e, 0x0d, 0x36, 0x42, 0xaf, 0x94, 0x63, 0x6e,
+	0xe3, 0x73, 0x70, 0x2b, 0x9b, 0xc1, 0x57, 0xd1, 0xa0, 0x73, 0x1d, 0xac, 0x8e, 0x86, 0xec, 0x98,
+	0x5f, 0x7e, 0x9c, 0xaa, 0xd1, 0xfc, 0x2a, 0xb9, 0x21, 0x5b, 0xc0, 0x4b, 0xa0, 0xe3, 0x7d, 0x1d,
+	0x07, 0x99, 0x61, 0x2d, 0x28, 0xf4, 0xc2, 0xe6, 0x11, 0x98, 0x02, 0x0b, 0x86, 0xce, 0x36, 0x61,
+	0x51, 0x59, 0x30, 0x74, 0xf8, 0x14, 0xa4, 0xd8, 0x4c, 0x18, 0x0d, 0x34, 0x96, 0x7b, 0x25, 0xc9,
+	0x57, 0xfd, 0x89, 0xb5, 0x03, 0x92, 0xa2, 0x9f, 0xb5, 0x7b, 0xae, 0x8d, 0x75, 0x96, 0xaa, 0xe5,
+	0xad, 0xf5, 0xaa, 0x88, 0xbc, 0x8d, 0x3c, 0x3c, 0x1c, 0x26, 0xdb, 0x8e, 0x61, 0x37, 0x16, 0x69,
+	0x34, 0xca, 0x63, 0x6e, 0xd5, 0x60, 0x46, 0xb0, 0x43, 0x3f, 0x59, 0xf8, 0x85, 0x2f, 0xba, 0xd5,
+	0xe2, 0x3c, 0xea, 0x70, 0xc8, 0x2a, 0x7a, 0xd6, 0x6b, 0xf0, 0x10, 0xdb, 0xc4, 0x35, 0x30, 0xed,
+	0x1f, 0xf4, 0xdb, 0x4c, 0x6d, 0xf6, 0xe8, 0x0b, 0xa7, 0x68, 0xd7, 0x26, 0xee, 0xb5, 0x90, 0xef,
+	0xb3, 0xc0, 0xf7, 0x40, 0x5a, 0x73, 0x31, 0x7b, 0xdb, 0x55, 0xcf, 0xf9, 0xe8, 0xa6, 0x6d, 0x22,
+	0xae, 0xa4, 0xfc, 0xe5, 0x97, 0x7c, 0xe6, 0xee, 0x83, 0xb4, 0xe6, 0x58, 0x5d, 0x13, 0x33, 0x28,
+	0x31, 0x2c, 0x7e, 0xb2, 0x97, 0xb7, 0x0a, 0x55, 0xfe, 0xd5, 0xaa, 0xea, 0x7f, 0xb5, 0xaa, 0xb6,
+	0xfc, 0xaf, 0x56, 0x8d, 0x25, 0xea, 0xec, 0xb3, 0xaf, 0x4b, 0x92, 0x92, 0x1a, 0x19, 0xd3, 0xc7,
+	0x70, 0x15, 0x24, 0xba, 0x8e, 0x63, 0x62, 0x9d, 0x9d, 0xd7, 0x25, 0x45, 0xfc, 0x12, 0xb7, 0xa1,
+	0x37, 0x12, 0xc8, 0x4f, 0x95, 0x0f, 0x7f, 0x34, 0xf3, 0xce, 0x37, 0xe5, 0x56, 0xd7, 0x02, 0x09,
+	0xb1, 0x19, 0xf3, 0x38, 0x77, 0x82, 0x4b, 0x48, 0xfc, 0xff, 0x02, 0x48, 0x1f, 0x32, 0xcd, 0x43,
+	0xa1, 0xd1, 0xc4, 0x4d, 0xc9, 0xfc, 0xc2, 0x77, 0xcd, 0x7c, 0xfc, 0x7b, 0x64, 0x9e, 0xd5, 0xaa,
+	0x66, 0x22, 0xc3, 0xc2, 0xfa, 0x9c, 0x6b, 0x55, 0xb0, 0x8a, 0x5a, 0x3d, 0x01, 0x0f, 0xfd, 0x9e,
+	0x3c, 0x8f, 0x59, 0xe7, 0x93, 0xf1, 0xfc, 0xbf, 0xff, 0x4f, 0x09, 0xa4, 0x43, 0x97, 0x3b, 0xf8,
+	0x33, 0xf0, 0xe4, 0xa4, 0xfe, 0xaa, 0xb9, 0x53, 0x6f, 0xbd, 0x56, 0xd4, 0xa3, 0x56, 0xbd, 0x75,
+	0x7c, 0xa4, 0x1e, 0x1f, 0x1c, 0x1d, 0xee, 0x6e, 0x37, 0xf7, 0x9a, 0xbb, 0x3b, 0x99, 0x58, 0xa1,
+	0x78, 0x73, 0x5b, 0x2e, 0x84, 0xcc, 0x8e, 0x6d, 0xaf, 0x8b, 0x35, 0xe3, 0xcc, 0xc0, 0x3a, 0xfc,
+	0x29, 0x58, 0x9b, 0x60, 0xa8, 0x6f, 0xb7, 0x9a, 0x27, 0xbb, 0x19, 0xa9, 0xb0, 0x7e, 0x73, 0x5b,
+	0xce, 0x87, 0x8c, 0xeb, 0x1a, 0x31, 0x2e, 0x31, 0x7c, 0x0e, 0xd6, 0x27, 0xec, 0x9a, 0x07, 0xc2,
+	0x72, 0xa1, 0xb0, 0x71, 0x73, 0x5b, 0x5e, 0x0b, 0x59, 0x36, 0x6d, 0xc4, 0x6c, 0x0b, 0x8b, 0x9f,
+	0xfe, 0xbd, 0x18, 0x7b, 0xff, 0x8f, 0x12, 0x48, 0x8e, 0x7d, 0x1f, 0x80, 0x5b, 0x20, 0x7f, 0xba,
+	0xdb, 0xfc, 0xf0, 0x65, 0xab, 0x79, 0xf0, 0xa1, 0xba, 0xff, 0x7a, 0x67, 0x97, 0x11, 0x37, 0xb7,
+	0x33, 0xb1, 0xc2, 0xda, 0xcd, 0x6d, 0x79, 0x65, 0x0c, 0x4d, 0x39, 0x0d, 0x0d, 0xbe, 0x00, 0x85,
+	0x90, 0xcd, 0xe1, 0xae, 0xb2, 0xf7, 0x5a, 0xd9, 0xaf, 0x1f, 0x6c, 0xd3, 0x10, 0x9e, 0xdc, 0xdc,
+	0x96, 0xe5, 0x31, 0xc3, 0xc0, 0x1b, 0x34, 0x57, 0xd2, 0x38, 0xf9, 0xe2, 0xae, 0x28, 0x7d, 0x79,
+	0x57, 0x94, 0xfe, 0x73, 0x57, 0x94, 0x3e, 0x7b, 0x5b, 0x8c, 0x7d, 0xf9, 0xb6, 0x18, 0xfb, 0xd7,
+	0xdb, 0x62, 0xec, 0xa3, 0x17, 0x81, 0x8d, 0xb3, 0xb0, 0x6b, 0x1a, 0xf6, 0x33, 0x1b, 0x93, 0x2b,
+	0xc7, 0xbd, 0xa8, 0xf1, 0x2e, 0xf4, 0xcc, 0x46, 0x34, 0xa4, 0xda, 0xe5, 0x56, 0xad, 0x1f, 0xf8,
+	0xbc, 0xce, 0xb6, 0xb4, 0x9d, 0x60, 0x65, 0xfa, 0x93, 0x6f, 0x06, 0x00, 0xb7, 0x6e, 0x08, 0x8f,
+	0x7f, 0x17, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -447,6 +716,133 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.InstantReserveRate.Size()
+		i -= size
+		if _, err := m.InstantReserveRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size := m.InstantUnstakeFeeRate.Size()
+		i -= size
+		if _, err := m.InstantUnstakeFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.MaxUnbondsPerBlock != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.MaxUnbondsPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.UpdateFrequency != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.UpdateFrequency))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.MaxPerformanceFactor.Size()
+		i -= size
+		if _, err := m.MaxPerformanceFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.MinPerformanceFactor.Size()
+		i -= size
+		if _, err := m.MinPerformanceFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.WeightingMode != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.WeightingMode))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.MaxTargetWeight.Size()
+		i -= size
+		if _, err := m.MaxTargetWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.MaxWhitelistedValidators != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.MaxWhitelistedValidators))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.FeeAccountAddress) > 0 {
+		i -= len(m.FeeAccountAddress)
+		copy(dAtA[i:], m.FeeAccountAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.FeeAccountAddress)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	{
+		size := m.RewardFeeRate.Size()
+		i -= size
+		if _, err := m.RewardFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.RebalancingFrequency != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.RebalancingFrequency))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxRedelegationsPerBlock != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.MaxRedelegationsPerBlock))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.RewardTrigger.Size()
+		i -= size
+		if _, err := m.RewardTrigger.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.RebalancingTrigger.Size()
+		i -= size
+		if _, err := m.RebalancingTrigger.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.MinLiquidStakingAmount.Size()
 		i -= size
@@ -789,66 +1185,299 @@ func (m *VotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLiquidstaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLiquidstaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UnbondingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *UnbondingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.LiquidBondDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidstaking(uint64(l))
+	if m.Pooled {
+		i--
+		if m.Pooled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if len(m.WhitelistedValidators) > 0 {
-		for _, e := range m.WhitelistedValidators {
-			l = e.Size()
-			n += 1 + l + sovLiquidstaking(uint64(l))
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintLiquidstaking(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.CreationHeight != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	l = m.UnstakeFeeRate.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.MinLiquidStakingAmount.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	return n
-}
-
-func (m *WhitelistedValidator) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.UnbondingAmount.Size()
+		i -= size
+		if _, err := m.UnbondingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstaking(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.BtokenBurned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
 	}
-	l = m.TargetWeight.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.StakerAddress) > 0 {
+		i -= len(m.StakerAddress)
+		copy(dAtA[i:], m.StakerAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.StakerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstaking(uint64(l))
+func (m *UnbondingRequestEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingRequestEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingRequestEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PooledUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PooledUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PooledUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.UnclaimedAmount.Size()
+		i -= size
+		if _, err := m.UnclaimedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintLiquidstaking(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if m.CreationHeight != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiquidstaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquidstaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LiquidBondDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	if len(m.WhitelistedValidators) > 0 {
+		for _, e := range m.WhitelistedValidators {
+			l = e.Size()
+			n += 1 + l + sovLiquidstaking(uint64(l))
+		}
+	}
+	l = m.UnstakeFeeRate.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.MinLiquidStakingAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.RebalancingTrigger.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.RewardTrigger.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	if m.MaxRedelegationsPerBlock != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.MaxRedelegationsPerBlock))
+	}
+	if m.RebalancingFrequency != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.RebalancingFrequency))
+	}
+	l = m.RewardFeeRate.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = len(m.FeeAccountAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	if m.MaxWhitelistedValidators != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.MaxWhitelistedValidators))
+	}
+	l = m.MaxTargetWeight.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	if m.WeightingMode != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.WeightingMode))
+	}
+	l = m.MinPerformanceFactor.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.MaxPerformanceFactor.Size()
+	n += 2 + l + sovLiquidstaking(uint64(l))
+	if m.UpdateFrequency != 0 {
+		n += 2 + sovLiquidstaking(uint64(m.UpdateFrequency))
+	}
+	if m.MaxUnbondsPerBlock != 0 {
+		n += 2 + sovLiquidstaking(uint64(m.MaxUnbondsPerBlock))
+	}
+	l = m.InstantUnstakeFeeRate.Size()
+	n += 2 + l + sovLiquidstaking(uint64(l))
+	l = m.InstantReserveRate.Size()
+	n += 2 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *WhitelistedValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.TargetWeight.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *LiquidValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	return n
 }
 
 func (m *LiquidValidatorState) Size() (n int) {
@@ -917,13 +1546,884 @@ func (m *VotingPower) Size() (n int) {
 	return n
 }
 
+func (m *UnbondingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.Id))
+	}
+	l = len(m.StakerAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.BtokenBurned.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.UnbondingAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovLiquidstaking(uint64(l))
+		}
+	}
+	if m.CreationHeight != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.CreationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	if m.Pooled {
+		n += 2
+	}
+	return n
+}
+
+func (m *UnbondingRequestEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *PooledUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	if m.CreationHeight != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.CreationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.UnclaimedAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
 func sovLiquidstaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozLiquidstaking(x uint64) (n int) {
 	return sovLiquidstaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidBondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidBondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedValidators = append(m.WhitelistedValidators, WhitelistedValidator{})
+			if err := m.WhitelistedValidators[len(m.WhitelistedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnstakeFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidStakingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLiquidStakingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalancingTrigger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RebalancingTrigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardTrigger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardTrigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedelegationsPerBlock", wireType)
+			}
+			m.MaxRedelegationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRedelegationsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalancingFrequency", wireType)
+			}
+			m.RebalancingFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RebalancingFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeAccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWhitelistedValidators", wireType)
+			}
+			m.MaxWhitelistedValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWhitelistedValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTargetWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightingMode", wireType)
+			}
+			m.WeightingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightingMode |= WeightingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPerformanceFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPerformanceFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerformanceFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPerformanceFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateFrequency", wireType)
+			}
+			m.UpdateFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnbondsPerBlock", wireType)
+			}
+			m.MaxUnbondsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUnbondsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantUnstakeFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantUnstakeFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantReserveRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantReserveRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhitelistedValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhitelistedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -946,15 +2446,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidValidatorState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidValidatorState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidBondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -982,13 +2482,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidBondDenom = string(dAtA[iNdEx:postIndex])
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstaking
@@ -998,29 +2498,48 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WhitelistedValidators = append(m.WhitelistedValidators, WhitelistedValidator{})
-			if err := m.WhitelistedValidators[len(m.WhitelistedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ValidatorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelShares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1048,13 +2567,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnstakeFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidStakingAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1082,7 +2601,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinLiquidStakingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1107,7 +2626,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
+func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1130,15 +2649,15 @@ func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WhitelistedValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetAmountState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WhitelistedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetAmountState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1166,11 +2685,13 @@ func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.MintRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtokenTotalSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1198,63 +2719,183 @@ func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BtokenTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
-			if err != nil {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLiquidstaking
+			if err := m.TotalDelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TotalLiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemainingRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalRemainingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnbondingBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalUnbondingBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidValidator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidValidator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyAccBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1282,7 +2923,9 @@ func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.ProxyAccBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1305,7 +2948,7 @@ func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
+func (m *VotingPower) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1328,15 +2971,15 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidValidatorState: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotingPower: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidValidatorState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1364,11 +3007,11 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingVotingPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1396,32 +3039,13 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StakingVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ValidatorStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakingVotingPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1449,13 +3073,13 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidStakingVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorVotingPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1483,7 +3107,7 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidatorVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1508,7 +3132,7 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetAmountState) Unmarshal(dAtA []byte) error {
+func (m *UnbondingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1531,15 +3155,34 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetAmountState: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetAmountState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1567,15 +3210,13 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtokenTotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtokenBurned", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstaking
@@ -1585,29 +3226,28 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BtokenTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BtokenBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1635,15 +3275,15 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UnbondingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstaking
@@ -1653,31 +3293,50 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalDelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, UnbondingRequestEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstaking
@@ -1687,31 +3346,30 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalLiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemainingRewards", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pooled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstaking
@@ -1721,29 +3379,65 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstaking
+			m.Pooled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalRemainingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingRequestEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
 			}
-			iNdEx = postIndex
-		case 7:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingRequestEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingRequestEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnbondingBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1771,13 +3465,11 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalUnbondingBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyAccBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1805,7 +3497,7 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProxyAccBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1830,7 +3522,7 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VotingPower) Unmarshal(dAtA []byte) error {
+func (m *PooledUnbonding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1853,15 +3545,15 @@ func (m *VotingPower) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VotingPower: wiretype end group for non-group")
+			return fmt.Errorf("proto: PooledUnbonding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PooledUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1889,13 +3581,32 @@ func (m *VotingPower) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingVotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstaking
@@ -1905,29 +3616,28 @@ func (m *VotingPower) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StakingVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakingVotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1955,13 +3665,13 @@ func (m *VotingPower) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidStakingVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UnclaimedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorVotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1989,7 +3699,7 @@ func (m *VotingPower) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
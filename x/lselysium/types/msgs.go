@@ -2,6 +2,7 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -9,12 +10,27 @@ import (
 var (
 	_ sdk.Msg = (*MsgLiquidStake)(nil)
 	_ sdk.Msg = (*MsgLiquidUnstake)(nil)
+	_ sdk.Msg = (*MsgStakeToLiquid)(nil)
+	_ sdk.Msg = (*MsgPooledLiquidUnstake)(nil)
+	_ sdk.Msg = (*MsgClaimUnbonded)(nil)
+	_ sdk.Msg = (*MsgInstantLiquidUnstake)(nil)
+	_ sdk.Msg = (*MsgAddWhitelistedValidator)(nil)
+	_ sdk.Msg = (*MsgRemoveWhitelistedValidator)(nil)
+	_ sdk.Msg = (*MsgUpdateValidatorWeight)(nil)
 )
 
 // Message types for the liquidstaking module
 const (
-	TypeMsgLiquidStake   = "liquid_stake"
-	TypeMsgLiquidUnstake = "liquid_unstake"
+	TypeMsgLiquidStake          = "liquid_stake"
+	TypeMsgLiquidUnstake        = "liquid_unstake"
+	TypeMsgStakeToLiquid        = "stake_to_liquid"
+	TypeMsgPooledLiquidUnstake  = "pooled_liquid_unstake"
+	TypeMsgClaimUnbonded        = "claim_unbonded"
+	TypeMsgInstantLiquidUnstake = "instant_liquid_unstake"
+
+	TypeMsgAddWhitelistedValidator    = "add_whitelisted_validator"
+	TypeMsgRemoveWhitelistedValidator = "remove_whitelisted_validator"
+	TypeMsgUpdateValidatorWeight      = "update_validator_weight"
 )
 
 // NewMsgLiquidStake creates a new MsgLiquidStake.
@@ -112,3 +128,322 @@ func (msg MsgLiquidUnstake) GetDelegator() sdk.AccAddress {
 	}
 	return addr
 }
+
+// NewMsgStakeToLiquid creates a new MsgStakeToLiquid.
+func NewMsgStakeToLiquid(
+	delegator sdk.AccAddress, //nolint: interfacer
+	validator sdk.ValAddress,
+	amount sdk.Coin,
+) *MsgStakeToLiquid {
+	return &MsgStakeToLiquid{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: validator.String(),
+		Amount:           amount,
+	}
+}
+
+func (msg MsgStakeToLiquid) Route() string { return RouterKey }
+
+func (msg MsgStakeToLiquid) Type() string { return TypeMsgStakeToLiquid }
+
+func (msg MsgStakeToLiquid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address %q: %v", msg.DelegatorAddress, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address %q: %v", msg.ValidatorAddress, err)
+	}
+	if ok := msg.Amount.IsZero(); ok {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "staking amount must not be zero")
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg MsgStakeToLiquid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStakeToLiquid) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgStakeToLiquid) GetDelegator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func (msg MsgStakeToLiquid) GetValidator() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgPooledLiquidUnstake creates a new MsgPooledLiquidUnstake.
+func NewMsgPooledLiquidUnstake(
+	liquidStaker sdk.AccAddress, //nolint: interfacer
+	amount sdk.Coin,
+) *MsgPooledLiquidUnstake {
+	return &MsgPooledLiquidUnstake{
+		DelegatorAddress: liquidStaker.String(),
+		Amount:           amount,
+	}
+}
+
+func (msg MsgPooledLiquidUnstake) Route() string { return RouterKey }
+
+func (msg MsgPooledLiquidUnstake) Type() string { return TypeMsgPooledLiquidUnstake }
+
+func (msg MsgPooledLiquidUnstake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address %q: %v", msg.DelegatorAddress, err)
+	}
+	if ok := msg.Amount.IsZero(); ok {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unstaking amount must not be zero")
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg MsgPooledLiquidUnstake) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPooledLiquidUnstake) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgPooledLiquidUnstake) GetDelegator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgClaimUnbonded creates a new MsgClaimUnbonded.
+func NewMsgClaimUnbonded(
+	liquidStaker sdk.AccAddress, //nolint: interfacer
+) *MsgClaimUnbonded {
+	return &MsgClaimUnbonded{
+		DelegatorAddress: liquidStaker.String(),
+	}
+}
+
+func (msg MsgClaimUnbonded) Route() string { return RouterKey }
+
+func (msg MsgClaimUnbonded) Type() string { return TypeMsgClaimUnbonded }
+
+func (msg MsgClaimUnbonded) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address %q: %v", msg.DelegatorAddress, err)
+	}
+	return nil
+}
+
+func (msg MsgClaimUnbonded) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgClaimUnbonded) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgClaimUnbonded) GetDelegator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgInstantLiquidUnstake creates a new MsgInstantLiquidUnstake.
+func NewMsgInstantLiquidUnstake(
+	liquidStaker sdk.AccAddress, //nolint: interfacer
+	amount sdk.Coin,
+) *MsgInstantLiquidUnstake {
+	return &MsgInstantLiquidUnstake{
+		DelegatorAddress: liquidStaker.String(),
+		Amount:           amount,
+	}
+}
+
+func (msg MsgInstantLiquidUnstake) Route() string { return RouterKey }
+
+func (msg MsgInstantLiquidUnstake) Type() string { return TypeMsgInstantLiquidUnstake }
+
+func (msg MsgInstantLiquidUnstake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address %q: %v", msg.DelegatorAddress, err)
+	}
+	if ok := msg.Amount.IsZero(); ok {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unstaking amount must not be zero")
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg MsgInstantLiquidUnstake) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgInstantLiquidUnstake) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgInstantLiquidUnstake) GetDelegator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgAddWhitelistedValidator creates a new MsgAddWhitelistedValidator.
+func NewMsgAddWhitelistedValidator(
+	authority sdk.AccAddress, //nolint: interfacer
+	validator sdk.ValAddress,
+	targetWeight math.Int,
+) *MsgAddWhitelistedValidator {
+	return &MsgAddWhitelistedValidator{
+		Authority:        authority.String(),
+		ValidatorAddress: validator.String(),
+		TargetWeight:     targetWeight,
+	}
+}
+
+func (msg MsgAddWhitelistedValidator) Route() string { return RouterKey }
+
+func (msg MsgAddWhitelistedValidator) Type() string { return TypeMsgAddWhitelistedValidator }
+
+func (msg MsgAddWhitelistedValidator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", msg.Authority, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address %q: %v", msg.ValidatorAddress, err)
+	}
+	return validateTargetWeight(msg.TargetWeight)
+}
+
+func (msg MsgAddWhitelistedValidator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAddWhitelistedValidator) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRemoveWhitelistedValidator creates a new MsgRemoveWhitelistedValidator.
+func NewMsgRemoveWhitelistedValidator(
+	authority sdk.AccAddress, //nolint: interfacer
+	validator sdk.ValAddress,
+) *MsgRemoveWhitelistedValidator {
+	return &MsgRemoveWhitelistedValidator{
+		Authority:        authority.String(),
+		ValidatorAddress: validator.String(),
+	}
+}
+
+func (msg MsgRemoveWhitelistedValidator) Route() string { return RouterKey }
+
+func (msg MsgRemoveWhitelistedValidator) Type() string { return TypeMsgRemoveWhitelistedValidator }
+
+func (msg MsgRemoveWhitelistedValidator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", msg.Authority, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address %q: %v", msg.ValidatorAddress, err)
+	}
+	return nil
+}
+
+func (msg MsgRemoveWhitelistedValidator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRemoveWhitelistedValidator) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgUpdateValidatorWeight creates a new MsgUpdateValidatorWeight.
+func NewMsgUpdateValidatorWeight(
+	authority sdk.AccAddress, //nolint: interfacer
+	validator sdk.ValAddress,
+	targetWeight math.Int,
+) *MsgUpdateValidatorWeight {
+	return &MsgUpdateValidatorWeight{
+		Authority:        authority.String(),
+		ValidatorAddress: validator.String(),
+		TargetWeight:     targetWeight,
+	}
+}
+
+func (msg MsgUpdateValidatorWeight) Route() string { return RouterKey }
+
+func (msg MsgUpdateValidatorWeight) Type() string { return TypeMsgUpdateValidatorWeight }
+
+func (msg MsgUpdateValidatorWeight) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", msg.Authority, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address %q: %v", msg.ValidatorAddress, err)
+	}
+	return validateTargetWeight(msg.TargetWeight)
+}
+
+func (msg MsgUpdateValidatorWeight) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateValidatorWeight) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func validateTargetWeight(targetWeight math.Int) error {
+	if targetWeight.IsNil() || !targetWeight.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidTargetWeight, "target weight must be positive: %s", targetWeight)
+	}
+	return nil
+}
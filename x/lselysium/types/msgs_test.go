@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto"
 
@@ -89,3 +90,234 @@ func TestMsgLiquidUnstake(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgStakeToLiquid(t *testing.T) {
+	delegatorAddr := sdk.AccAddress(crypto.AddressHash([]byte("delegatorAddr")))
+	valAddr := sdk.ValAddress(crypto.AddressHash([]byte("validator")))
+	stakingCoin := sdk.NewCoin("token", sdk.NewInt(1))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgStakeToLiquid
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgStakeToLiquid(delegatorAddr, valAddr, stakingCoin),
+		},
+		{
+			"invalid delegator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgStakeToLiquid(sdk.AccAddress{}, valAddr, stakingCoin),
+		},
+		{
+			"invalid validator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgStakeToLiquid(delegatorAddr, sdk.ValAddress{}, stakingCoin),
+		},
+		{
+			"staking amount must not be zero: invalid request",
+			types.NewMsgStakeToLiquid(delegatorAddr, valAddr, sdk.NewCoin("token", sdk.NewInt(0))),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgStakeToLiquid{}, tc.msg)
+		require.Equal(t, types.TypeMsgStakeToLiquid, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetDelegator(), signers[0])
+			require.Equal(t, valAddr, tc.msg.GetValidator())
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgPooledLiquidUnstake(t *testing.T) {
+	delegatorAddr := sdk.AccAddress(crypto.AddressHash([]byte("delegatorAddr")))
+	stakingCoin := sdk.NewCoin("btoken", sdk.NewInt(1))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgPooledLiquidUnstake
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgPooledLiquidUnstake(delegatorAddr, stakingCoin),
+		},
+		{
+			"invalid delegator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgPooledLiquidUnstake(sdk.AccAddress{}, stakingCoin),
+		},
+		{
+			"unstaking amount must not be zero: invalid request",
+			types.NewMsgPooledLiquidUnstake(delegatorAddr, sdk.NewCoin("btoken", sdk.NewInt(0))),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgPooledLiquidUnstake{}, tc.msg)
+		require.Equal(t, types.TypeMsgPooledLiquidUnstake, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetDelegator(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgClaimUnbonded(t *testing.T) {
+	delegatorAddr := sdk.AccAddress(crypto.AddressHash([]byte("delegatorAddr")))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgClaimUnbonded
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgClaimUnbonded(delegatorAddr),
+		},
+		{
+			"invalid delegator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgClaimUnbonded(sdk.AccAddress{}),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgClaimUnbonded{}, tc.msg)
+		require.Equal(t, types.TypeMsgClaimUnbonded, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetDelegator(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgInstantLiquidUnstake(t *testing.T) {
+	delegatorAddr := sdk.AccAddress(crypto.AddressHash([]byte("delegatorAddr")))
+	stakingCoin := sdk.NewCoin("btoken", sdk.NewInt(1))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgInstantLiquidUnstake
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgInstantLiquidUnstake(delegatorAddr, stakingCoin),
+		},
+		{
+			"invalid delegator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgInstantLiquidUnstake(sdk.AccAddress{}, stakingCoin),
+		},
+		{
+			"unstaking amount must not be zero: invalid request",
+			types.NewMsgInstantLiquidUnstake(delegatorAddr, sdk.NewCoin("btoken", sdk.NewInt(0))),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgInstantLiquidUnstake{}, tc.msg)
+		require.Equal(t, types.TypeMsgInstantLiquidUnstake, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetDelegator(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgWhitelistManagement(t *testing.T) {
+	authority := sdk.AccAddress(crypto.AddressHash([]byte("authority")))
+	valAddr := sdk.ValAddress(crypto.AddressHash([]byte("validator")))
+
+	testCases := []struct {
+		expectedErr string
+		msg         sdk.Msg
+		msgType     string
+	}{
+		{
+			"",
+			types.NewMsgAddWhitelistedValidator(authority, valAddr, sdk.NewInt(10)),
+			types.TypeMsgAddWhitelistedValidator,
+		},
+		{
+			"invalid authority address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgAddWhitelistedValidator(sdk.AccAddress{}, valAddr, sdk.NewInt(10)),
+			types.TypeMsgAddWhitelistedValidator,
+		},
+		{
+			"invalid validator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgAddWhitelistedValidator(authority, sdk.ValAddress{}, sdk.NewInt(10)),
+			types.TypeMsgAddWhitelistedValidator,
+		},
+		{
+			"target weight must be positive: 0: invalid target weight",
+			types.NewMsgAddWhitelistedValidator(authority, valAddr, sdk.ZeroInt()),
+			types.TypeMsgAddWhitelistedValidator,
+		},
+		{
+			"",
+			types.NewMsgRemoveWhitelistedValidator(authority, valAddr),
+			types.TypeMsgRemoveWhitelistedValidator,
+		},
+		{
+			"invalid validator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgRemoveWhitelistedValidator(authority, sdk.ValAddress{}),
+			types.TypeMsgRemoveWhitelistedValidator,
+		},
+		{
+			"",
+			types.NewMsgUpdateValidatorWeight(authority, valAddr, sdk.NewInt(10)),
+			types.TypeMsgUpdateValidatorWeight,
+		},
+		{
+			"target weight must be positive: -1: invalid target weight",
+			types.NewMsgUpdateValidatorWeight(authority, valAddr, sdk.NewInt(-1)),
+			types.TypeMsgUpdateValidatorWeight,
+		},
+	}
+
+	for _, tc := range testCases {
+		legacyMsg, ok := tc.msg.(legacytx.LegacyMsg)
+		require.True(t, ok)
+		require.Equal(t, tc.msgType, legacyMsg.Type())
+		require.Equal(t, types.RouterKey, legacyMsg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), legacyMsg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, authority, signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
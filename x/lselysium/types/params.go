@@ -13,10 +13,25 @@ import (
 
 // Parameter store keys
 var (
-	KeyLiquidBondDenom        = []byte("LiquidBondDenom")
-	KeyWhitelistedValidators  = []byte("WhitelistedValidators")
-	KeyUnstakeFeeRate         = []byte("UnstakeFeeRate")
-	KeyMinLiquidStakingAmount = []byte("MinLiquidStakingAmount")
+	KeyLiquidBondDenom          = []byte("LiquidBondDenom")
+	KeyWhitelistedValidators    = []byte("WhitelistedValidators")
+	KeyUnstakeFeeRate           = []byte("UnstakeFeeRate")
+	KeyMinLiquidStakingAmount   = []byte("MinLiquidStakingAmount")
+	KeyRebalancingTrigger       = []byte("RebalancingTrigger")
+	KeyRewardTrigger            = []byte("RewardTrigger")
+	KeyMaxRedelegationsPerBlock = []byte("MaxRedelegationsPerBlock")
+	KeyRebalancingFrequency     = []byte("RebalancingFrequency")
+	KeyRewardFeeRate            = []byte("RewardFeeRate")
+	KeyFeeAccountAddress        = []byte("FeeAccountAddress")
+	KeyMaxWhitelistedValidators = []byte("MaxWhitelistedValidators")
+	KeyMaxTargetWeight          = []byte("MaxTargetWeight")
+	KeyWeightingMode            = []byte("WeightingMode")
+	KeyMinPerformanceFactor     = []byte("MinPerformanceFactor")
+	KeyMaxPerformanceFactor     = []byte("MaxPerformanceFactor")
+	KeyUpdateFrequency          = []byte("UpdateFrequency")
+	KeyMaxUnbondsPerBlock       = []byte("MaxUnbondsPerBlock")
+	KeyInstantUnstakeFeeRate    = []byte("InstantUnstakeFeeRate")
+	KeyInstantReserveRate       = []byte("InstantReserveRate")
 
 	DefaultLiquidBondDenom = "bstake"
 
@@ -26,16 +41,63 @@ var (
 	// DefaultMinLiquidStakingAmount is the default minimum liquid staking amount.
 	DefaultMinLiquidStakingAmount = sdk.NewInt(1000000)
 
-	// Const variables
+	// DefaultRebalancingTrigger if the maximum difference and needed each redelegation amount exceeds it, asset rebalacing will be executed.
+	DefaultRebalancingTrigger = sdk.NewDecWithPrec(1, 3) // "0.001000000000000000"
+
+	// DefaultRewardTrigger If the sum of balance and the upcoming rewards of LiquidStakingProxyAcc exceeds it, the reward is automatically withdrawn and re-stake according to the weights.
+	DefaultRewardTrigger = sdk.NewDecWithPrec(1, 3) // "0.001000000000000000"
+
+	// DefaultMaxRedelegationsPerBlock is the default maximum number of redelegations tried by a single rebalancing.
+	DefaultMaxRedelegationsPerBlock = uint32(20)
+
+	// DefaultRebalancingFrequency is the default number of blocks between two asset rebalancings, every liquid
+	// validator set update.
+	DefaultRebalancingFrequency = uint64(1)
+
+	// DefaultRewardFeeRate is the default Reward Fee Rate, no fee is taken from the auto-compounded rewards.
+	DefaultRewardFeeRate = sdk.ZeroDec()
+
+	// DefaultFeeAccountAddress is the default fee account address, it must be set for a positive RewardFeeRate.
+	DefaultFeeAccountAddress = ""
+
+	// DefaultMaxWhitelistedValidators is the default maximum number of whitelisted validators.
+	DefaultMaxWhitelistedValidators = uint32(50)
+
+	// DefaultMaxTargetWeight is the default maximum target weight of a whitelisted validator.
+	DefaultMaxTargetWeight = sdk.NewInt(10000)
+
+	// DefaultWeightingMode is the default weighting mode, the target weights are applied as they are.
+	DefaultWeightingMode = WeightingModeStatic
+
+	// DefaultMinPerformanceFactor is the default floor of the performance factor.
+	DefaultMinPerformanceFactor = sdk.NewDecWithPrec(1, 1) // "0.100000000000000000"
+
+	// DefaultMaxPerformanceFactor is the default ceiling of the performance factor.
+	DefaultMaxPerformanceFactor = sdk.OneDec() // "1.000000000000000000"
+
+	// DefaultUpdateFrequency is the default number of blocks between two liquid validator set updates, every block.
+	DefaultUpdateFrequency = uint64(1)
+
+	// DefaultMaxUnbondsPerBlock is the default maximum number of inactive liquid validators unbonded by a single update.
+	DefaultMaxUnbondsPerBlock = uint32(10)
 
-	// RebalancingTrigger if the maximum difference and needed each redelegation amount exceeds it, asset rebalacing will be executed.
-	RebalancingTrigger = sdk.NewDecWithPrec(1, 3) // "0.001000000000000000"
+	// DefaultInstantUnstakeFeeRate is the default fee rate of the instant liquid unstaking.
+	DefaultInstantUnstakeFeeRate = sdk.NewDecWithPrec(5, 3) // "0.005000000000000000"
 
-	// RewardTrigger If the sum of balance and the upcoming rewards of LiquidStakingProxyAcc exceeds it, the reward is automatically withdrawn and re-stake according to the weights.
-	RewardTrigger = sdk.NewDecWithPrec(1, 3) // "0.001000000000000000"
+	// DefaultInstantReserveRate is the default fraction of the NetAmount available to the instant liquid unstaking, disabled.
+	DefaultInstantReserveRate = sdk.ZeroDec()
+
+	// Const variables
+
+	// PerformanceWeightPrecision scales the target weights in performance weighting mode before they are multiplied
+	// by the performance factor, so that the truncation keeps the precision of the factor.
+	PerformanceWeightPrecision = sdk.NewInt(1000000)
 
 	// LiquidStakingProxyAcc is a proxy reserve account for delegation and undelegation.
 	LiquidStakingProxyAcc = authtypes.NewModuleAddress(ModuleName + "-LiquidStakingProxyAcc")
+
+	// UnbondingPoolAcc is the account holding the pooled liquid unstakings until they are claimed.
+	UnbondingPoolAcc = authtypes.NewModuleAddress(ModuleName + "-UnbondingPoolAcc")
 )
 
 var _ paramstypes.ParamSet = (*Params)(nil)
@@ -48,10 +110,25 @@ func ParamKeyTable() paramstypes.KeyTable {
 // DefaultParams returns the default liquidstaking module parameters.
 func DefaultParams() Params {
 	return Params{
-		WhitelistedValidators:  []WhitelistedValidator{},
-		LiquidBondDenom:        DefaultLiquidBondDenom,
-		UnstakeFeeRate:         DefaultUnstakeFeeRate,
-		MinLiquidStakingAmount: DefaultMinLiquidStakingAmount,
+		WhitelistedValidators:    []WhitelistedValidator{},
+		LiquidBondDenom:          DefaultLiquidBondDenom,
+		UnstakeFeeRate:           DefaultUnstakeFeeRate,
+		MinLiquidStakingAmount:   DefaultMinLiquidStakingAmount,
+		RebalancingTrigger:       DefaultRebalancingTrigger,
+		RewardTrigger:            DefaultRewardTrigger,
+		MaxRedelegationsPerBlock: DefaultMaxRedelegationsPerBlock,
+		RebalancingFrequency:     DefaultRebalancingFrequency,
+		RewardFeeRate:            DefaultRewardFeeRate,
+		FeeAccountAddress:        DefaultFeeAccountAddress,
+		MaxWhitelistedValidators: DefaultMaxWhitelistedValidators,
+		MaxTargetWeight:          DefaultMaxTargetWeight,
+		WeightingMode:            DefaultWeightingMode,
+		MinPerformanceFactor:     DefaultMinPerformanceFactor,
+		MaxPerformanceFactor:     DefaultMaxPerformanceFactor,
+		UpdateFrequency:          DefaultUpdateFrequency,
+		MaxUnbondsPerBlock:       DefaultMaxUnbondsPerBlock,
+		InstantUnstakeFeeRate:    DefaultInstantUnstakeFeeRate,
+		InstantReserveRate:       DefaultInstantReserveRate,
 	}
 }
 
@@ -62,6 +139,21 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyWhitelistedValidators, &p.WhitelistedValidators, validateWhitelistedValidators),
 		paramstypes.NewParamSetPair(KeyUnstakeFeeRate, &p.UnstakeFeeRate, validateUnstakeFeeRate),
 		paramstypes.NewParamSetPair(KeyMinLiquidStakingAmount, &p.MinLiquidStakingAmount, validateMinLiquidStakingAmount),
+		paramstypes.NewParamSetPair(KeyRebalancingTrigger, &p.RebalancingTrigger, validateRebalancingTrigger),
+		paramstypes.NewParamSetPair(KeyRewardTrigger, &p.RewardTrigger, validateRewardTrigger),
+		paramstypes.NewParamSetPair(KeyMaxRedelegationsPerBlock, &p.MaxRedelegationsPerBlock, validateMaxRedelegationsPerBlock),
+		paramstypes.NewParamSetPair(KeyRebalancingFrequency, &p.RebalancingFrequency, validateRebalancingFrequency),
+		paramstypes.NewParamSetPair(KeyRewardFeeRate, &p.RewardFeeRate, validateRewardFeeRate),
+		paramstypes.NewParamSetPair(KeyFeeAccountAddress, &p.FeeAccountAddress, validateFeeAccountAddress),
+		paramstypes.NewParamSetPair(KeyMaxWhitelistedValidators, &p.MaxWhitelistedValidators, validateMaxWhitelistedValidators),
+		paramstypes.NewParamSetPair(KeyMaxTargetWeight, &p.MaxTargetWeight, validateMaxTargetWeight),
+		paramstypes.NewParamSetPair(KeyWeightingMode, &p.WeightingMode, validateWeightingMode),
+		paramstypes.NewParamSetPair(KeyMinPerformanceFactor, &p.MinPerformanceFactor, validatePerformanceFactor),
+		paramstypes.NewParamSetPair(KeyMaxPerformanceFactor, &p.MaxPerformanceFactor, validatePerformanceFactor),
+		paramstypes.NewParamSetPair(KeyUpdateFrequency, &p.UpdateFrequency, validateUpdateFrequency),
+		paramstypes.NewParamSetPair(KeyMaxUnbondsPerBlock, &p.MaxUnbondsPerBlock, validateMaxUnbondsPerBlock),
+		paramstypes.NewParamSetPair(KeyInstantUnstakeFeeRate, &p.InstantUnstakeFeeRate, validateInstantUnstakeFeeRate),
+		paramstypes.NewParamSetPair(KeyInstantReserveRate, &p.InstantReserveRate, validateInstantReserveRate),
 	}
 }
 
@@ -85,11 +177,45 @@ func (p Params) Validate() error {
 		{p.WhitelistedValidators, validateWhitelistedValidators},
 		{p.UnstakeFeeRate, validateUnstakeFeeRate},
 		{p.MinLiquidStakingAmount, validateMinLiquidStakingAmount},
+		{p.RebalancingTrigger, validateRebalancingTrigger},
+		{p.RewardTrigger, validateRewardTrigger},
+		{p.MaxRedelegationsPerBlock, validateMaxRedelegationsPerBlock},
+		{p.RebalancingFrequency, validateRebalancingFrequency},
+		{p.RewardFeeRate, validateRewardFeeRate},
+		{p.FeeAccountAddress, validateFeeAccountAddress},
+		{p.MaxWhitelistedValidators, validateMaxWhitelistedValidators},
+		{p.MaxTargetWeight, validateMaxTargetWeight},
+		{p.WeightingMode, validateWeightingMode},
+		{p.MinPerformanceFactor, validatePerformanceFactor},
+		{p.MaxPerformanceFactor, validatePerformanceFactor},
+		{p.UpdateFrequency, validateUpdateFrequency},
+		{p.MaxUnbondsPerBlock, validateMaxUnbondsPerBlock},
+		{p.InstantUnstakeFeeRate, validateInstantUnstakeFeeRate},
+		{p.InstantReserveRate, validateInstantReserveRate},
 	} {
 		if err := v.validator(v.value); err != nil {
 			return err
 		}
 	}
+	if p.RewardFeeRate.IsPositive() && p.FeeAccountAddress == "" {
+		return fmt.Errorf("fee account address must be set for positive reward fee rate: %s", p.RewardFeeRate)
+	}
+	if p.MinPerformanceFactor.GT(p.MaxPerformanceFactor) {
+		return fmt.Errorf("min performance factor %s must not exceed max performance factor %s", p.MinPerformanceFactor, p.MaxPerformanceFactor)
+	}
+	return p.ValidateWhitelistLimits()
+}
+
+// ValidateWhitelistLimits validates the whitelisted validators against MaxWhitelistedValidators and MaxTargetWeight.
+func (p Params) ValidateWhitelistLimits() error {
+	if len(p.WhitelistedValidators) > int(p.MaxWhitelistedValidators) {
+		return fmt.Errorf("whitelisted validators exceed max whitelisted validators %d: %d", p.MaxWhitelistedValidators, len(p.WhitelistedValidators))
+	}
+	for _, wv := range p.WhitelistedValidators {
+		if wv.TargetWeight.GT(p.MaxTargetWeight) {
+			return fmt.Errorf("liquidstaking validator target weight exceeds max target weight %s: %s", p.MaxTargetWeight, wv.TargetWeight)
+		}
+	}
 	return nil
 }
 
@@ -175,3 +301,241 @@ func validateMinLiquidStakingAmount(i interface{}) error {
 
 	return nil
 }
+
+func validateRebalancingTrigger(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("rebalancing trigger must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("rebalancing trigger must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("rebalancing trigger too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateRewardTrigger(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("reward trigger must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("reward trigger must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("reward trigger too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxRedelegationsPerBlock(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max redelegations per block must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateRebalancingFrequency(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("rebalancing frequency must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateRewardFeeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("reward fee rate must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("reward fee rate must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("reward fee rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateFeeAccountAddress(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid fee account address %s: %w", v, err)
+	}
+
+	return nil
+}
+
+func validateMaxWhitelistedValidators(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max whitelisted validators must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxTargetWeight(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("max target weight must not be nil")
+	}
+
+	if !v.IsPositive() {
+		return fmt.Errorf("max target weight must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateWeightingMode(i interface{}) error {
+	v, ok := i.(WeightingMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := WeightingMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid weighting mode: %d", v)
+	}
+
+	return nil
+}
+
+func validatePerformanceFactor(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("performance factor must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("performance factor must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("performance factor too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateUpdateFrequency(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("update frequency must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxUnbondsPerBlock(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max unbonds per block must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateInstantUnstakeFeeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("instant unstake fee rate must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("instant unstake fee rate must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("instant unstake fee rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateInstantReserveRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("instant reserve rate must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("instant reserve rate must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("instant reserve rate too large: %s", v)
+	}
+
+	return nil
+}
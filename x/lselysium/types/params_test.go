@@ -8,6 +8,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/merlin-network/estake-native/v2/x/lselysium/types"
 )
@@ -21,6 +22,21 @@ func TestParams(t *testing.T) {
 whitelisted_validators: []
 unstake_fee_rate: "0.001000000000000000"
 min_liquid_staking_amount: "1000000"
+rebalancing_trigger: "0.001000000000000000"
+reward_trigger: "0.001000000000000000"
+max_redelegations_per_block: 20
+rebalancing_frequency: 1
+reward_fee_rate: "0.000000000000000000"
+fee_account_address: ""
+max_whitelisted_validators: 50
+max_target_weight: "10000"
+weighting_mode: 0
+min_performance_factor: "0.100000000000000000"
+max_performance_factor: "1.000000000000000000"
+update_frequency: 1
+max_unbonds_per_block: 10
+instant_unstake_fee_rate: "0.005000000000000000"
+instant_reserve_rate: "0.000000000000000000"
 `
 	require.Equal(t, paramsStr, params.String())
 
@@ -36,6 +52,21 @@ whitelisted_validators:
   target_weight: "10"
 unstake_fee_rate: "0.001000000000000000"
 min_liquid_staking_amount: "1000000"
+rebalancing_trigger: "0.001000000000000000"
+reward_trigger: "0.001000000000000000"
+max_redelegations_per_block: 20
+rebalancing_frequency: 1
+reward_fee_rate: "0.000000000000000000"
+fee_account_address: ""
+max_whitelisted_validators: 50
+max_target_weight: "10000"
+weighting_mode: 0
+min_performance_factor: "0.100000000000000000"
+max_performance_factor: "1.000000000000000000"
+update_frequency: 1
+max_unbonds_per_block: 10
+instant_unstake_fee_rate: "0.005000000000000000"
+instant_reserve_rate: "0.000000000000000000"
 `
 	require.Equal(t, paramsStr, params.String())
 }
@@ -184,6 +215,241 @@ func TestValidateWhitelistedValidators(t *testing.T) {
 			},
 			"min liquid staking amount must not be negative: -1",
 		},
+		{
+			"nil rebalancing trigger",
+			func(params *types.Params) {
+				params.RebalancingTrigger = sdk.Dec{}
+			},
+			"rebalancing trigger must not be nil",
+		},
+		{
+			"negative rebalancing trigger",
+			func(params *types.Params) {
+				params.RebalancingTrigger = sdk.NewDec(-1)
+			},
+			"rebalancing trigger must not be negative: -1.000000000000000000",
+		},
+		{
+			"too large rebalancing trigger",
+			func(params *types.Params) {
+				params.RebalancingTrigger = sdk.MustNewDecFromStr("1.0000001")
+			},
+			"rebalancing trigger too large: 1.000000100000000000",
+		},
+		{
+			"nil reward trigger",
+			func(params *types.Params) {
+				params.RewardTrigger = sdk.Dec{}
+			},
+			"reward trigger must not be nil",
+		},
+		{
+			"negative reward trigger",
+			func(params *types.Params) {
+				params.RewardTrigger = sdk.NewDec(-1)
+			},
+			"reward trigger must not be negative: -1.000000000000000000",
+		},
+		{
+			"too large reward trigger",
+			func(params *types.Params) {
+				params.RewardTrigger = sdk.MustNewDecFromStr("1.0000001")
+			},
+			"reward trigger too large: 1.000000100000000000",
+		},
+		{
+			"zero max redelegations per block",
+			func(params *types.Params) {
+				params.MaxRedelegationsPerBlock = 0
+			},
+			"max redelegations per block must be positive: 0",
+		},
+		{
+			"zero rebalancing frequency",
+			func(params *types.Params) {
+				params.RebalancingFrequency = 0
+			},
+			"rebalancing frequency must be positive: 0",
+		},
+		{
+			"nil reward fee rate",
+			func(params *types.Params) {
+				params.RewardFeeRate = sdk.Dec{}
+			},
+			"reward fee rate must not be nil",
+		},
+		{
+			"negative reward fee rate",
+			func(params *types.Params) {
+				params.RewardFeeRate = sdk.NewDec(-1)
+			},
+			"reward fee rate must not be negative: -1.000000000000000000",
+		},
+		{
+			"too large reward fee rate",
+			func(params *types.Params) {
+				params.RewardFeeRate = sdk.MustNewDecFromStr("1.0000001")
+			},
+			"reward fee rate too large: 1.000000100000000000",
+		},
+		{
+			"invalid fee account address",
+			func(params *types.Params) {
+				params.FeeAccountAddress = "invalid"
+			},
+			"invalid fee account address invalid: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			"positive reward fee rate without fee account address",
+			func(params *types.Params) {
+				params.RewardFeeRate = sdk.NewDecWithPrec(5, 2)
+			},
+			"fee account address must be set for positive reward fee rate: 0.050000000000000000",
+		},
+		{
+			"valid reward fee",
+			func(params *types.Params) {
+				params.RewardFeeRate = sdk.NewDecWithPrec(5, 2)
+				params.FeeAccountAddress = sdk.AccAddress(crypto.AddressHash([]byte("feeAccount"))).String()
+			},
+			"",
+		},
+		{
+			"zero max whitelisted validators",
+			func(params *types.Params) {
+				params.MaxWhitelistedValidators = 0
+			},
+			"max whitelisted validators must be positive: 0",
+		},
+		{
+			"nil max target weight",
+			func(params *types.Params) {
+				params.MaxTargetWeight = math.Int{}
+			},
+			"max target weight must not be nil",
+		},
+		{
+			"zero max target weight",
+			func(params *types.Params) {
+				params.MaxTargetWeight = sdk.ZeroInt()
+			},
+			"max target weight must be positive: 0",
+		},
+		{
+			"too many whitelisted validators",
+			func(params *types.Params) {
+				params.MaxWhitelistedValidators = 1
+				params.WhitelistedValidators = []types.WhitelistedValidator{
+					{
+						ValidatorAddress: sdk.ValAddress(crypto.AddressHash([]byte("val1"))).String(),
+						TargetWeight:     sdk.NewInt(10),
+					},
+					{
+						ValidatorAddress: sdk.ValAddress(crypto.AddressHash([]byte("val2"))).String(),
+						TargetWeight:     sdk.NewInt(10),
+					},
+				}
+			},
+			"whitelisted validators exceed max whitelisted validators 1: 2",
+		},
+		{
+			"too large target weight",
+			func(params *types.Params) {
+				params.MaxTargetWeight = sdk.NewInt(1)
+				params.WhitelistedValidators = []types.WhitelistedValidator{
+					{
+						ValidatorAddress: "did:fury:evaloper19rz0gtqf88vwk6dwz522ajpqpv5swunqm9z90m",
+						TargetWeight:     sdk.NewInt(2),
+					},
+				}
+			},
+			"liquidstaking validator target weight exceeds max target weight 1: 2",
+		},
+		{
+			"invalid weighting mode",
+			func(params *types.Params) {
+				params.WeightingMode = 2
+			},
+			"invalid weighting mode: 2",
+		},
+		{
+			"nil performance factor",
+			func(params *types.Params) {
+				params.MinPerformanceFactor = sdk.Dec{}
+			},
+			"performance factor must not be nil",
+		},
+		{
+			"negative performance factor",
+			func(params *types.Params) {
+				params.MinPerformanceFactor = sdk.NewDec(-1)
+			},
+			"performance factor must not be negative: -1.000000000000000000",
+		},
+		{
+			"too large performance factor",
+			func(params *types.Params) {
+				params.MaxPerformanceFactor = sdk.MustNewDecFromStr("1.0000001")
+			},
+			"performance factor too large: 1.000000100000000000",
+		},
+		{
+			"min performance factor exceeds max performance factor",
+			func(params *types.Params) {
+				params.MinPerformanceFactor = sdk.NewDecWithPrec(5, 1)
+				params.MaxPerformanceFactor = sdk.NewDecWithPrec(4, 1)
+			},
+			"min performance factor 0.500000000000000000 must not exceed max performance factor 0.400000000000000000",
+		},
+		{
+			"valid performance weighting mode",
+			func(params *types.Params) {
+				params.WeightingMode = types.WeightingModePerformance
+			},
+			"",
+		},
+		{
+			"zero update frequency",
+			func(params *types.Params) {
+				params.UpdateFrequency = 0
+			},
+			"update frequency must be positive: 0",
+		},
+		{
+			"zero max unbonds per block",
+			func(params *types.Params) {
+				params.MaxUnbondsPerBlock = 0
+			},
+			"max unbonds per block must be positive: 0",
+		},
+		{
+			"negative instant unstake fee rate",
+			func(params *types.Params) {
+				params.InstantUnstakeFeeRate = sdk.NewDec(-1)
+			},
+			"instant unstake fee rate must not be negative: -1.000000000000000000",
+		},
+		{
+			"too large instant unstake fee rate",
+			func(params *types.Params) {
+				params.InstantUnstakeFeeRate = sdk.MustNewDecFromStr("1.0000001")
+			},
+			"instant unstake fee rate too large: 1.000000100000000000",
+		},
+		{
+			"nil instant reserve rate",
+			func(params *types.Params) {
+				params.InstantReserveRate = sdk.Dec{}
+			},
+			"instant reserve rate must not be nil",
+		},
+		{
+			"too large instant reserve rate",
+			func(params *types.Params) {
+				params.InstantReserveRate = sdk.MustNewDecFromStr("1.0000001")
+			},
+			"instant reserve rate too large: 1.000000100000000000",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			params := types.DefaultParams()
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -37,7 +38,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_686f3882ea1eb1bb, []int{0}
+	return fileDescriptor_4018547f2e6619ac, []int{0}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -75,7 +76,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_686f3882ea1eb1bb, []int{1}
+	return fileDescriptor_4018547f2e6619ac, []int{1}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -119,7 +120,7 @@ func (m *QueryLiquidValidatorsRequest) Reset()         { *m = QueryLiquidValidat
 func (m *QueryLiquidValidatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLiquidValidatorsRequest) ProtoMessage()    {}
 func (*QueryLiquidValidatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_686f3882ea1eb1bb, []int{2}
+	return fileDescriptor_4018547f2e6619ac, []int{2}
 }
 func (m *QueryLiquidValidatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -157,7 +158,7 @@ func (m *QueryLiquidValidatorsResponse) Reset()         { *m = QueryLiquidValida
 func (m *QueryLiquidValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLiquidValidatorsResponse) ProtoMessage()    {}
 func (*QueryLiquidValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_686f3882ea1eb1bb, []int{3}
+	return fileDescriptor_4018547f2e6619ac, []int{3}
 }
 func (m *QueryLiquidValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -201,7 +202,7 @@ func (m *QueryStatesRequest) Reset()         { *m = QueryStatesRequest{} }
 func (m *QueryStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryStatesRequest) ProtoMessage()    {}
 func (*QueryStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_686f3882ea1eb1bb, []int{4}
+	return fileDescriptor_4018547f2e6619ac, []int{4}
 }
 func (m *QueryStatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -239,7 +240,7 @@ func (m *QueryStatesResponse) Reset()         { *m = QueryStatesResponse{} }
 func (m *QueryStatesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryStatesResponse) ProtoMessage()    {}
 func (*QueryStatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_686f3882ea1eb1bb, []int{5}
+	return fileDescriptor_4018547f2e6619ac, []int{5}
 }
 func (m *QueryStatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,6 +276,202 @@ func (m *QueryStatesResponse) GetNetAmountState() NetAmountState {
 	return NetAmountState{}
 }
 
+// QueryVotingPowerRequest is the request type for the Query/VotingPower RPC method.
+type QueryVotingPowerRequest struct {
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+}
+
+func (m *QueryVotingPowerRequest) Reset()         { *m = QueryVotingPowerRequest{} }
+func (m *QueryVotingPowerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingPowerRequest) ProtoMessage()    {}
+func (*QueryVotingPowerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4018547f2e6619ac, []int{6}
+}
+func (m *QueryVotingPowerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingPowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingPowerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowerRequest.Merge(m, src)
+}
+func (m *QueryVotingPowerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingPowerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingPowerRequest proto.InternalMessageInfo
+
+func (m *QueryVotingPowerRequest) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+// QueryVotingPowerResponse is the response type for the Query/VotingPower RPC method.
+type QueryVotingPowerResponse struct {
+	VotingPower VotingPower `protobuf:"bytes,1,opt,name=voting_power,json=votingPower,proto3" json:"voting_power"`
+}
+
+func (m *QueryVotingPowerResponse) Reset()         { *m = QueryVotingPowerResponse{} }
+func (m *QueryVotingPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingPowerResponse) ProtoMessage()    {}
+func (*QueryVotingPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4018547f2e6619ac, []int{7}
+}
+func (m *QueryVotingPowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowerResponse.Merge(m, src)
+}
+func (m *QueryVotingPowerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingPowerResponse proto.InternalMessageInfo
+
+func (m *QueryVotingPowerResponse) GetVotingPower() VotingPower {
+	if m != nil {
+		return m.VotingPower
+	}
+	return VotingPower{}
+}
+
+// QueryUnbondingRequestsRequest is the request type for the Query/UnbondingRequests RPC method.
+type QueryUnbondingRequestsRequest struct {
+	Staker     string             `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUnbondingRequestsRequest) Reset()         { *m = QueryUnbondingRequestsRequest{} }
+func (m *QueryUnbondingRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingRequestsRequest) ProtoMessage()    {}
+func (*QueryUnbondingRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4018547f2e6619ac, []int{8}
+}
+func (m *QueryUnbondingRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingRequestsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingRequestsRequest.Merge(m, src)
+}
+func (m *QueryUnbondingRequestsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingRequestsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingRequestsRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondingRequestsRequest) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *QueryUnbondingRequestsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryUnbondingRequestsResponse is the response type for the Query/UnbondingRequests RPC method.
+type QueryUnbondingRequestsResponse struct {
+	UnbondingRequests []UnbondingRequest  `protobuf:"bytes,1,rep,name=unbonding_requests,json=unbondingRequests,proto3" json:"unbonding_requests"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUnbondingRequestsResponse) Reset()         { *m = QueryUnbondingRequestsResponse{} }
+func (m *QueryUnbondingRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingRequestsResponse) ProtoMessage()    {}
+func (*QueryUnbondingRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4018547f2e6619ac, []int{9}
+}
+func (m *QueryUnbondingRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingRequestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingRequestsResponse.Merge(m, src)
+}
+func (m *QueryUnbondingRequestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingRequestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingRequestsResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingRequestsResponse) GetUnbondingRequests() []UnbondingRequest {
+	if m != nil {
+		return m.UnbondingRequests
+	}
+	return nil
+}
+
+func (m *QueryUnbondingRequestsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "estake.lselysium.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "estake.lselysium.v1beta1.QueryParamsResponse")
@@ -282,44 +479,63 @@ func init() {
 	proto.RegisterType((*QueryLiquidValidatorsResponse)(nil), "estake.lselysium.v1beta1.QueryLiquidValidatorsResponse")
 	proto.RegisterType((*QueryStatesRequest)(nil), "estake.lselysium.v1beta1.QueryStatesRequest")
 	proto.RegisterType((*QueryStatesResponse)(nil), "estake.lselysium.v1beta1.QueryStatesResponse")
+	proto.RegisterType((*QueryVotingPowerRequest)(nil), "estake.lselysium.v1beta1.QueryVotingPowerRequest")
+	proto.RegisterType((*QueryVotingPowerResponse)(nil), "estake.lselysium.v1beta1.QueryVotingPowerResponse")
+	proto.RegisterType((*QueryUnbondingRequestsRequest)(nil), "estake.lselysium.v1beta1.QueryUnbondingRequestsRequest")
+	proto.RegisterType((*QueryUnbondingRequestsResponse)(nil), "estake.lselysium.v1beta1.QueryUnbondingRequestsResponse")
 }
 
 func init() {
-	proto.RegisterFile("estake/lselysium/v1beta1/query.proto", fileDescriptor_686f3882ea1eb1bb)
-}
-
-var fileDescriptor_686f3882ea1eb1bb = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0x5a, 0x73, 0x98, 0x82, 0xc4, 0xb1, 0x87, 0x12, 0xe2, 0x5a, 0x42, 0x91, 0x20,
-	0x75, 0x27, 0x59, 0x6f, 0x7a, 0xd1, 0x9c, 0xc5, 0x1f, 0x15, 0x04, 0x8b, 0x10, 0x26, 0xed, 0x63,
-	0x1d, 0xdc, 0xcc, 0x6c, 0x76, 0xde, 0x06, 0x7b, 0x55, 0xf0, 0x2c, 0x78, 0xf4, 0x6f, 0xf1, 0xde,
-	0x63, 0xc1, 0x8b, 0x27, 0x91, 0xc4, 0x3f, 0x44, 0x32, 0x33, 0xae, 0xdd, 0x95, 0x2c, 0xab, 0xb7,
-	0xe5, 0xcd, 0xfb, 0xbe, 0xf7, 0x79, 0xdf, 0xf7, 0x58, 0x3a, 0x48, 0x0d, 0x8a, 0x37, 0xc0, 0x13,
-	0x93, 0x42, 0x66, 0xa4, 0x41, 0x50, 0xc7, 0xc0, 0x17, 0xa3, 0x29, 0xa0, 0x18, 0xf1, 0x79, 0x0e,
-	0xd9, 0x69, 0x98, 0x66, 0x1a, 0x35, 0xeb, 0xb9, 0xcc, 0xb0, 0x94, 0x19, 0xfa, 0xcc, 0x6e, 0x2f,
-	0xd6, 0x3a, 0x4e, 0x80, 0x8b, 0x54, 0x72, 0xa1, 0x94, 0x46, 0x81, 0x52, 0x2b, 0xe3, 0xb4, 0xdd,
-	0x61, 0x6d, 0x97, 0x44, 0xce, 0x73, 0x79, 0xb2, 0xce, 0x90, 0x2a, 0xf6, 0x8a, 0x9d, 0x58, 0xc7,
-	0xda, 0x7e, 0xf2, 0xf5, 0x97, 0x8b, 0xf6, 0x77, 0x28, 0x7b, 0xb6, 0x46, 0x7a, 0x2a, 0x32, 0x31,
-	0x33, 0x87, 0x30, 0xcf, 0xc1, 0x60, 0xff, 0x25, 0xbd, 0x5e, 0x8a, 0x9a, 0x54, 0x2b, 0x03, 0x6c,
-	0x4c, 0xdb, 0xa9, 0x8d, 0xec, 0x92, 0x3d, 0x32, 0xd8, 0x8e, 0xf6, 0xc3, 0xba, 0x09, 0x42, 0xa7,
-	0x1e, 0x6f, 0x9d, 0x7d, 0xbf, 0xd9, 0x3a, 0xf4, 0xca, 0x7e, 0x40, 0x7b, 0xb6, 0xf4, 0x23, 0x8b,
-	0xf8, 0x42, 0x24, 0xf2, 0x44, 0xa0, 0xce, 0x8a, 0xd6, 0x1f, 0x08, 0xbd, 0xb1, 0x21, 0xc1, 0x53,
-	0x00, 0xbd, 0xe6, 0xe6, 0x9b, 0x2c, 0x8a, 0xc7, 0x5d, 0xb2, 0x77, 0x79, 0xb0, 0x1d, 0x45, 0xf5,
-	0x40, 0x95, 0x92, 0xcf, 0x51, 0x20, 0x78, 0xbc, 0x4e, 0x52, 0x69, 0x57, 0x38, 0x63, 0xb3, 0x0a,
-	0x3c, 0xe3, 0x9d, 0xf9, 0x1d, 0xf5, 0x4c, 0xaf, 0x68, 0x47, 0x01, 0x4e, 0xc4, 0x4c, 0xe7, 0x0a,
-	0x27, 0x66, 0xfd, 0xe8, 0x3d, 0x3a, 0xa8, 0x47, 0x7a, 0x0c, 0xf8, 0xd0, 0x8a, 0x2e, 0xc2, 0x5c,
-	0x55, 0xa5, 0x68, 0xf4, 0x7e, 0x8b, 0x5e, 0xb1, 0x5d, 0xd9, 0x67, 0x42, 0xdb, 0xce, 0x56, 0x36,
-	0xac, 0x2f, 0xfc, 0xf7, 0x56, 0xbb, 0xa3, 0x7f, 0x50, 0xb8, 0xb9, 0xfa, 0x07, 0xef, 0xbe, 0xfe,
-	0xfc, 0x74, 0xe9, 0x16, 0xdb, 0xe7, 0xb5, 0xf7, 0xe6, 0x76, 0xcb, 0xbe, 0x10, 0xda, 0xa9, 0xae,
-	0x8d, 0xdd, 0x6b, 0xd0, 0x75, 0xc3, 0x31, 0x74, 0xef, 0xff, 0x97, 0xd6, 0xb3, 0x0f, 0x2d, 0xfb,
-	0x6d, 0x36, 0xa8, 0x67, 0xff, 0x73, 0x44, 0xd6, 0x5d, 0xb7, 0xd8, 0x46, 0xee, 0x96, 0x2e, 0xa3,
-	0x91, 0xbb, 0xe5, 0xab, 0x69, 0xea, 0xae, 0x3d, 0x27, 0x33, 0x3e, 0x3a, 0x5b, 0x06, 0xe4, 0x7c,
-	0x19, 0x90, 0x1f, 0xcb, 0x80, 0x7c, 0x5c, 0x05, 0xad, 0xf3, 0x55, 0xd0, 0xfa, 0xb6, 0x0a, 0x5a,
-	0x47, 0x0f, 0x62, 0x89, 0xaf, 0xf3, 0x69, 0x78, 0xac, 0x67, 0xfc, 0x42, 0x81, 0x27, 0x0a, 0x7c,
-	0xe1, 0x3b, 0x4a, 0xa0, 0x5c, 0x00, 0x5f, 0x44, 0xfc, 0x6d, 0xa5, 0x09, 0x9e, 0xa6, 0x60, 0xa6,
-	0x6d, 0xfb, 0x37, 0xb8, 0xfb, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x46, 0x87, 0xb0, 0x44, 0xbd, 0x04,
-	0x00, 0x00,
+	proto.RegisterFile("estake/lselysium/v1beta1/query.proto", fileDescriptor_4018547f2e6619ac)
+}
+
+var fileDescriptor_4018547f2e6619ac = []byte{
+	// 708 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x4f, 0x6b, 0x13, 0x41,
+	0x18, 0xc6, 0xb3, 0xd5, 0x06, 0x9c, 0x88, 0xb4, 0xd3, 0xa2, 0x61, 0xa9, 0x6b, 0x58, 0x6a, 0x0d,
+	0xa5, 0xd9, 0xb1, 0x11, 0x5b, 0x0f, 0x2a, 0xd8, 0x83, 0x5e, 0xa4, 0xd4, 0x48, 0x8b, 0x78, 0x09,
+	0x93, 0x76, 0x58, 0xc7, 0x6e, 0x66, 0xb6, 0x3b, 0xb3, 0x5b, 0x4b, 0x29, 0x42, 0xbf, 0x80, 0x82,
+	0x5f, 0xc3, 0x8b, 0x1f, 0x42, 0x28, 0x9e, 0x0a, 0x82, 0x78, 0x12, 0x69, 0xfd, 0x20, 0x92, 0x99,
+	0x69, 0xb2, 0x49, 0x1c, 0xd3, 0xde, 0x92, 0x77, 0xde, 0x3f, 0xbf, 0xe7, 0xcd, 0x3c, 0x13, 0x30,
+	0x4b, 0x84, 0xc4, 0xdb, 0x04, 0x45, 0x82, 0x44, 0x7b, 0x82, 0xa6, 0x6d, 0x94, 0x2d, 0xb6, 0x88,
+	0xc4, 0x8b, 0x68, 0x27, 0x25, 0xc9, 0x5e, 0x10, 0x27, 0x5c, 0x72, 0x58, 0xd6, 0x59, 0x41, 0x37,
+	0x2b, 0x30, 0x59, 0xee, 0x4c, 0xc8, 0x79, 0x18, 0x11, 0x84, 0x63, 0x8a, 0x30, 0x63, 0x5c, 0x62,
+	0x49, 0x39, 0x13, 0xba, 0xce, 0x5d, 0xb0, 0x76, 0x8f, 0xe8, 0x4e, 0x4a, 0xb7, 0x3a, 0xa7, 0x94,
+	0x85, 0x26, 0x7b, 0x3a, 0xe4, 0x21, 0x57, 0x1f, 0x51, 0xe7, 0x93, 0x89, 0xce, 0x6f, 0x72, 0xd1,
+	0xe6, 0x02, 0xb5, 0xb0, 0x20, 0x1a, 0xaa, 0xdb, 0x24, 0xc6, 0x21, 0x65, 0x6a, 0xa0, 0xce, 0xf5,
+	0xa7, 0x01, 0x7c, 0xd1, 0xc9, 0x58, 0xc3, 0x09, 0x6e, 0x8b, 0x06, 0xd9, 0x49, 0x89, 0x90, 0xfe,
+	0x3a, 0x98, 0xea, 0x8b, 0x8a, 0x98, 0x33, 0x41, 0xe0, 0x63, 0x50, 0x8c, 0x55, 0xa4, 0xec, 0x54,
+	0x9c, 0x6a, 0xa9, 0x5e, 0x09, 0x6c, 0x2a, 0x03, 0x5d, 0xb9, 0x72, 0xf9, 0xe8, 0xd7, 0xad, 0x42,
+	0xc3, 0x54, 0xf9, 0x1e, 0x98, 0x51, 0x6d, 0x9f, 0x2b, 0x29, 0x1b, 0x38, 0xa2, 0x5b, 0x58, 0xf2,
+	0xa4, 0x3b, 0xf6, 0xd0, 0x01, 0x37, 0x2d, 0x09, 0x86, 0x00, 0x83, 0x49, 0xbd, 0x87, 0x66, 0xd6,
+	0x3d, 0x2c, 0x3b, 0x95, 0x4b, 0xd5, 0x52, 0x3d, 0xb0, 0xc3, 0x0c, 0xb4, 0x7b, 0x29, 0xb1, 0x24,
+	0x06, 0x6d, 0x22, 0x1a, 0x18, 0xd5, 0xdd, 0x88, 0xca, 0xea, 0xa2, 0x71, 0x30, 0xd5, 0x17, 0x35,
+	0x3c, 0xaf, 0xc0, 0x04, 0x23, 0xb2, 0x89, 0xdb, 0x3c, 0x65, 0xb2, 0x29, 0x3a, 0x87, 0x66, 0x37,
+	0x55, 0x3b, 0xce, 0x2a, 0x91, 0x4f, 0x54, 0x41, 0x1e, 0xe4, 0x1a, 0xeb, 0x8b, 0xfa, 0x08, 0xdc,
+	0x50, 0x03, 0x37, 0xb8, 0xa4, 0x2c, 0x5c, 0xe3, 0xbb, 0x24, 0x31, 0x2c, 0x70, 0x1a, 0x8c, 0x67,
+	0x5c, 0x92, 0x44, 0x4d, 0xba, 0xd2, 0xd0, 0x5f, 0xfc, 0xb7, 0xa0, 0x3c, 0x5c, 0x60, 0x30, 0x57,
+	0xc1, 0xd5, 0x4c, 0x85, 0x9b, 0x31, 0xdf, 0x35, 0x85, 0xa5, 0xfa, 0x6d, 0x3b, 0x62, 0xae, 0x89,
+	0xe1, 0x2b, 0x65, 0xbd, 0x90, 0xff, 0xde, 0xfc, 0x4e, 0xeb, 0xac, 0xc5, 0xd9, 0x16, 0x65, 0xa1,
+	0x41, 0x3b, 0x5b, 0x17, 0xbc, 0x0e, 0x8a, 0xaa, 0xf5, 0x19, 0xa3, 0xf9, 0x06, 0x9f, 0x02, 0xd0,
+	0xbb, 0x82, 0xe5, 0x31, 0x85, 0x31, 0x17, 0xe8, 0xfb, 0x1a, 0x74, 0xee, 0x6b, 0xa0, 0x4d, 0xd4,
+	0xbb, 0x46, 0x21, 0x31, 0x3d, 0x1b, 0xb9, 0x4a, 0xff, 0x9b, 0x03, 0x3c, 0x1b, 0x81, 0xd1, 0xdc,
+	0x04, 0x30, 0x3d, 0x3b, 0x6c, 0x26, 0xe6, 0xd4, 0xdc, 0x95, 0x79, 0xbb, 0xf2, 0xc1, 0x86, 0x46,
+	0xfe, 0x64, 0x3a, 0x38, 0x08, 0x3e, 0xfb, 0x87, 0x96, 0x3b, 0x23, 0xb5, 0x68, 0xba, 0xbc, 0x98,
+	0xfa, 0x8f, 0x22, 0x18, 0x57, 0x62, 0xe0, 0x07, 0x07, 0x14, 0xb5, 0x73, 0xe0, 0x82, 0x1d, 0x71,
+	0xd8, 0xb0, 0x6e, 0xed, 0x9c, 0xd9, 0x7a, 0xba, 0x5f, 0x3d, 0xfc, 0xfe, 0xe7, 0xd3, 0x98, 0x0f,
+	0x2b, 0xc8, 0xfa, 0xdc, 0x68, 0xcb, 0xc2, 0x2f, 0x0e, 0x98, 0x18, 0x74, 0x23, 0x5c, 0x1a, 0x31,
+	0xcd, 0xe2, 0x6f, 0x77, 0xf9, 0xc2, 0x75, 0x86, 0x77, 0x41, 0xf1, 0xce, 0xc1, 0x59, 0x3b, 0x6f,
+	0xef, 0x3d, 0x50, 0x5b, 0xd4, 0x3e, 0x1d, 0xb9, 0xc5, 0x3e, 0x93, 0xbb, 0xb5, 0x73, 0x66, 0x9f,
+	0x7f, 0x8b, 0x42, 0x63, 0x7c, 0x76, 0x40, 0x29, 0x67, 0x29, 0xb8, 0x38, 0x62, 0xd0, 0xb0, 0xe9,
+	0xdd, 0xfa, 0x45, 0x4a, 0x0c, 0xe0, 0x92, 0x02, 0xbc, 0x0b, 0x83, 0xff, 0xac, 0x2d, 0xf7, 0x2c,
+	0xa0, 0x7d, 0xf5, 0x92, 0x1c, 0xc0, 0xaf, 0x0e, 0x98, 0x1c, 0x32, 0x16, 0x1c, 0xf5, 0xeb, 0xd9,
+	0x1e, 0x03, 0xf7, 0xc1, 0xc5, 0x0b, 0x8d, 0x80, 0x47, 0x4a, 0xc0, 0x32, 0xbc, 0x6f, 0x17, 0x30,
+	0xec, 0x71, 0xb4, 0xaf, 0x72, 0x93, 0x83, 0x95, 0x8d, 0xa3, 0x13, 0xcf, 0x39, 0x3e, 0xf1, 0x9c,
+	0xdf, 0x27, 0x9e, 0xf3, 0xf1, 0xd4, 0x2b, 0x1c, 0x9f, 0x7a, 0x85, 0x9f, 0xa7, 0x5e, 0xe1, 0xf5,
+	0xc3, 0x90, 0xca, 0x37, 0x69, 0x2b, 0xd8, 0xe4, 0x6d, 0xd4, 0x26, 0x49, 0x44, 0x59, 0x8d, 0x11,
+	0xb9, 0xcb, 0x93, 0x6d, 0x33, 0xa9, 0xd6, 0xf1, 0x66, 0x46, 0x50, 0x56, 0x47, 0xef, 0x72, 0x53,
+	0xe5, 0x5e, 0x4c, 0x44, 0xab, 0xa8, 0xfe, 0x3b, 0xef, 0xfd, 0x1d, 0x00, 0x92, 0x0d, 0xb8, 0xc9,
+	0x0b, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -340,6 +556,10 @@ type QueryClient interface {
 	LiquidValidators(ctx context.Context, in *QueryLiquidValidatorsRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorsResponse, error)
 	// States returns states of the liquidstaking module.
 	States(ctx context.Context, in *QueryStatesRequest, opts ...grpc.CallOption) (*QueryStatesResponse, error)
+	// VotingPower returns the staking, liquid staking and validator voting power of the voter.
+	VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error)
+	// UnbondingRequests returns the unbonding requests of the liquid staker, except the matured non-pooled ones.
+	UnbondingRequests(ctx context.Context, in *QueryUnbondingRequestsRequest, opts ...grpc.CallOption) (*QueryUnbondingRequestsResponse, error)
 }
 
 type queryClient struct {
@@ -377,6 +597,24 @@ func (c *queryClient) States(ctx context.Context, in *QueryStatesRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error) {
+	out := new(QueryVotingPowerResponse)
+	err := c.cc.Invoke(ctx, "/estake.lselysium.v1beta1.Query/VotingPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingRequests(ctx context.Context, in *QueryUnbondingRequestsRequest, opts ...grpc.CallOption) (*QueryUnbondingRequestsResponse, error) {
+	out := new(QueryUnbondingRequestsResponse)
+	err := c.cc.Invoke(ctx, "/estake.lselysium.v1beta1.Query/UnbondingRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns parameters of the liquidstaking module.
@@ -385,6 +623,10 @@ type QueryServer interface {
 	LiquidValidators(context.Context, *QueryLiquidValidatorsRequest) (*QueryLiquidValidatorsResponse, error)
 	// States returns states of the liquidstaking module.
 	States(context.Context, *QueryStatesRequest) (*QueryStatesResponse, error)
+	// VotingPower returns the staking, liquid staking and validator voting power of the voter.
+	VotingPower(context.Context, *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error)
+	// UnbondingRequests returns the unbonding requests of the liquid staker, except the matured non-pooled ones.
+	UnbondingRequests(context.Context, *QueryUnbondingRequestsRequest) (*QueryUnbondingRequestsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -400,6 +642,12 @@ func (*UnimplementedQueryServer) LiquidValidators(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) States(ctx context.Context, req *QueryStatesRequest) (*QueryStatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method States not implemented")
 }
+func (*UnimplementedQueryServer) VotingPower(ctx context.Context, req *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingPower not implemented")
+}
+func (*UnimplementedQueryServer) UnbondingRequests(ctx context.Context, req *QueryUnbondingRequestsRequest) (*QueryUnbondingRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingRequests not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -459,6 +707,42 @@ func _Query_States_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/estake.lselysium.v1beta1.Query/VotingPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingPower(ctx, req.(*QueryVotingPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/estake.lselysium.v1beta1.Query/UnbondingRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingRequests(ctx, req.(*QueryUnbondingRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "estake.lselysium.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -475,6 +759,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "States",
 			Handler:    _Query_States_Handler,
 		},
+		{
+			MethodName: "VotingPower",
+			Handler:    _Query_VotingPower_Handler,
+		},
+		{
+			MethodName: "UnbondingRequests",
+			Handler:    _Query_UnbondingRequests_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "estake/lselysium/v1beta1/query.proto",
@@ -652,88 +944,302 @@ func (m *QueryStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryVotingPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryVotingPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryVotingPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLiquidValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVotingPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryLiquidValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryVotingPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.LiquidValidators) > 0 {
-		for _, e := range m.LiquidValidators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.VotingPower.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryUnbondingRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryStatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.NetAmountState.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryUnbondingRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UnbondingRequests) > 0 {
+		for iNdEx := len(m.UnbondingRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLiquidValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidValidators) > 0 {
+		for _, e := range m.LiquidValidators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetAmountState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVotingPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotingPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VotingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUnbondingRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnbondingRequests) > 0 {
+		for _, e := range m.UnbondingRequests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1133,6 +1639,409 @@ func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingRequests = append(m.UnbondingRequests, UnbondingRequest{})
+			if err := m.UnbondingRequests[len(m.UnbondingRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
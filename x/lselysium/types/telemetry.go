@@ -0,0 +1,13 @@
+package types
+
+// telemetry metric keys, exported under the lselysium module prefix
+const (
+	MetricKeyMintRate              = "mint_rate"
+	MetricKeyNetAmount             = "net_amount"
+	MetricKeyBTokenSupply          = "btoken_supply"
+	MetricKeyTotalLiquidTokens     = "total_liquid_tokens"
+	MetricKeyTotalRemainingRewards = "total_remaining_rewards"
+	MetricKeyTotalUnbondingBalance = "total_unbonding_balance"
+	MetricKeyProxyAccBalance       = "proxy_acc_balance"
+	MetricKeyActiveLiquidVals      = "active_liquid_validators"
+)
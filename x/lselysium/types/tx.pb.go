@@ -7,7 +7,9 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -196,376 +198,3078 @@ func (m *MsgLiquidUnstakeResponse) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
-func init() {
-	proto.RegisterType((*MsgLiquidStake)(nil), "estake.lselysium.v1beta1.MsgLiquidStake")
-	proto.RegisterType((*MsgLiquidStakeResponse)(nil), "estake.lselysium.v1beta1.MsgLiquidStakeResponse")
-	proto.RegisterType((*MsgLiquidUnstake)(nil), "estake.lselysium.v1beta1.MsgLiquidUnstake")
-	proto.RegisterType((*MsgLiquidUnstakeResponse)(nil), "estake.lselysium.v1beta1.MsgLiquidUnstakeResponse")
+// MsgStakeToLiquid defines a SDK message for converting an existing delegation to an active liquid validator into
+// bToken without unbonding.
+type MsgStakeToLiquid struct {
+	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress string     `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	Amount           types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
 
-func init() {
-	proto.RegisterFile("estake/lselysium/v1beta1/tx.proto", fileDescriptor_7d46e981836fefd9)
+func (m *MsgStakeToLiquid) Reset()         { *m = MsgStakeToLiquid{} }
+func (m *MsgStakeToLiquid) String() string { return proto.CompactTextString(m) }
+func (*MsgStakeToLiquid) ProtoMessage()    {}
+func (*MsgStakeToLiquid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{4}
 }
-
-var fileDescriptor_7d46e981836fefd9 = []byte{
-	// 452 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x63, 0x40, 0xd3, 0x70, 0xc5, 0x18, 0x11, 0x42, 0x59, 0x34, 0x25, 0x53, 0x24, 0xa4,
-	0x1d, 0xc0, 0xd6, 0x0a, 0x02, 0x69, 0x27, 0x28, 0x27, 0x24, 0x2a, 0xa4, 0x02, 0x97, 0x5d, 0x2a,
-	0x27, 0x35, 0xc6, 0x22, 0xf1, 0xcb, 0xfa, 0xdc, 0xb2, 0x7d, 0x03, 0x8e, 0xfb, 0x04, 0xa8, 0x1f,
-	0x67, 0xc7, 0x1d, 0x39, 0x0d, 0xd4, 0x5e, 0xb8, 0xc2, 0x27, 0x40, 0xa9, 0x93, 0x6e, 0x1d, 0x12,
-	0xa2, 0x37, 0x6e, 0x79, 0xfe, 0xff, 0xec, 0xf7, 0xf7, 0xfb, 0xc7, 0xf4, 0x7e, 0x89, 0x56, 0x7c,
-	0x94, 0x3c, 0xc7, 0x52, 0x0e, 0x51, 0xa3, 0x95, 0x26, 0x93, 0x7c, 0xbc, 0x97, 0x4a, 0x2b, 0xf6,
-	0xb8, 0x3d, 0x62, 0xe5, 0x10, 0x2c, 0xf8, 0xdb, 0x0e, 0x63, 0x4b, 0x18, 0xab, 0xb1, 0xf0, 0xae,
-	0x02, 0x05, 0x73, 0x90, 0x57, 0x5f, 0x6e, 0x4f, 0xb8, 0x95, 0x01, 0x16, 0x80, 0x7d, 0x27, 0xb8,
-	0xa2, 0x96, 0x22, 0x57, 0xf1, 0x54, 0xe0, 0x45, 0xb3, 0x0c, 0xb4, 0xa9, 0xf5, 0x58, 0x01, 0xa8,
-	0x5c, 0xf2, 0x79, 0x95, 0x8e, 0xde, 0x73, 0xab, 0x0b, 0x89, 0x56, 0x14, 0xa5, 0x03, 0x92, 0x2f,
-	0x84, 0x6e, 0x74, 0x51, 0xbd, 0xd2, 0x87, 0x23, 0x3d, 0x78, 0x53, 0x59, 0xf3, 0x5f, 0xd2, 0x3b,
-	0x03, 0x99, 0x4b, 0x25, 0x2c, 0x0c, 0xfb, 0x62, 0x30, 0x18, 0x4a, 0xc4, 0x80, 0xec, 0x90, 0xdd,
-	0x9b, 0x9d, 0xed, 0x5f, 0xe7, 0x71, 0x70, 0x2c, 0x8a, 0x7c, 0x3f, 0xf9, 0x03, 0x49, 0x7a, 0x9b,
-	0x8b, 0xb5, 0xe7, 0x6e, 0xc9, 0x7f, 0x4a, 0xd7, 0x44, 0x01, 0x23, 0x63, 0x83, 0x6b, 0x3b, 0x64,
-	0xb7, 0xd5, 0xde, 0x62, 0xb5, 0xfb, 0xca, 0x6f, 0x73, 0x6b, 0xf6, 0x02, 0xb4, 0xe9, 0xdc, 0x38,
-	0x3d, 0x8f, 0xbd, 0x5e, 0x8d, 0xef, 0xaf, 0x7f, 0x9e, 0xc4, 0xde, 0x8f, 0x49, 0xec, 0x25, 0x01,
-	0xbd, 0xb7, 0xec, 0xaf, 0x27, 0xb1, 0x04, 0x83, 0x32, 0x99, 0x10, 0xba, 0xb9, 0x90, 0xde, 0x19,
-	0xfc, 0x0f, 0xcd, 0x6b, 0x1a, 0x5c, 0x75, 0xd8, 0xd8, 0xf7, 0xbb, 0xf4, 0x76, 0x06, 0x45, 0x99,
-	0x4b, 0xab, 0xc1, 0xf4, 0xab, 0x5c, 0xe6, 0x3e, 0x5b, 0xed, 0x90, 0xb9, 0xd0, 0x58, 0x13, 0x1a,
-	0x7b, 0xdb, 0x84, 0xd6, 0x59, 0xaf, 0x1a, 0x9d, 0x7c, 0x8b, 0x49, 0x6f, 0xe3, 0x62, 0x73, 0x25,
-	0xb7, 0x7f, 0x12, 0x7a, 0xbd, 0x8b, 0xca, 0x3f, 0xa4, 0xad, 0xcb, 0x61, 0x3e, 0x60, 0x7f, 0xfb,
-	0xe1, 0xd8, 0xf2, 0x68, 0xc3, 0xc7, 0xab, 0xd0, 0x8b, 0x9b, 0x7c, 0xa2, 0xb7, 0x96, 0x43, 0x60,
-	0xff, 0x78, 0x4c, 0xcd, 0x87, 0x4f, 0x56, 0xe3, 0x9b, 0xc6, 0x9d, 0x83, 0xd3, 0x69, 0x44, 0xce,
-	0xa6, 0x11, 0xf9, 0x3e, 0x8d, 0xc8, 0xc9, 0x2c, 0xf2, 0xce, 0x66, 0x91, 0xf7, 0x75, 0x16, 0x79,
-	0x07, 0xcf, 0x94, 0xb6, 0x1f, 0x46, 0x29, 0xcb, 0xa0, 0xe0, 0x97, 0x8e, 0x7c, 0x6d, 0x24, 0x77,
-	0xad, 0x1e, 0x1a, 0x61, 0xf5, 0x58, 0xf2, 0x71, 0x9b, 0x1f, 0x5d, 0x79, 0xb3, 0xf6, 0xb8, 0x94,
-	0x98, 0xae, 0xcd, 0xa7, 0xff, 0xe8, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x55, 0xad, 0x86, 0xc6,
-	0xd8, 0x03, 0x00, 0x00,
+func (m *MsgStakeToLiquid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStakeToLiquid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStakeToLiquid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStakeToLiquid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStakeToLiquid.Merge(m, src)
+}
+func (m *MsgStakeToLiquid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStakeToLiquid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStakeToLiquid.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgStakeToLiquid proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// LiquidStake defines a method for performing a delegation of coins
-	// from a delegator to whitelisted validators.
-	LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error)
-	// LiquidUnstake defines a method for performing an undelegation of liquid staking from a
-	// delegate.
-	LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opts ...grpc.CallOption) (*MsgLiquidUnstakeResponse, error)
+// MsgStakeToLiquidResponse defines the Msg/StakeToLiquid response type.
+type MsgStakeToLiquidResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgStakeToLiquidResponse) Reset()         { *m = MsgStakeToLiquidResponse{} }
+func (m *MsgStakeToLiquidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStakeToLiquidResponse) ProtoMessage()    {}
+func (*MsgStakeToLiquidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{5}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgStakeToLiquidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error) {
-	out := new(MsgLiquidStakeResponse)
-	err := c.cc.Invoke(ctx, "/estake.lselysium.v1beta1.Msg/LiquidStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgStakeToLiquidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStakeToLiquidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opts ...grpc.CallOption) (*MsgLiquidUnstakeResponse, error) {
-	out := new(MsgLiquidUnstakeResponse)
-	err := c.cc.Invoke(ctx, "/estake.lselysium.v1beta1.Msg/LiquidUnstake", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgStakeToLiquidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStakeToLiquidResponse.Merge(m, src)
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// LiquidStake defines a method for performing a delegation of coins
-	// from a delegator to whitelisted validators.
-	LiquidStake(context.Context, *MsgLiquidStake) (*MsgLiquidStakeResponse, error)
-	// LiquidUnstake defines a method for performing an undelegation of liquid staking from a
-	// delegate.
-	LiquidUnstake(context.Context, *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error)
+func (m *MsgStakeToLiquidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStakeToLiquidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStakeToLiquidResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgStakeToLiquidResponse proto.InternalMessageInfo
+
+// MsgPooledLiquidUnstake defines a SDK message for performing an undelegation of liquid staking into the unbonding
+// pool, which is not limited by the unbonding delegation entries of the delegator.
+type MsgPooledLiquidUnstake struct {
+	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	Amount           types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
 }
 
-func (*UnimplementedMsgServer) LiquidStake(ctx context.Context, req *MsgLiquidStake) (*MsgLiquidStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidStake not implemented")
+func (m *MsgPooledLiquidUnstake) Reset()         { *m = MsgPooledLiquidUnstake{} }
+func (m *MsgPooledLiquidUnstake) String() string { return proto.CompactTextString(m) }
+func (*MsgPooledLiquidUnstake) ProtoMessage()    {}
+func (*MsgPooledLiquidUnstake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{6}
 }
-func (*UnimplementedMsgServer) LiquidUnstake(ctx context.Context, req *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidUnstake not implemented")
+func (m *MsgPooledLiquidUnstake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPooledLiquidUnstake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPooledLiquidUnstake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPooledLiquidUnstake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPooledLiquidUnstake.Merge(m, src)
+}
+func (m *MsgPooledLiquidUnstake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPooledLiquidUnstake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPooledLiquidUnstake.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgPooledLiquidUnstake proto.InternalMessageInfo
+
+// MsgPooledLiquidUnstakeResponse defines the Msg/PooledLiquidUnstake response type.
+type MsgPooledLiquidUnstakeResponse struct {
+	UnbondingRequestId uint64    `protobuf:"varint,1,opt,name=unbonding_request_id,json=unbondingRequestId,proto3" json:"unbonding_request_id,omitempty"`
+	CompletionTime     time.Time `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
 }
 
-func _Msg_LiquidStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLiquidStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).LiquidStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/estake.lselysium.v1beta1.Msg/LiquidStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LiquidStake(ctx, req.(*MsgLiquidStake))
+func (m *MsgPooledLiquidUnstakeResponse) Reset()         { *m = MsgPooledLiquidUnstakeResponse{} }
+func (m *MsgPooledLiquidUnstakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPooledLiquidUnstakeResponse) ProtoMessage()    {}
+func (*MsgPooledLiquidUnstakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{7}
+}
+func (m *MsgPooledLiquidUnstakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPooledLiquidUnstakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPooledLiquidUnstakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgPooledLiquidUnstakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPooledLiquidUnstakeResponse.Merge(m, src)
+}
+func (m *MsgPooledLiquidUnstakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPooledLiquidUnstakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPooledLiquidUnstakeResponse.DiscardUnknown(m)
 }
 
-func _Msg_LiquidUnstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLiquidUnstake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).LiquidUnstake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/estake.lselysium.v1beta1.Msg/LiquidUnstake",
+var xxx_messageInfo_MsgPooledLiquidUnstakeResponse proto.InternalMessageInfo
+
+func (m *MsgPooledLiquidUnstakeResponse) GetUnbondingRequestId() uint64 {
+	if m != nil {
+		return m.UnbondingRequestId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LiquidUnstake(ctx, req.(*MsgLiquidUnstake))
+	return 0
+}
+
+func (m *MsgPooledLiquidUnstakeResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "estake.lselysium.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "LiquidStake",
-			Handler:    _Msg_LiquidStake_Handler,
-		},
-		{
-			MethodName: "LiquidUnstake",
-			Handler:    _Msg_LiquidUnstake_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "estake/lselysium/v1beta1/tx.proto",
+// MsgClaimUnbonded defines a SDK message for claiming the matured pooled liquid unstakings of the delegator.
+type MsgClaimUnbonded struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
 }
 
-func (m *MsgLiquidStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimUnbonded) Reset()         { *m = MsgClaimUnbonded{} }
+func (m *MsgClaimUnbonded) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimUnbonded) ProtoMessage()    {}
+func (*MsgClaimUnbonded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{8}
+}
+func (m *MsgClaimUnbonded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimUnbonded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimUnbonded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgClaimUnbonded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimUnbonded.Merge(m, src)
+}
+func (m *MsgClaimUnbonded) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimUnbonded) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimUnbonded.DiscardUnknown(m)
 }
 
-func (m *MsgLiquidStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgClaimUnbonded proto.InternalMessageInfo
+
+// MsgClaimUnbondedResponse defines the Msg/ClaimUnbonded response type.
+type MsgClaimUnbondedResponse struct {
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgLiquidStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgClaimUnbondedResponse) Reset()         { *m = MsgClaimUnbondedResponse{} }
+func (m *MsgClaimUnbondedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimUnbondedResponse) ProtoMessage()    {}
+func (*MsgClaimUnbondedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{9}
+}
+func (m *MsgClaimUnbondedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimUnbondedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimUnbondedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgClaimUnbondedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimUnbondedResponse.Merge(m, src)
+}
+func (m *MsgClaimUnbondedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimUnbondedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimUnbondedResponse.DiscardUnknown(m)
 }
 
-func (m *MsgLiquidStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClaimUnbondedResponse proto.InternalMessageInfo
+
+func (m *MsgClaimUnbondedResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgLiquidStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgInstantLiquidUnstake defines a SDK message for performing a liquid unstaking paid out immediately from the
+// reserve of the proxy account balance.
+type MsgInstantLiquidUnstake struct {
+	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	Amount           types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgLiquidStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgInstantLiquidUnstake) Reset()         { *m = MsgInstantLiquidUnstake{} }
+func (m *MsgInstantLiquidUnstake) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantLiquidUnstake) ProtoMessage()    {}
+func (*MsgInstantLiquidUnstake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{10}
 }
-
-func (m *MsgLiquidUnstake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgInstantLiquidUnstake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantLiquidUnstake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantLiquidUnstake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgInstantLiquidUnstake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantLiquidUnstake.Merge(m, src)
+}
+func (m *MsgInstantLiquidUnstake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantLiquidUnstake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantLiquidUnstake.DiscardUnknown(m)
 }
 
-func (m *MsgLiquidUnstake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgInstantLiquidUnstake proto.InternalMessageInfo
+
+// MsgInstantLiquidUnstakeResponse defines the Msg/InstantLiquidUnstake response type.
+type MsgInstantLiquidUnstakeResponse struct {
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgLiquidUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgInstantLiquidUnstakeResponse) Reset()         { *m = MsgInstantLiquidUnstakeResponse{} }
+func (m *MsgInstantLiquidUnstakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantLiquidUnstakeResponse) ProtoMessage()    {}
+func (*MsgInstantLiquidUnstakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{11}
+}
+func (m *MsgInstantLiquidUnstakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantLiquidUnstakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantLiquidUnstakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgInstantLiquidUnstakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantLiquidUnstakeResponse.Merge(m, src)
+}
+func (m *MsgInstantLiquidUnstakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantLiquidUnstakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantLiquidUnstakeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgLiquidUnstakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgInstantLiquidUnstakeResponse proto.InternalMessageInfo
+
+func (m *MsgInstantLiquidUnstakeResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgLiquidUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgAddWhitelistedValidator defines a SDK message for adding a validator to the whitelist, executed by
+// governance.
+type MsgAddWhitelistedValidator struct {
+	// authority is the address of the governance account.
+	Authority        string                                 `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ValidatorAddress string                                 `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	TargetWeight     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=target_weight,json=targetWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"target_weight" yaml:"target_weight"`
 }
 
-func (m *MsgLiquidUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err3 != nil {
-		return 0, err3
+func (m *MsgAddWhitelistedValidator) Reset()         { *m = MsgAddWhitelistedValidator{} }
+func (m *MsgAddWhitelistedValidator) String() string { return proto.CompactTextString(m) }
+func (*MsgAddWhitelistedValidator) ProtoMessage()    {}
+func (*MsgAddWhitelistedValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{12}
+}
+func (m *MsgAddWhitelistedValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddWhitelistedValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddWhitelistedValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n3
-	i = encodeVarintTx(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgAddWhitelistedValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddWhitelistedValidator.Merge(m, src)
+}
+func (m *MsgAddWhitelistedValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddWhitelistedValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddWhitelistedValidator.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgAddWhitelistedValidator proto.InternalMessageInfo
+
+// MsgAddWhitelistedValidatorResponse defines the Msg/AddWhitelistedValidator response type.
+type MsgAddWhitelistedValidatorResponse struct {
+}
+
+func (m *MsgAddWhitelistedValidatorResponse) Reset()         { *m = MsgAddWhitelistedValidatorResponse{} }
+func (m *MsgAddWhitelistedValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddWhitelistedValidatorResponse) ProtoMessage()    {}
+func (*MsgAddWhitelistedValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{13}
+}
+func (m *MsgAddWhitelistedValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddWhitelistedValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddWhitelistedValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *MsgLiquidStake) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddWhitelistedValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddWhitelistedValidatorResponse.Merge(m, src)
+}
+func (m *MsgAddWhitelistedValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddWhitelistedValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddWhitelistedValidatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddWhitelistedValidatorResponse proto.InternalMessageInfo
+
+// MsgRemoveWhitelistedValidator defines a SDK message for removing a validator from the whitelist, executed by
+// governance.
+type MsgRemoveWhitelistedValidator struct {
+	// authority is the address of the governance account.
+	Authority        string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+}
+
+func (m *MsgRemoveWhitelistedValidator) Reset()         { *m = MsgRemoveWhitelistedValidator{} }
+func (m *MsgRemoveWhitelistedValidator) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveWhitelistedValidator) ProtoMessage()    {}
+func (*MsgRemoveWhitelistedValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{14}
+}
+func (m *MsgRemoveWhitelistedValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveWhitelistedValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveWhitelistedValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgRemoveWhitelistedValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveWhitelistedValidator.Merge(m, src)
+}
+func (m *MsgRemoveWhitelistedValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveWhitelistedValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveWhitelistedValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveWhitelistedValidator proto.InternalMessageInfo
+
+// MsgRemoveWhitelistedValidatorResponse defines the Msg/RemoveWhitelistedValidator response type.
+type MsgRemoveWhitelistedValidatorResponse struct {
+}
+
+func (m *MsgRemoveWhitelistedValidatorResponse) Reset()         { *m = MsgRemoveWhitelistedValidatorResponse{} }
+func (m *MsgRemoveWhitelistedValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveWhitelistedValidatorResponse) ProtoMessage()    {}
+func (*MsgRemoveWhitelistedValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{15}
+}
+func (m *MsgRemoveWhitelistedValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveWhitelistedValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveWhitelistedValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgRemoveWhitelistedValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveWhitelistedValidatorResponse.Merge(m, src)
+}
+func (m *MsgRemoveWhitelistedValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveWhitelistedValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveWhitelistedValidatorResponse.DiscardUnknown(m)
 }
 
-func (m *MsgLiquidStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgRemoveWhitelistedValidatorResponse proto.InternalMessageInfo
+
+// MsgUpdateValidatorWeight defines a SDK message for updating the target weight of a whitelisted validator,
+// executed by governance.
+type MsgUpdateValidatorWeight struct {
+	// authority is the address of the governance account.
+	Authority        string                                 `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ValidatorAddress string                                 `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	TargetWeight     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=target_weight,json=targetWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"target_weight" yaml:"target_weight"`
+}
+
+func (m *MsgUpdateValidatorWeight) Reset()         { *m = MsgUpdateValidatorWeight{} }
+func (m *MsgUpdateValidatorWeight) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateValidatorWeight) ProtoMessage()    {}
+func (*MsgUpdateValidatorWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{16}
+}
+func (m *MsgUpdateValidatorWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateValidatorWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateValidatorWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgUpdateValidatorWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateValidatorWeight.Merge(m, src)
+}
+func (m *MsgUpdateValidatorWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateValidatorWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateValidatorWeight.DiscardUnknown(m)
 }
 
-func (m *MsgLiquidUnstake) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgUpdateValidatorWeight proto.InternalMessageInfo
+
+// MsgUpdateValidatorWeightResponse defines the Msg/UpdateValidatorWeight response type.
+type MsgUpdateValidatorWeightResponse struct {
+}
+
+func (m *MsgUpdateValidatorWeightResponse) Reset()         { *m = MsgUpdateValidatorWeightResponse{} }
+func (m *MsgUpdateValidatorWeightResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateValidatorWeightResponse) ProtoMessage()    {}
+func (*MsgUpdateValidatorWeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{17}
+}
+func (m *MsgUpdateValidatorWeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateValidatorWeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateValidatorWeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgUpdateValidatorWeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateValidatorWeightResponse.Merge(m, src)
+}
+func (m *MsgUpdateValidatorWeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateValidatorWeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateValidatorWeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateValidatorWeightResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgLiquidStake)(nil), "estake.lselysium.v1beta1.MsgLiquidStake")
+	proto.RegisterType((*MsgLiquidStakeResponse)(nil), "estake.lselysium.v1beta1.MsgLiquidStakeResponse")
+	proto.RegisterType((*MsgLiquidUnstake)(nil), "estake.lselysium.v1beta1.MsgLiquidUnstake")
+	proto.RegisterType((*MsgLiquidUnstakeResponse)(nil), "estake.lselysium.v1beta1.MsgLiquidUnstakeResponse")
+	proto.RegisterType((*MsgStakeToLiquid)(nil), "estake.lselysium.v1beta1.MsgStakeToLiquid")
+	proto.RegisterType((*MsgStakeToLiquidResponse)(nil), "estake.lselysium.v1beta1.MsgStakeToLiquidResponse")
+	proto.RegisterType((*MsgPooledLiquidUnstake)(nil), "estake.lselysium.v1beta1.MsgPooledLiquidUnstake")
+	proto.RegisterType((*MsgPooledLiquidUnstakeResponse)(nil), "estake.lselysium.v1beta1.MsgPooledLiquidUnstakeResponse")
+	proto.RegisterType((*MsgClaimUnbonded)(nil), "estake.lselysium.v1beta1.MsgClaimUnbonded")
+	proto.RegisterType((*MsgClaimUnbondedResponse)(nil), "estake.lselysium.v1beta1.MsgClaimUnbondedResponse")
+	proto.RegisterType((*MsgInstantLiquidUnstake)(nil), "estake.lselysium.v1beta1.MsgInstantLiquidUnstake")
+	proto.RegisterType((*MsgInstantLiquidUnstakeResponse)(nil), "estake.lselysium.v1beta1.MsgInstantLiquidUnstakeResponse")
+	proto.RegisterType((*MsgAddWhitelistedValidator)(nil), "estake.lselysium.v1beta1.MsgAddWhitelistedValidator")
+	proto.RegisterType((*MsgAddWhitelistedValidatorResponse)(nil), "estake.lselysium.v1beta1.MsgAddWhitelistedValidatorResponse")
+	proto.RegisterType((*MsgRemoveWhitelistedValidator)(nil), "estake.lselysium.v1beta1.MsgRemoveWhitelistedValidator")
+	proto.RegisterType((*MsgRemoveWhitelistedValidatorResponse)(nil), "estake.lselysium.v1beta1.MsgRemoveWhitelistedValidatorResponse")
+	proto.RegisterType((*MsgUpdateValidatorWeight)(nil), "estake.lselysium.v1beta1.MsgUpdateValidatorWeight")
+	proto.RegisterType((*MsgUpdateValidatorWeightResponse)(nil), "estake.lselysium.v1beta1.MsgUpdateValidatorWeightResponse")
+}
+
+func init() {
+	proto.RegisterFile("estake/lselysium/v1beta1/tx.proto", fileDescriptor_7d46e981836fefd9)
+}
+
+var fileDescriptor_7d46e981836fefd9 = []byte{
+	// 932 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xde, 0xd9, 0x56, 0x55, 0x33, 0xa1, 0x25, 0x5d, 0x96, 0xc6, 0xb5, 0xc0, 0x0e, 0x16, 0x3f,
+	0xa2, 0x4a, 0x6b, 0x37, 0x0b, 0xa2, 0x10, 0x55, 0x42, 0xdd, 0x9e, 0x56, 0x62, 0x25, 0xe4, 0x24,
+	0xad, 0xd4, 0xcb, 0xca, 0x1b, 0x0f, 0x93, 0x51, 0x6c, 0xcf, 0xd6, 0x33, 0xde, 0x76, 0x8f, 0x45,
+	0x48, 0x70, 0x41, 0xea, 0x89, 0x23, 0x0a, 0x17, 0xb8, 0x56, 0xa8, 0x27, 0xfe, 0x82, 0x1e, 0xab,
+	0x9e, 0x10, 0x87, 0x80, 0x92, 0x03, 0x48, 0xdc, 0xfa, 0x17, 0x20, 0x7b, 0xec, 0xc9, 0x3a, 0xb1,
+	0xad, 0xcd, 0x8f, 0xc3, 0x1e, 0x7a, 0xf2, 0x7a, 0xde, 0xf7, 0xde, 0xfb, 0xde, 0x37, 0xcf, 0x6f,
+	0x66, 0xe1, 0x7b, 0x88, 0x71, 0x67, 0x1b, 0x59, 0x1e, 0x43, 0xde, 0x98, 0x91, 0xc8, 0xb7, 0x46,
+	0x2b, 0x03, 0xc4, 0x9d, 0x15, 0x8b, 0x3f, 0x32, 0x87, 0x21, 0xe5, 0xb4, 0xa1, 0x08, 0x88, 0x29,
+	0x21, 0x66, 0x0a, 0x51, 0x9b, 0x98, 0x62, 0x9a, 0x80, 0xac, 0xf8, 0x97, 0xc0, 0xab, 0xd7, 0x36,
+	0x29, 0xf3, 0x29, 0xeb, 0x0b, 0x83, 0x78, 0x49, 0x4d, 0x8b, 0xe2, 0xcd, 0xf2, 0x19, 0xb6, 0x46,
+	0x2b, 0xf1, 0x23, 0x35, 0x68, 0xa9, 0x61, 0xe0, 0x30, 0x24, 0x19, 0x6c, 0x52, 0x12, 0xa4, 0x76,
+	0x1d, 0x53, 0x8a, 0x3d, 0x64, 0x25, 0x6f, 0x83, 0xe8, 0x6b, 0x8b, 0x13, 0x3f, 0xa6, 0xe5, 0x0f,
+	0x05, 0xc0, 0xf8, 0x09, 0xc0, 0xcb, 0x3d, 0x86, 0xbf, 0x24, 0x0f, 0x22, 0xe2, 0xae, 0xc5, 0x7c,
+	0x1b, 0x5d, 0x78, 0xc5, 0x45, 0x1e, 0xc2, 0x0e, 0xa7, 0x61, 0xdf, 0x71, 0xdd, 0x10, 0x31, 0xa6,
+	0x80, 0x25, 0xb0, 0x3c, 0xd7, 0x79, 0xe7, 0xd5, 0xae, 0xae, 0x8c, 0x1d, 0xdf, 0x5b, 0x35, 0x8e,
+	0x40, 0x0c, 0x7b, 0x41, 0xae, 0xdd, 0x16, 0x4b, 0x8d, 0x9b, 0xf0, 0x82, 0xe3, 0xd3, 0x28, 0xe0,
+	0x4a, 0x7d, 0x09, 0x2c, 0xcf, 0xb7, 0xaf, 0x99, 0x69, 0x59, 0x31, 0xdf, 0x4c, 0x0e, 0xf3, 0x0e,
+	0x25, 0x41, 0xe7, 0xfc, 0xf3, 0x5d, 0xbd, 0x66, 0xa7, 0xf0, 0xd5, 0x8b, 0xdf, 0xef, 0xe8, 0xb5,
+	0x7f, 0x77, 0xf4, 0x9a, 0xa1, 0xc0, 0xab, 0x79, 0x7e, 0x36, 0x62, 0x43, 0x1a, 0x30, 0x64, 0xec,
+	0x00, 0xb8, 0x20, 0x4d, 0x1b, 0x01, 0x9b, 0x41, 0xf2, 0x04, 0x2a, 0x87, 0x19, 0x66, 0xf4, 0x1b,
+	0x3d, 0xf8, 0xe6, 0x26, 0xf5, 0x87, 0x1e, 0xe2, 0x84, 0x06, 0xfd, 0x78, 0x5f, 0x12, 0x9e, 0xf3,
+	0x6d, 0xd5, 0x14, 0x9b, 0x66, 0x66, 0x9b, 0x66, 0xae, 0x67, 0x9b, 0xd6, 0xb9, 0x18, 0x27, 0x7a,
+	0xf2, 0x97, 0x0e, 0xec, 0xcb, 0x07, 0xce, 0xb1, 0xd9, 0xf8, 0x4f, 0xa8, 0x91, 0x48, 0xb4, 0x4e,
+	0x45, 0xca, 0xb3, 0x54, 0xa3, 0x0b, 0xaf, 0x8c, 0x1c, 0x8f, 0xb8, 0xb9, 0x50, 0xf5, 0xc3, 0xa1,
+	0x8e, 0x40, 0x0c, 0x7b, 0x41, 0xae, 0x1d, 0x15, 0xf6, 0xdc, 0x49, 0x85, 0x55, 0xa1, 0x72, 0xb8,
+	0x58, 0xd9, 0x17, 0xbf, 0x80, 0xa4, 0x65, 0xbe, 0xa2, 0xd4, 0x43, 0xee, 0x2c, 0x77, 0xc7, 0xcf,
+	0x00, 0x6a, 0xc5, 0x44, 0x65, 0x93, 0xdc, 0x80, 0xcd, 0x28, 0x18, 0xd0, 0xc0, 0x25, 0x01, 0xee,
+	0x87, 0xe8, 0x41, 0x84, 0x18, 0xef, 0x13, 0x37, 0xe1, 0x7c, 0xde, 0x6e, 0x48, 0x9b, 0x2d, 0x4c,
+	0x5d, 0xb7, 0xa8, 0xad, 0xea, 0xa7, 0x68, 0x2b, 0x9c, 0x74, 0xd5, 0x1d, 0xcf, 0x21, 0xfe, 0x46,
+	0x92, 0x0c, 0x9d, 0x65, 0x57, 0x4d, 0x88, 0xb1, 0x06, 0x95, 0xc3, 0x89, 0xa4, 0x0a, 0x07, 0x5a,
+	0x83, 0x63, 0x69, 0x6d, 0xfc, 0x0a, 0xe0, 0x62, 0x8f, 0xe1, 0x6e, 0xac, 0x6a, 0xc0, 0x67, 0xb9,
+	0x17, 0xee, 0x43, 0xbd, 0x84, 0xe8, 0xe9, 0x55, 0xf8, 0xad, 0x0e, 0xd5, 0x1e, 0xc3, 0xb7, 0x5d,
+	0xf7, 0xde, 0x16, 0xe1, 0xc8, 0x23, 0x8c, 0x23, 0xf7, 0x6e, 0xf6, 0x55, 0x36, 0x3e, 0x85, 0x73,
+	0x4e, 0xc4, 0xb7, 0x68, 0x48, 0xf8, 0x38, 0x15, 0x40, 0x79, 0xf9, 0xac, 0xd5, 0x4c, 0xa3, 0xa7,
+	0x45, 0xae, 0xf1, 0x30, 0xee, 0xb3, 0x03, 0xe8, 0x59, 0x4e, 0x84, 0x31, 0xbc, 0xc4, 0x9d, 0x10,
+	0x23, 0xde, 0x7f, 0x88, 0x08, 0xde, 0x12, 0x83, 0x61, 0xae, 0xb3, 0x1e, 0x97, 0xf1, 0xe7, 0xae,
+	0xfe, 0x21, 0x26, 0x7c, 0x2b, 0x1a, 0x98, 0x9b, 0xd4, 0x4f, 0xcf, 0xc5, 0xf4, 0xd1, 0x62, 0xee,
+	0xb6, 0xc5, 0xc7, 0x43, 0xc4, 0xcc, 0x6e, 0xc0, 0x5f, 0xed, 0xea, 0x4d, 0x91, 0x34, 0x17, 0xcc,
+	0x78, 0xf9, 0xac, 0x05, 0xd3, 0x62, 0xba, 0x01, 0xb7, 0xdf, 0x10, 0xd6, 0x7b, 0x89, 0x71, 0xf5,
+	0x6a, 0xb6, 0x05, 0xdf, 0xfc, 0xf3, 0xf4, 0xfa, 0x41, 0x75, 0xc6, 0xfb, 0xd0, 0x28, 0xd7, 0x4c,
+	0xce, 0x9a, 0xdf, 0x01, 0x7c, 0xb7, 0xc7, 0xb0, 0x8d, 0x7c, 0x3a, 0x42, 0x33, 0xaa, 0x6e, 0x69,
+	0x89, 0x1f, 0xc1, 0x0f, 0x2a, 0xb9, 0xcb, 0x2a, 0x9f, 0xd6, 0x93, 0x8f, 0x73, 0x63, 0xe8, 0x3a,
+	0x1c, 0x49, 0xb3, 0x10, 0xf0, 0x75, 0xfb, 0x14, 0x6a, 0x6b, 0xc0, 0xa5, 0x32, 0xc5, 0x32, 0x59,
+	0xdb, 0x8f, 0xe7, 0xe0, 0xb9, 0x1e, 0xc3, 0x0d, 0x02, 0xe7, 0x27, 0xef, 0x5f, 0xcb, 0x66, 0xd9,
+	0xc5, 0xd1, 0xcc, 0xdf, 0x84, 0xd4, 0x1b, 0xd3, 0x22, 0xe5, 0x0c, 0xa1, 0xf0, 0x52, 0x7e, 0x0a,
+	0x5e, 0x9f, 0x22, 0x44, 0x8a, 0x55, 0xdb, 0xd3, 0x63, 0x27, 0x13, 0xe6, 0xaf, 0x24, 0xd5, 0x09,
+	0x73, 0x58, 0xb5, 0x3d, 0x3d, 0x56, 0x26, 0x7c, 0x0c, 0xe0, 0x5b, 0x45, 0x47, 0x7f, 0xb5, 0x56,
+	0x05, 0x1e, 0xea, 0x67, 0xc7, 0xf5, 0x98, 0x2c, 0x3a, 0x7f, 0x62, 0x56, 0x17, 0x9d, 0xc3, 0xaa,
+	0xed, 0xe9, 0xb1, 0x32, 0xe1, 0xb7, 0x00, 0x36, 0x0b, 0x0f, 0xb9, 0x95, 0xca, 0x60, 0x45, 0x2e,
+	0xea, 0xe7, 0xc7, 0x76, 0x91, 0x34, 0x7e, 0x00, 0x70, 0xb1, 0xec, 0x94, 0xf9, 0xa4, 0x32, 0x6c,
+	0x89, 0x97, 0x7a, 0xeb, 0x24, 0x5e, 0x92, 0xcf, 0x8f, 0x00, 0xaa, 0x15, 0xa3, 0xf9, 0x66, 0x65,
+	0xf0, 0x72, 0x47, 0xf5, 0x8b, 0x13, 0x3a, 0x4a, 0x62, 0xdf, 0x01, 0xf8, 0x76, 0xf1, 0x34, 0xad,
+	0xde, 0xfd, 0x42, 0x1f, 0x75, 0xf5, 0xf8, 0x3e, 0x19, 0x93, 0xce, 0xdd, 0xe7, 0x7b, 0x1a, 0x78,
+	0xb1, 0xa7, 0x81, 0xbf, 0xf7, 0x34, 0xf0, 0x64, 0x5f, 0xab, 0xbd, 0xd8, 0xd7, 0x6a, 0x7f, 0xec,
+	0x6b, 0xb5, 0xfb, 0xb7, 0x26, 0xa6, 0xa6, 0x8f, 0x42, 0x8f, 0x04, 0xad, 0x00, 0xf1, 0x87, 0x34,
+	0xdc, 0xb6, 0x44, 0xba, 0x56, 0xe0, 0x70, 0x32, 0x42, 0xd6, 0xa8, 0x6d, 0x3d, 0x9a, 0xf8, 0x1f,
+	0x9c, 0xcc, 0xd3, 0xc1, 0x85, 0xe4, 0x96, 0xf9, 0xf1, 0xff, 0x03, 0x00, 0x2c, 0xb2, 0xc7, 0x50,
+	0x28, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// LiquidStake defines a method for performing a delegation of coins
+	// from a delegator to whitelisted validators.
+	LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error)
+	// LiquidUnstake defines a method for performing an undelegation of liquid staking from a
+	// delegate.
+	LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opts ...grpc.CallOption) (*MsgLiquidUnstakeResponse, error)
+	// StakeToLiquid defines a method for converting an existing delegation to an active liquid validator into
+	// bToken without unbonding.
+	StakeToLiquid(ctx context.Context, in *MsgStakeToLiquid, opts ...grpc.CallOption) (*MsgStakeToLiquidResponse, error)
+	// PooledLiquidUnstake defines a method for performing an undelegation of liquid staking into the unbonding pool,
+	// claimed by the delegator once matured.
+	PooledLiquidUnstake(ctx context.Context, in *MsgPooledLiquidUnstake, opts ...grpc.CallOption) (*MsgPooledLiquidUnstakeResponse, error)
+	// ClaimUnbonded defines a method for claiming the matured pooled liquid unstakings of the delegator.
+	ClaimUnbonded(ctx context.Context, in *MsgClaimUnbonded, opts ...grpc.CallOption) (*MsgClaimUnbondedResponse, error)
+	// InstantLiquidUnstake defines a method for performing a liquid unstaking paid out immediately from the balance
+	// of the proxy account.
+	InstantLiquidUnstake(ctx context.Context, in *MsgInstantLiquidUnstake, opts ...grpc.CallOption) (*MsgInstantLiquidUnstakeResponse, error)
+	// AddWhitelistedValidator defines a governance operation for adding a validator to the whitelist.
+	AddWhitelistedValidator(ctx context.Context, in *MsgAddWhitelistedValidator, opts ...grpc.CallOption) (*MsgAddWhitelistedValidatorResponse, error)
+	// RemoveWhitelistedValidator defines a governance operation for removing a validator from the whitelist.
+	RemoveWhitelistedValidator(ctx context.Context, in *MsgRemoveWhitelistedValidator, opts ...grpc.CallOption) (*MsgRemoveWhitelistedValidatorResponse, error)
+	// UpdateValidatorWeight defines a governance operation for updating the target weight of a whitelisted validator.
+	UpdateValidatorWeight(ctx context.Context, in *MsgUpdateValidatorWeight, opts ...grpc.CallOption) (*MsgUpdateValidatorWeightResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error) {
+	out := new(MsgLiquidStakeResponse)
+	err := c.cc.Invoke(ctx, "/estake.lselysium.v1beta1.Msg/LiquidStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opts ...grpc.CallOption) (*MsgLiquidUnstakeResponse, error) {
+	out := new(MsgLiquidUnstakeResponse)
+	err := c.cc.Invoke(ctx, "/estake.lselysium.v1beta1.Msg/LiquidUnstake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StakeToLiquid(ctx context.Context, in *MsgStakeToLiquid, opts ...grpc.CallOption) (*MsgStakeToLiquidResponse, error) {
+	out := new(MsgStakeToLiquidResponse)
+	err := c.cc.Invoke(ctx, "/estake.lselysium.v1beta1.Msg/StakeToLiquid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PooledLiquidUnstake(ctx context.Context, in *MsgPooledLiquidUnstake, opts ...grpc.CallOption) (*MsgPooledLiquidUnstakeResponse, error) {
+	out := new(MsgPooledLiquidUnstakeResponse)
+	err := c.cc.Invoke(ctx, "/estake.lselysium.v1beta1.Msg/PooledLiquidUnstake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimUnbonded(ctx context.Context, in *MsgClaimUnbonded, opts ...grpc.CallOption) (*MsgClaimUnbondedResponse, error) {
+	out := new(MsgClaimUnbondedResponse)
+	err := c.cc.Invoke(ctx, "/estake.lselysium.v1beta1.Msg/ClaimUnbonded", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InstantLiquidUnstake(ctx context.Context, in *MsgInstantLiquidUnstake, opts ...grpc.CallOption) (*MsgInstantLiquidUnstakeResponse, error) {
+	out := new(MsgInstantLiquidUnstakeResponse)
+	err := c.cc.Invoke(ctx, "/estake.lselysium.v1beta1.Msg/InstantLiquidUnstake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddWhitelistedValidator(ctx context.Context, in *MsgAddWhitelistedValidator, opts ...grpc.CallOption) (*MsgAddWhitelistedValidatorResponse, error) {
+	out := new(MsgAddWhitelistedValidatorResponse)
+	err := c.cc.Invoke(ctx, "/estake.lselysium.v1beta1.Msg/AddWhitelistedValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveWhitelistedValidator(ctx context.Context, in *MsgRemoveWhitelistedValidator, opts ...grpc.CallOption) (*MsgRemoveWhitelistedValidatorResponse, error) {
+	out := new(MsgRemoveWhitelistedValidatorResponse)
+	err := c.cc.Invoke(ctx, "/estake.lselysium.v1beta1.Msg/RemoveWhitelistedValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateValidatorWeight(ctx context.Context, in *MsgUpdateValidatorWeight, opts ...grpc.CallOption) (*MsgUpdateValidatorWeightResponse, error) {
+	out := new(MsgUpdateValidatorWeightResponse)
+	err := c.cc.Invoke(ctx, "/estake.lselysium.v1beta1.Msg/UpdateValidatorWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// LiquidStake defines a method for performing a delegation of coins
+	// from a delegator to whitelisted validators.
+	LiquidStake(context.Context, *MsgLiquidStake) (*MsgLiquidStakeResponse, error)
+	// LiquidUnstake defines a method for performing an undelegation of liquid staking from a
+	// delegate.
+	LiquidUnstake(context.Context, *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error)
+	// StakeToLiquid defines a method for converting an existing delegation to an active liquid validator into
+	// bToken without unbonding.
+	StakeToLiquid(context.Context, *MsgStakeToLiquid) (*MsgStakeToLiquidResponse, error)
+	// PooledLiquidUnstake defines a method for performing an undelegation of liquid staking into the unbonding pool,
+	// claimed by the delegator once matured.
+	PooledLiquidUnstake(context.Context, *MsgPooledLiquidUnstake) (*MsgPooledLiquidUnstakeResponse, error)
+	// ClaimUnbonded defines a method for claiming the matured pooled liquid unstakings of the delegator.
+	ClaimUnbonded(context.Context, *MsgClaimUnbonded) (*MsgClaimUnbondedResponse, error)
+	// InstantLiquidUnstake defines a method for performing a liquid unstaking paid out immediately from the balance
+	// of the proxy account.
+	InstantLiquidUnstake(context.Context, *MsgInstantLiquidUnstake) (*MsgInstantLiquidUnstakeResponse, error)
+	// AddWhitelistedValidator defines a governance operation for adding a validator to the whitelist.
+	AddWhitelistedValidator(context.Context, *MsgAddWhitelistedValidator) (*MsgAddWhitelistedValidatorResponse, error)
+	// RemoveWhitelistedValidator defines a governance operation for removing a validator from the whitelist.
+	RemoveWhitelistedValidator(context.Context, *MsgRemoveWhitelistedValidator) (*MsgRemoveWhitelistedValidatorResponse, error)
+	// UpdateValidatorWeight defines a governance operation for updating the target weight of a whitelisted validator.
+	UpdateValidatorWeight(context.Context, *MsgUpdateValidatorWeight) (*MsgUpdateValidatorWeightResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) LiquidStake(ctx context.Context, req *MsgLiquidStake) (*MsgLiquidStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidStake not implemented")
+}
+func (*UnimplementedMsgServer) LiquidUnstake(ctx context.Context, req *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidUnstake not implemented")
+}
+func (*UnimplementedMsgServer) StakeToLiquid(ctx context.Context, req *MsgStakeToLiquid) (*MsgStakeToLiquidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakeToLiquid not implemented")
+}
+func (*UnimplementedMsgServer) PooledLiquidUnstake(ctx context.Context, req *MsgPooledLiquidUnstake) (*MsgPooledLiquidUnstakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PooledLiquidUnstake not implemented")
+}
+func (*UnimplementedMsgServer) ClaimUnbonded(ctx context.Context, req *MsgClaimUnbonded) (*MsgClaimUnbondedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimUnbonded not implemented")
+}
+func (*UnimplementedMsgServer) InstantLiquidUnstake(ctx context.Context, req *MsgInstantLiquidUnstake) (*MsgInstantLiquidUnstakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantLiquidUnstake not implemented")
+}
+func (*UnimplementedMsgServer) AddWhitelistedValidator(ctx context.Context, req *MsgAddWhitelistedValidator) (*MsgAddWhitelistedValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWhitelistedValidator not implemented")
+}
+func (*UnimplementedMsgServer) RemoveWhitelistedValidator(ctx context.Context, req *MsgRemoveWhitelistedValidator) (*MsgRemoveWhitelistedValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWhitelistedValidator not implemented")
+}
+func (*UnimplementedMsgServer) UpdateValidatorWeight(ctx context.Context, req *MsgUpdateValidatorWeight) (*MsgUpdateValidatorWeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateValidatorWeight not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_LiquidStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidStake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/estake.lselysium.v1beta1.Msg/LiquidStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidStake(ctx, req.(*MsgLiquidStake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LiquidUnstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidUnstake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidUnstake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/estake.lselysium.v1beta1.Msg/LiquidUnstake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidUnstake(ctx, req.(*MsgLiquidUnstake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StakeToLiquid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStakeToLiquid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StakeToLiquid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/estake.lselysium.v1beta1.Msg/StakeToLiquid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StakeToLiquid(ctx, req.(*MsgStakeToLiquid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PooledLiquidUnstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPooledLiquidUnstake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PooledLiquidUnstake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/estake.lselysium.v1beta1.Msg/PooledLiquidUnstake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PooledLiquidUnstake(ctx, req.(*MsgPooledLiquidUnstake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimUnbonded_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimUnbonded)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimUnbonded(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/estake.lselysium.v1beta1.Msg/ClaimUnbonded",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimUnbonded(ctx, req.(*MsgClaimUnbonded))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InstantLiquidUnstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantLiquidUnstake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InstantLiquidUnstake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/estake.lselysium.v1beta1.Msg/InstantLiquidUnstake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantLiquidUnstake(ctx, req.(*MsgInstantLiquidUnstake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddWhitelistedValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddWhitelistedValidator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddWhitelistedValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/estake.lselysium.v1beta1.Msg/AddWhitelistedValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddWhitelistedValidator(ctx, req.(*MsgAddWhitelistedValidator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveWhitelistedValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveWhitelistedValidator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveWhitelistedValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/estake.lselysium.v1beta1.Msg/RemoveWhitelistedValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveWhitelistedValidator(ctx, req.(*MsgRemoveWhitelistedValidator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateValidatorWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateValidatorWeight)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateValidatorWeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/estake.lselysium.v1beta1.Msg/UpdateValidatorWeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateValidatorWeight(ctx, req.(*MsgUpdateValidatorWeight))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "estake.lselysium.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LiquidStake",
+			Handler:    _Msg_LiquidStake_Handler,
+		},
+		{
+			MethodName: "LiquidUnstake",
+			Handler:    _Msg_LiquidUnstake_Handler,
+		},
+		{
+			MethodName: "StakeToLiquid",
+			Handler:    _Msg_StakeToLiquid_Handler,
+		},
+		{
+			MethodName: "PooledLiquidUnstake",
+			Handler:    _Msg_PooledLiquidUnstake_Handler,
+		},
+		{
+			MethodName: "ClaimUnbonded",
+			Handler:    _Msg_ClaimUnbonded_Handler,
+		},
+		{
+			MethodName: "InstantLiquidUnstake",
+			Handler:    _Msg_InstantLiquidUnstake_Handler,
+		},
+		{
+			MethodName: "AddWhitelistedValidator",
+			Handler:    _Msg_AddWhitelistedValidator_Handler,
+		},
+		{
+			MethodName: "RemoveWhitelistedValidator",
+			Handler:    _Msg_RemoveWhitelistedValidator_Handler,
+		},
+		{
+			MethodName: "UpdateValidatorWeight",
+			Handler:    _Msg_UpdateValidatorWeight_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "estake/lselysium/v1beta1/tx.proto",
+}
+
+func (m *MsgLiquidStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidUnstake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidUnstake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidUnstakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStakeToLiquid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStakeToLiquid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStakeToLiquid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStakeToLiquidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStakeToLiquidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStakeToLiquidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPooledLiquidUnstake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPooledLiquidUnstake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPooledLiquidUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPooledLiquidUnstakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPooledLiquidUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPooledLiquidUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if m.UnbondingRequestId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingRequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimUnbonded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimUnbonded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimUnbonded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimUnbondedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimUnbondedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimUnbondedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantLiquidUnstake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantLiquidUnstake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantLiquidUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantLiquidUnstakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantLiquidUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantLiquidUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddWhitelistedValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddWhitelistedValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddWhitelistedValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TargetWeight.Size()
+		i -= size
+		if _, err := m.TargetWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddWhitelistedValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddWhitelistedValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddWhitelistedValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveWhitelistedValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveWhitelistedValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveWhitelistedValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveWhitelistedValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveWhitelistedValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveWhitelistedValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateValidatorWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateValidatorWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateValidatorWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TargetWeight.Size()
+		i -= size
+		if _, err := m.TargetWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateValidatorWeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateValidatorWeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateValidatorWeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgLiquidStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidUnstakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStakeToLiquid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStakeToLiquidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPooledLiquidUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPooledLiquidUnstakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnbondingRequestId != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingRequestId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgClaimUnbonded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimUnbondedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgInstantLiquidUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgInstantLiquidUnstakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddWhitelistedValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TargetWeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddWhitelistedValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveWhitelistedValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveWhitelistedValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateValidatorWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TargetWeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateValidatorWeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidUnstake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeToLiquid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeToLiquid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeToLiquid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeToLiquidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeToLiquidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeToLiquidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPooledLiquidUnstake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPooledLiquidUnstake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPooledLiquidUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPooledLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPooledLiquidUnstakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPooledLiquidUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingRequestId", wireType)
+			}
+			m.UnbondingRequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingRequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimUnbonded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimUnbonded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimUnbonded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimUnbondedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimUnbondedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimUnbondedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantLiquidUnstake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantLiquidUnstake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantLiquidUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantLiquidUnstakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantLiquidUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgLiquidUnstakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
+func (m *MsgAddWhitelistedValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -588,15 +3292,15 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddWhitelistedValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddWhitelistedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -624,13 +3328,13 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -640,22 +3344,55 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -680,7 +3417,7 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddWhitelistedValidatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -703,10 +3440,10 @@ func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddWhitelistedValidatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddWhitelistedValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -730,7 +3467,7 @@ func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveWhitelistedValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -753,15 +3490,15 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidUnstake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveWhitelistedValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveWhitelistedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -789,13 +3526,13 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -805,25 +3542,74 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveWhitelistedValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveWhitelistedValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveWhitelistedValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -845,7 +3631,7 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateValidatorWeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -868,17 +3654,17 @@ func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateValidatorWeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateValidatorWeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -888,22 +3674,87 @@ func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -928,6 +3779,56 @@ func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateValidatorWeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateValidatorWeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateValidatorWeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0